@@ -0,0 +1,10 @@
+CREATE TABLE stats_data_downloads (
+    id serial NOT NULL,
+    date date NOT NULL,
+    path character varying NOT NULL,
+    count integer DEFAULT 0 NOT NULL,
+    bytes bigint DEFAULT 0 NOT NULL,
+    CONSTRAINT stats_data_downloads_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX stats_data_downloads_idx_date_path ON stats_data_downloads (date, path);
@@ -153,6 +153,30 @@ ALTER SEQUENCE public.stats_id_seq OWNED BY public.stats.id;
 
 
 
+CREATE TABLE public.stats_data_downloads (
+    id integer NOT NULL,
+    date date NOT NULL,
+    path character varying NOT NULL,
+    count integer DEFAULT 0 NOT NULL,
+    bytes bigint DEFAULT 0 NOT NULL
+);
+
+
+
+CREATE SEQUENCE public.stats_data_downloads_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+
+ALTER SEQUENCE public.stats_data_downloads_id_seq OWNED BY public.stats_data_downloads.id;
+
+
+
 CREATE TABLE public.stats_lookups (
     id integer NOT NULL,
     date date NOT NULL,
@@ -223,6 +247,10 @@ ALTER TABLE ONLY public.stats ALTER COLUMN id SET DEFAULT nextval('public.stats_
 
 
 
+ALTER TABLE ONLY public.stats_data_downloads ALTER COLUMN id SET DEFAULT nextval('public.stats_data_downloads_id_seq'::regclass);
+
+
+
 ALTER TABLE ONLY public.stats_lookups ALTER COLUMN id SET DEFAULT nextval('public.stats_lookups_id_seq'::regclass);
 
 
@@ -261,6 +289,11 @@ ALTER TABLE ONLY public.source
 
 
 
+ALTER TABLE ONLY public.stats_data_downloads
+    ADD CONSTRAINT stats_data_downloads_pkey PRIMARY KEY (id);
+
+
+
 ALTER TABLE ONLY public.stats_lookups
     ADD CONSTRAINT stats_lookups_pkey PRIMARY KEY (id);
 
@@ -300,6 +333,10 @@ CREATE UNIQUE INDEX source_idx_uniq ON public.source USING btree (application_id
 
 
 
+CREATE UNIQUE INDEX stats_data_downloads_idx_date_path ON public.stats_data_downloads USING btree (date, path);
+
+
+
 CREATE INDEX stats_idx_date ON public.stats USING btree (date);
 
 
@@ -0,0 +1,7 @@
+-- Small data set for local development, the API keys must not be used anywhere else.
+
+INSERT INTO account (id, name, apikey, is_admin) VALUES (1, 'Developer', 'devuserkey', true);
+INSERT INTO application (id, name, version, apikey, account_id) VALUES (1, 'Development', '1.0', 'devappkey', 1);
+
+SELECT setval('account_id_seq', 1);
+SELECT setval('application_id_seq', 1);
@@ -0,0 +1,8 @@
+-- The updated columns were added by hand before migrations were recorded, so no migration creates them,
+-- although the exports and 2020_03_03_more_created_updated_indexes use them. This only adds them where
+-- they are missing.
+ALTER TABLE fingerprint ADD COLUMN IF NOT EXISTS updated timestamp with time zone;
+ALTER TABLE track ADD COLUMN IF NOT EXISTS updated timestamp with time zone;
+ALTER TABLE track_mbid ADD COLUMN IF NOT EXISTS updated timestamp with time zone;
+ALTER TABLE track_puid ADD COLUMN IF NOT EXISTS updated timestamp with time zone;
+ALTER TABLE track_meta ADD COLUMN IF NOT EXISTS updated timestamp with time zone;
@@ -0,0 +1,36 @@
+CREATE TABLE change_log (
+    id bigserial NOT NULL,
+    created timestamp with time zone DEFAULT now() NOT NULL,
+    table_name text NOT NULL,
+    operation text NOT NULL,
+    record_id integer NOT NULL,
+    new_id integer,
+    CONSTRAINT change_log_pkey PRIMARY KEY (id),
+    CONSTRAINT change_log_operation_check CHECK (operation IN ('delete', 'merge'))
+);
+
+CREATE INDEX change_log_idx_created ON change_log (created);
+
+CREATE FUNCTION log_delete() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO change_log (table_name, operation, record_id) VALUES (TG_TABLE_NAME, 'delete', OLD.id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION log_track_merge() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO change_log (table_name, operation, record_id, new_id) VALUES (TG_TABLE_NAME, 'merge', OLD.id, NEW.new_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER track_log_merge AFTER UPDATE OF new_id ON track
+    FOR EACH ROW WHEN (NEW.new_id IS NOT NULL AND OLD.new_id IS DISTINCT FROM NEW.new_id) EXECUTE PROCEDURE log_track_merge();
+
+CREATE TRIGGER track_log_delete AFTER DELETE ON track FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER fingerprint_log_delete AFTER DELETE ON fingerprint FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_mbid_log_delete AFTER DELETE ON track_mbid FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_puid_log_delete AFTER DELETE ON track_puid FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_meta_log_delete AFTER DELETE ON track_meta FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_foreignid_log_delete AFTER DELETE ON track_foreignid FOR EACH ROW EXECUTE PROCEDURE log_delete();
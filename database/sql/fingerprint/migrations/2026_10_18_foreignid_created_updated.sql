@@ -0,0 +1,16 @@
+-- Existing rows get the time of the migration, so they are all included in the next export.
+ALTER TABLE foreignid_vendor ADD created timestamp with time zone DEFAULT now() NOT NULL;
+ALTER TABLE foreignid ADD created timestamp with time zone DEFAULT now() NOT NULL;
+
+-- The application doesn't set updated when it changes a track_foreignid row, so a trigger does.
+ALTER TABLE track_foreignid ADD updated timestamp with time zone;
+
+CREATE FUNCTION set_updated() RETURNS trigger AS $$
+BEGIN
+    NEW.updated = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER track_foreignid_set_updated BEFORE UPDATE ON track_foreignid
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*) EXECUTE PROCEDURE set_updated();
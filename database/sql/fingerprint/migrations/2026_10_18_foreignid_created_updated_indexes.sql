@@ -0,0 +1,5 @@
+CREATE INDEX CONCURRENTLY foreignid_vendor_idx_created ON foreignid_vendor (created);
+CREATE INDEX CONCURRENTLY foreignid_idx_created ON foreignid (created);
+
+CREATE INDEX CONCURRENTLY track_foreignid_idx_created ON track_foreignid (created);
+CREATE INDEX CONCURRENTLY track_foreignid_idx_updated ON track_foreignid (updated) WHERE updated IS NOT NULL;
@@ -1,3 +1,23 @@
+CREATE TABLE change_log (
+    id bigint NOT NULL,
+    created timestamp with time zone DEFAULT now() NOT NULL,
+    table_name text NOT NULL,
+    operation text NOT NULL,
+    record_id integer NOT NULL,
+    new_id integer,
+    CONSTRAINT change_log_operation_check CHECK (operation IN ('delete', 'merge'))
+);
+
+CREATE SEQUENCE change_log_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE change_log_id_seq OWNED BY change_log.id;
+
+
 CREATE TABLE fingerprint (
     id integer NOT NULL,
     created timestamp with time zone DEFAULT now() NOT NULL,
@@ -7,6 +27,7 @@ CREATE TABLE fingerprint (
     format_id integer,
     track_id integer NOT NULL,
     submission_count integer NOT NULL,
+    updated timestamp with time zone,
     CONSTRAINT fingerprint_bitrate_check CHECK ((bitrate > 0)),
     CONSTRAINT fingerprint_length_check CHECK ((length > 0))
 );
@@ -25,7 +46,8 @@ ALTER SEQUENCE fingerprint_id_seq OWNED BY fingerprint.id;
 CREATE TABLE foreignid (
     id integer NOT NULL,
     vendor_id integer NOT NULL,
-    name text NOT NULL
+    name text NOT NULL,
+    created timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
@@ -45,7 +67,8 @@ ALTER SEQUENCE foreignid_id_seq OWNED BY foreignid.id;
 
 CREATE TABLE foreignid_vendor (
     id integer NOT NULL,
-    name character varying NOT NULL
+    name character varying NOT NULL,
+    created timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
@@ -94,7 +117,8 @@ CREATE TABLE track (
     id integer NOT NULL,
     created timestamp with time zone DEFAULT now() NOT NULL,
     gid uuid NOT NULL,
-    new_id integer
+    new_id integer,
+    updated timestamp with time zone
 );
 
 
@@ -104,7 +128,8 @@ CREATE TABLE track_foreignid (
     track_id integer NOT NULL,
     foreignid_id integer NOT NULL,
     created timestamp with time zone DEFAULT now() NOT NULL,
-    submission_count integer NOT NULL
+    submission_count integer NOT NULL,
+    updated timestamp with time zone
 );
 
 
@@ -143,7 +168,8 @@ CREATE TABLE track_mbid (
     mbid uuid NOT NULL,
     created timestamp with time zone DEFAULT now() NOT NULL,
     submission_count integer NOT NULL,
-    disabled boolean DEFAULT false NOT NULL
+    disabled boolean DEFAULT false NOT NULL,
+    updated timestamp with time zone
 );
 
 
@@ -167,7 +193,8 @@ CREATE TABLE track_meta (
     track_id integer NOT NULL,
     meta_id integer NOT NULL,
     created timestamp with time zone DEFAULT now() NOT NULL,
-    submission_count integer NOT NULL
+    submission_count integer NOT NULL,
+    updated timestamp with time zone
 );
 
 
@@ -191,7 +218,8 @@ CREATE TABLE track_puid (
     track_id integer NOT NULL,
     puid uuid NOT NULL,
     created timestamp with time zone DEFAULT now() NOT NULL,
-    submission_count integer NOT NULL
+    submission_count integer NOT NULL,
+    updated timestamp with time zone
 );
 
 
@@ -210,6 +238,10 @@ ALTER SEQUENCE track_puid_id_seq OWNED BY track_puid.id;
 
 
 
+ALTER TABLE ONLY change_log ALTER COLUMN id SET DEFAULT nextval('change_log_id_seq'::regclass);
+
+
+
 ALTER TABLE ONLY fingerprint ALTER COLUMN id SET DEFAULT nextval('fingerprint_id_seq'::regclass);
 
 
@@ -246,6 +278,11 @@ ALTER TABLE ONLY track_puid ALTER COLUMN id SET DEFAULT nextval('track_puid_id_s
 
 
 
+ALTER TABLE ONLY change_log
+    ADD CONSTRAINT change_log_pkey PRIMARY KEY (id);
+
+
+
 ALTER TABLE ONLY fingerprint
     ADD CONSTRAINT fingerprint_pkey PRIMARY KEY (id);
 
@@ -291,6 +328,8 @@ ALTER TABLE ONLY track_puid
 
 
 
+CREATE INDEX change_log_idx_created ON change_log (created);
+
 CREATE INDEX fingerprint_idx_created ON fingerprint (created);
 CREATE INDEX fingerprint_idx_length ON fingerprint (length);
 CREATE INDEX fingerprint_idx_track_id ON fingerprint (track_id);
@@ -300,7 +339,9 @@ CREATE UNIQUE INDEX meta_idx_gid ON meta (gid) WHERE gid IS NOT NULL;
 
 CREATE INDEX foreignid_idx_vendor ON foreignid (vendor_id);
 CREATE UNIQUE INDEX foreignid_idx_vendor_name ON foreignid (vendor_id, name);
+CREATE INDEX foreignid_idx_created ON foreignid (created);
 CREATE UNIQUE INDEX foreignid_vendor_idx_name ON foreignid_vendor (name);
+CREATE INDEX foreignid_vendor_idx_created ON foreignid_vendor (created);
 
 CREATE INDEX track_idx_gid ON track (gid);
 CREATE INDEX track_idx_new_id ON track (new_id) WHERE (new_id IS NOT NULL);
@@ -322,6 +363,8 @@ CREATE UNIQUE INDEX track_meta_idx_track_id_meta ON track_meta (track_id, meta_i
 
 CREATE INDEX track_foreignid_idx_foreignid_id ON track_foreignid (foreignid_id);
 CREATE UNIQUE INDEX track_foreignid_idx_track_id_foreignid ON track_foreignid (track_id, foreignid_id);
+CREATE INDEX track_foreignid_idx_created ON track_foreignid (created);
+CREATE INDEX track_foreignid_idx_updated ON track_foreignid (updated) WHERE updated IS NOT NULL;
 
 
 
@@ -369,3 +412,37 @@ ALTER TABLE ONLY track_puid
     ADD CONSTRAINT track_puid_fk_track_id FOREIGN KEY (track_id) REFERENCES track(id);
 
 
+
+CREATE FUNCTION log_delete() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO change_log (table_name, operation, record_id) VALUES (TG_TABLE_NAME, 'delete', OLD.id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION log_track_merge() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO change_log (table_name, operation, record_id, new_id) VALUES (TG_TABLE_NAME, 'merge', OLD.id, NEW.new_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION set_updated() RETURNS trigger AS $$
+BEGIN
+    NEW.updated = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER track_foreignid_set_updated BEFORE UPDATE ON track_foreignid
+    FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*) EXECUTE PROCEDURE set_updated();
+
+CREATE TRIGGER track_log_merge AFTER UPDATE OF new_id ON track
+    FOR EACH ROW WHEN (NEW.new_id IS NOT NULL AND OLD.new_id IS DISTINCT FROM NEW.new_id) EXECUTE PROCEDURE log_track_merge();
+
+CREATE TRIGGER track_log_delete AFTER DELETE ON track FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER fingerprint_log_delete AFTER DELETE ON fingerprint FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_mbid_log_delete AFTER DELETE ON track_mbid FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_puid_log_delete AFTER DELETE ON track_puid FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_meta_log_delete AFTER DELETE ON track_meta FOR EACH ROW EXECUTE PROCEDURE log_delete();
+CREATE TRIGGER track_foreignid_log_delete AFTER DELETE ON track_foreignid FOR EACH ROW EXECUTE PROCEDURE log_delete();
@@ -0,0 +1,24 @@
+-- Small data set for local development.
+
+INSERT INTO foreignid_vendor (id, name) VALUES (1, 'spotify');
+INSERT INTO foreignid (id, vendor_id, name) VALUES (1, 1, '4uLU6hMCjMI75M1A2tKUQC');
+
+INSERT INTO meta (id, track, artist, album, track_no, year) VALUES (1, 'Test Track', 'Test Artist', 'Test Album', 1, 2020);
+
+INSERT INTO track (id, gid) VALUES (1, 'eb31d1c3-950e-468b-9e36-e46fa75b1291');
+
+INSERT INTO fingerprint (id, fingerprint, length, bitrate, track_id, submission_count)
+    VALUES (1, '{-1624442622,-1624438526,-1628632830,-1628628734}', 206, 192, 1, 1);
+
+INSERT INTO track_mbid (id, track_id, mbid, submission_count) VALUES (1, 1, 'b81f83ee-4da4-11e0-9ed8-0025225356f3', 1);
+INSERT INTO track_meta (id, track_id, meta_id, submission_count) VALUES (1, 1, 1, 1);
+INSERT INTO track_foreignid (id, track_id, foreignid_id, submission_count) VALUES (1, 1, 1, 1);
+
+SELECT setval('foreignid_vendor_id_seq', 1);
+SELECT setval('foreignid_id_seq', 1);
+SELECT setval('meta_id_seq', 1);
+SELECT setval('track_id_seq', 1);
+SELECT setval('fingerprint_id_seq', 1);
+SELECT setval('track_mbid_id_seq', 1);
+SELECT setval('track_meta_id_seq', 1);
+SELECT setval('track_foreignid_id_seq', 1);
@@ -7,7 +7,6 @@ import (
 	"compress/gzip"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"os"
 	pathpkg "path"
@@ -19,51 +18,146 @@ var SQL = func() http.FileSystem {
 	fs := vfsgen۰FS{
 		"/": &vfsgen۰DirInfo{
 			name:    "/",
-			modTime: time.Date(2020, 2, 26, 11, 20, 54, 617295371, time.UTC),
+			modTime: time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
 		},
 		"/app": &vfsgen۰DirInfo{
 			name:    "app",
-			modTime: time.Date(2019, 10, 5, 19, 36, 0, 393158915, time.UTC),
+			modTime: time.Date(2026, 10, 18, 19, 19, 58, 69829531, time.UTC),
+		},
+		"/app/migrations": &vfsgen۰DirInfo{
+			name:    "migrations",
+			modTime: time.Date(2026, 10, 18, 20, 10, 51, 954011063, time.UTC),
+		},
+		"/app/migrations/2026_10_18_stats_data_downloads.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2026_10_18_stats_data_downloads.sql",
+			modTime:          time.Date(2026, 10, 18, 19, 13, 22, 544044987, time.UTC),
+			uncompressedSize: 351,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x90\x3f\x6b\xc3\x30\x10\x47\x77\x7d\x8a\xdf\x18\x43\x86\xee\x99\xd4\x44\x05\x53\x57\x6e\x5d\x19\x9a\xc9\x5c\x2c\xe1\x1c\x0d\x72\x90\xae\x7f\xfc\xed\x4b\xdc\x2e\x0d\xf1\x72\xc3\xe3\x71\xf0\x7e\xdb\xc6\x68\x67\xe0\xf4\x7d\x65\x90\x85\x24\x77\x9e\x84\x3a\x3f\x7e\xc5\xd3\x48\x3e\x63\xa5\x00\x80\x3d\x72\x48\x4c\x27\xd8\xda\xc1\xb6\x55\xb5\x9e\xb9\x27\x09\xbf\xe7\x3f\x3f\x93\x1c\xd1\x1f\x29\x51\x2f\x21\xe1\x93\xd2\xc4\x71\xb8\x92\xfa\xf1\x23\x0a\x38\x4a\x18\x42\xc2\xce\x3c\xe8\xb6\x72\xb8\xbb\xb2\x0e\x93\x84\x8c\x03\x0f\x1c\x65\x51\xda\xd6\xf6\xd5\x35\xba\xb4\xee\x66\x45\x77\x7e\x0f\x13\x9e\x9b\xf2\x49\x37\x7b\x3c\x9a\x3d\x56\xec\x0b\x55\x6c\x94\xfa\x5b\xa0\xb5\xe5\x4b\x6b\x50\xda\x9d\x79\xbb\xfd\x82\xfd\xf7\x05\x85\x6e\x6e\xab\xed\xc2\x5c\x17\x65\x3d\xf7\x17\x1b\xf5\x33\x00\x3e\xfc\xbe\x5a\x5f\x01\x00\x00"),
 		},
 		"/app/schema.sql": &vfsgen۰CompressedFileInfo{
 			name:             "schema.sql",
-			modTime:          time.Date(2019, 10, 5, 19, 36, 0, 393158915, time.UTC),
-			uncompressedSize: 7310,
+			modTime:          time.Date(2026, 10, 18, 19, 13, 22, 536775941, time.UTC),
+			uncompressedSize: 8122,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x4d\x6f\xdb\x38\x10\xbd\xfb\x57\xf0\x66\x07\x08\x8a\xf6\xb6\x70\x90\x83\xea\x28\xad\xb1\x8e\xbc\xb5\xe5\x6d\x73\x22\x68\x69\xac\x10\x91\x48\x57\xa4\x62\x67\x7f\xfd\x82\xa2\x6c\x89\xd6\xb7\xf3\xd5\x1e\x1a\x48\x33\x7a\x6f\xf8\x86\xc3\x19\x7a\x30\x58\xda\x2e\x12\x92\x48\x88\x80\x49\x2c\x69\x04\x3c\x91\xe8\x1a\x7d\xbe\x4a\x4d\x21\xf7\x1e\xcb\x6f\xa9\x1f\x02\xa6\x0c\xcb\x98\x30\x41\x3c\x49\x39\xc3\x02\x84\x50\x7f\x4b\xce\x5e\x48\x15\x34\x30\x8f\xfb\x94\x05\xe8\x1a\x0d\x57\xee\xed\x5f\xc3\xab\x03\x37\xf3\x49\xec\x63\x8f\xb3\x0d\x8f\x23\xca\x02\x2c\x64\x4c\x59\x20\xd0\x35\xe2\x2c\xc3\x78\x00\xef\x11\x6f\x12\xa6\xb9\xd6\xdc\xa7\xa0\xec\x1b\x12\x0a\xd0\x2e\xfb\x28\xe4\x5b\x65\x45\xd7\xc8\xe3\x4c\x02\x93\x06\x7f\x44\x19\x8e\x40\x08\x12\xa4\x5f\xee\x48\xcc\x28\x0b\xb4\x4b\xcc\x77\x58\x80\x97\xc4\x54\x3e\x2b\xd6\xcd\xe6\x4a\x2b\xe3\xc3\x86\x24\xa1\xc4\x92\xac\x43\x10\x5b\xe2\x81\x0a\x7f\x78\x62\xdd\x51\xf9\x80\x39\xf5\x0b\x11\x0d\x06\x93\x85\x6d\xb9\x36\x72\xad\xaf\x33\x1b\x6d\x93\x75\x48\xbd\x4f\xc4\xf3\x78\xc2\x24\x1a\x0d\x10\x42\x88\xfa\x88\x32\x09\x01\xc4\xc8\x99\xbb\xc8\x59\xcd\x66\x97\xa9\x81\x91\x08\x90\xf7\x40\x62\xe2\x49\x88\xd1\x13\x89\x9f\x95\x70\xa6\x13\xd9\xd2\x47\x78\x6e\x75\x8b\xd6\x89\x80\xb8\xec\x96\x81\x30\xce\x9e\x23\x9e\x08\xb4\xe6\x3c\x04\xc2\xd0\x8d\x7d\x6b\xad\x66\xae\x5e\x87\x76\xf2\x62\x20\x12\x7c\xa4\x32\x2b\x24\x89\xb6\x48\x2d\x38\x7d\x44\xff\x71\x06\xc7\x6f\x18\xdf\x8d\x2e\xf4\x37\x21\x11\x32\xe4\x01\x65\xb5\x5f\x69\x3f\x91\xac\x23\xaa\x37\x8e\x96\xe6\xa0\xc8\x01\xf3\x73\x69\xd9\xdb\x90\x7a\x24\xdd\x06\xb9\x80\x65\xdb\x13\xc4\x0a\xb5\x6e\xe1\xd9\x9a\xf0\x26\xe6\x11\xa2\x0c\xa4\x7e\x4d\x05\x26\x7e\x44\x59\xb5\x1c\xc7\x50\x06\x17\x2a\xc3\x4d\x29\xc6\x01\xe7\x41\x08\x59\xa6\xf5\x03\x56\x99\x50\x41\xb7\xa6\x36\xc3\xa8\xd8\x20\x26\xf3\xd2\xfe\xb1\xb2\x9d\x49\x89\x9c\xfa\x58\xc0\xef\x14\xcb\x5a\x1e\x30\xd2\xc7\xa5\x6b\x2d\x5c\xf4\x73\xea\x7e\x47\x5f\xd2\x17\x53\x67\xb2\xb0\xef\x6c\xc7\x45\x5f\xef\xb3\x57\xce\x1c\xdd\x4d\x9d\x7f\xad\xd9\xca\x3e\x3e\x5b\xbf\xf2\xe7\x89\x35\xf9\x6e\xa3\x2f\x69\x20\xd6\xcc\xb5\x17\x2d\x71\xa0\xf9\x4f\xc7\xbe\x51\xf8\xa6\xf9\x13\xf5\x5b\x65\xe4\x5b\x60\xd4\xcf\x64\xcc\x1e\x5e\x4d\x3e\x93\x31\xdf\x3c\xaf\x52\x9f\xb5\x1b\xf0\xbc\x3a\xee\x57\x84\x25\x45\x24\x7d\x82\xd2\xa6\x96\x71\x02\xad\x92\x69\x07\x88\x08\x0d\xeb\x8a\x69\x07\x6b\x41\x65\x85\x24\x2d\xbb\xd5\x28\xe5\x0f\xde\xb1\xa5\x58\xca\xbb\x36\x77\x69\xda\xb9\xaa\x89\x91\x97\x1e\xf1\xcd\xc2\x69\x8a\xa2\x66\xef\x23\x92\xc1\x5b\xd2\x47\x5b\x9b\xa4\x11\x3c\x89\x3d\x68\x93\xa6\xfa\x84\xef\x5e\xe3\x2d\xe5\xd7\x2c\xad\x0e\xf1\xfd\xa5\x35\x78\x4b\xd2\x6a\x6b\xa3\xb4\x92\x48\xf1\x2a\xe7\x96\x4f\x24\xe8\xff\x0e\xc7\xc4\x68\xc8\xf8\x6e\x38\x1e\x4b\xd8\xcb\x8b\xf1\x38\xb5\x9d\x88\x4d\xc2\x04\x7a\xf6\xaa\x34\xe2\x0f\xaf\xfb\x62\x14\x65\xd9\x95\xb1\x55\x75\x1c\x72\xfe\x98\x6c\x5b\xd5\xcf\x85\x35\xdf\x3f\xf0\x24\x7e\x49\x29\xe8\x42\x60\xfc\x81\x4a\xd1\x3a\x3e\x69\xe7\x16\xd7\x2e\x89\xcb\x16\xfd\x87\x24\xd0\x8c\xa6\x3a\x91\x07\xa7\xf6\x84\xa6\x73\x1a\x09\x80\xb5\x97\x54\x5d\x52\x3b\x65\x2e\xe7\x69\xad\x4a\xba\x6d\x9f\x10\x5a\xe6\xe7\x2e\x59\x2d\xac\xfc\x0f\xc9\x6c\x39\xa2\x9a\xec\x16\x1c\x0f\x19\xd6\xb8\x3a\xc1\x73\x67\x76\x3a\x7f\x22\x6d\x9f\xcc\x67\xab\x3b\x47\xa5\x58\xdd\xe9\x8e\x63\x14\xec\xe5\x13\x09\x47\xc3\xca\x91\x76\x38\x1e\xc7\x10\x78\x21\x11\xe2\xa2\x99\xaa\x30\x55\xf6\xa2\x2b\xcd\x23\x9d\x29\xb3\x01\xa4\x0f\x9b\xd1\xd8\x3b\x13\x65\xed\xbc\x0f\x91\xd1\xe6\xba\x13\xa5\xcd\xad\x17\x4f\xe1\x58\xef\x47\x73\x3c\xcd\xfb\xd3\x99\x87\x50\x4f\xda\xe2\x99\xd3\x9f\xba\x5c\x25\xdd\x77\xa8\x71\x61\xd5\xe5\x7e\x73\x83\x26\x73\x67\xe9\x2e\xac\xa9\xe3\x22\xd3\x03\x6f\xd5\x75\xe5\x9f\xc5\xf4\xce\x5a\xdc\xa3\xbf\xed\x7b\x34\x32\xaf\xb7\xdd\xe8\xf4\x5d\xae\x89\x4e\x7b\x54\xd0\x69\x43\x27\x9a\x26\xfc\x32\x70\x2b\x68\x5e\x94\x95\xc0\x85\x9a\xed\x0f\xae\x6b\xb0\x0a\x37\xab\xce\xfe\x90\xba\xda\xaa\x20\xb3\x3a\x3c\x03\xb2\xb8\xd3\x2b\x91\x8d\x52\x38\x93\xa0\x1e\xf8\xdc\x88\x0b\x05\x52\x0f\x5e\xac\xa2\x7a\xa2\xac\x81\x4e\x9d\x1b\xfb\xd7\x69\x6d\x50\x7f\x8f\x0b\x57\x93\xb9\x53\xf3\xb3\xd0\x6a\x39\x75\xbe\xa1\xb5\x8c\x01\xd0\x28\xff\xc0\x20\x58\x39\xd3\x1f\xab\x53\x9e\x94\x40\xff\x62\x50\x02\x3f\x41\x4d\xbd\xba\x21\x66\x3f\x12\xb6\x20\x6a\xaf\x06\x11\xb2\x8a\x6d\x15\x21\xfb\x1d\xa7\xb7\x08\xc7\x36\xb5\xc7\xe9\x55\x26\x47\xce\x7a\x9e\x81\xa8\x5c\xea\xb1\x8e\x9d\x68\x8f\x13\x46\x7f\x17\xb0\xb2\xb6\x76\x22\x66\xb1\x1d\x5f\x16\xc4\xbb\x3c\xdc\x34\x2b\x74\x39\x74\xa1\x3d\x4e\x27\xc4\x02\x45\xda\xd0\x0c\x06\xe5\xd1\x08\xa1\x96\xd3\x01\x47\xb9\x5d\xa2\x46\xb4\xbc\x4f\x55\x07\x76\x6c\x81\xdd\x03\x34\xfb\x4f\x0d\x6c\xb1\xc5\xd5\x40\x7f\xec\xbf\x17\x34\x93\xcd\x23\x3e\x19\xf9\x6f\xe7\x0b\x7b\xfa\xcd\xd1\x67\x87\x69\xbb\x40\x0b\xfb\xd6\x5e\xa8\x61\x77\x59\xd1\x5b\xda\xfb\x50\xdf\x96\xad\xa2\xcb\x0b\xd2\x8c\x2c\xaf\xbb\xaa\xa8\xb4\xb5\x73\x44\x9d\xbb\xfa\xdb\x47\xd4\xbd\x57\xbf\x79\x2c\x3d\x9b\xe6\xbb\xee\xa5\x33\xdb\xe3\x9b\xc4\xf8\x7f\x00\x00\x00\xff\xff\xb1\xbf\xdf\x36\x8e\x1c\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x4d\x6f\xdb\x38\x10\xbd\xfb\x57\xf0\x66\x07\x08\x8a\xf6\xb6\x48\x90\x83\xeb\x28\xad\xb1\x8e\xbc\xb5\xe5\x6d\x7b\x22\x68\x69\x2c\x13\x91\x48\x57\xa4\x62\x67\x7f\xfd\x82\xa2\x6c\x89\x16\xf5\xe5\xa4\x1f\x39\xc4\x90\x66\xf4\xde\xe8\x0d\x87\x33\xd4\x60\xb0\x74\x3c\x24\x24\x91\x10\x03\x93\x58\xd2\x18\x78\x2a\xd1\x1d\x7a\x7f\x9b\x99\x22\xee\x3f\x55\xef\xd2\x20\x02\x4c\x19\x96\x09\x61\x82\xf8\x92\x72\x86\x05\x08\xa1\x7e\x2b\xce\x7e\x44\x15\x34\x30\x9f\x07\x94\x85\xe8\x0e\x0d\x57\xde\xc3\x5f\xc3\xdb\x23\x37\x0b\x48\x12\x60\x9f\xb3\x0d\x4f\x62\xca\x42\x2c\x64\x42\x59\x28\xd0\x1d\xe2\x2c\xc7\xd8\x82\xff\x84\x37\x29\xd3\x5c\x6b\x1e\x50\x50\xf6\x0d\x89\x04\x68\x97\x43\x1c\xf1\x9d\xb2\xa2\x3b\xe4\x73\x26\x81\x49\x83\x3f\xa6\x0c\xc7\x20\x04\x09\xb3\x27\xf7\x24\x61\x94\x85\xda\x25\xe1\x7b\x2c\xc0\x4f\x13\x2a\x5f\x14\xeb\x66\x73\xab\x95\x09\x60\x43\xd2\x48\x62\x49\xd6\x11\x88\x1d\xf1\x41\x85\x3f\x3c\xb3\xee\xa9\xdc\x62\x4e\x83\x52\x44\x83\xc1\x64\xe1\x8c\x3d\x07\x79\xe3\x8f\x33\x07\xed\xd2\x75\x44\xfd\x77\xc4\xf7\x79\xca\x24\x1a\x0d\x10\x42\x88\x06\x88\x32\x09\x21\x24\xc8\x9d\x7b\xc8\x5d\xcd\x66\xd7\x99\x81\x91\x18\x90\xbf\x25\x09\xf1\x25\x24\xe8\x99\x24\x2f\x4a\x38\xd3\x89\xec\xe8\x13\xbc\xb4\xba\xc5\xeb\x54\x40\x52\x75\xcb\x41\x18\x67\x2f\x31\x4f\x05\x5a\x73\x1e\x01\x61\xe8\xde\x79\x18\xaf\x66\x9e\x7e\x0f\xed\xe4\x27\x40\x24\x04\x48\x65\x56\x48\x12\xef\x90\x7a\xe1\xec\x12\xfd\xc7\x19\x9c\x9e\x61\x7c\x3f\xba\xd2\xcf\x44\x44\xc8\x88\x87\x94\xd5\x3e\xa5\xfd\x44\xba\x8e\xa9\x5e\x38\x5a\x9a\xa3\x22\x47\xcc\xf7\x95\xd7\xde\x45\xd4\x27\xd9\x32\x28\x04\xac\xda\x9e\x21\x51\xa8\x75\x2f\x9e\xbf\x13\xde\x24\x3c\x46\x94\x81\xd4\xb7\xa9\xc0\x24\x88\x29\xb3\xcb\x71\x0a\x65\x70\x75\x3b\x68\x4e\x31\x0e\x39\x0f\x23\xc8\x33\xad\x2f\xb0\xca\x84\x0a\xba\x35\xb5\x39\x86\x65\x81\x98\xcc\x4b\xe7\xcb\xca\x71\x27\x15\x72\x1a\x60\x01\x3f\x32\xac\xf1\xf2\x88\x91\x5d\x2e\xbd\xf1\xc2\x43\x5f\xa7\xde\x67\xf4\x21\xbb\x31\x75\x27\x0b\xe7\xd1\x71\x3d\xf4\xf1\x7b\x7e\xcb\x9d\xa3\xc7\xa9\xfb\xef\x78\xb6\x72\x4e\xd7\xe3\x6f\xc5\xf5\x64\x3c\xf9\xec\xa0\x0f\x59\x20\xe3\x99\xe7\x2c\x5a\xe2\x40\xf3\xaf\xae\x73\xaf\xf0\x4d\xf3\x3b\x1a\xb4\xca\xc8\x77\xc0\x68\x90\xcb\x98\x5f\xbc\x99\x7c\x26\x63\xb1\x78\xde\xa4\x3e\x6b\x17\xe0\x65\x75\xdc\xaf\x08\x2b\x8a\x48\xfa\x0c\x95\x45\x2d\x93\x14\x5a\x25\xd3\x0e\x10\x13\x1a\xd5\x15\xd3\x1e\xd6\x82\x4a\x8b\x24\x2d\xab\xd5\x28\xe5\xdf\xbc\x62\x2b\xb1\x54\x57\x6d\xe1\xd2\xb4\x72\x55\x13\x23\xaf\xdd\xe2\x9b\x85\xd3\x14\x65\xcd\x7e\x8d\x48\x06\x6f\x45\x1f\x6d\x6d\x92\x46\xf0\x34\xf1\xa1\x4d\x1a\xfb\x0e\xdf\xbd\xc6\x5b\xca\xaf\x59\x5a\x1d\xe2\xaf\x97\xd6\xe0\xad\x48\xab\xad\x8d\xd2\x4a\x22\xc5\x9b\xec\x5b\x01\x91\xa0\xff\x1d\xb7\x89\xd1\x90\xf1\xfd\xf0\xe6\x46\xc2\x41\x5e\xdd\xdc\x64\xb6\x33\xb1\x49\x94\x42\xcf\x5e\x95\x45\xfc\xdb\xeb\xbe\x1c\x45\x55\x76\x65\x6c\x55\x1d\x07\x44\x12\x1c\xf0\x3d\x8b\x38\x09\x5a\x93\x50\xe8\x6b\xde\xdf\x11\xb9\x6d\xef\x02\x9d\x66\xa4\xf5\x8b\x04\x81\xd6\x34\xa4\x4c\x5a\x9c\xba\xe4\xc5\x7c\xa7\x3f\x24\x4d\xd6\xa0\xec\x59\x3b\xf3\x6d\x4f\x62\xc4\xf9\x53\xba\xbb\x38\x7b\x5b\x9e\x26\xaf\xd9\xcf\xf4\x6e\xc6\xf8\x96\x4a\xd1\x9a\x5f\xed\xdc\xe2\xda\x25\xcb\xf9\x4b\xff\x21\xe9\x35\xa3\xa9\xc9\x6b\xee\xd4\x9e\xd0\x6c\xd8\x26\x21\x30\x79\x71\x52\x3b\x65\xae\xe0\x69\xad\x5e\xba\x7b\x75\x81\x77\xc9\x6a\xe9\xcd\xff\x90\xcc\x56\x23\xaa\xc9\x6e\xc9\xf1\x98\x61\x8d\xab\x13\x3c\x77\x67\xe7\x87\x08\xa4\xed\x93\xf9\x6c\xf5\xe8\xaa\x14\xab\x83\xf9\x69\x16\x86\x83\x7c\x26\xd1\x68\x68\x3d\x97\x0c\x6f\x6e\x12\x08\xfd\x88\x08\x71\xd5\x4c\x55\xac\x83\x7e\x74\x95\xa1\xb2\x33\x65\x3e\x45\xf6\x61\x33\xa6\xb3\xce\x44\xf9\x4c\xd6\x87\xc8\x98\x55\xba\x13\xa9\x04\xf7\xe3\x29\xf5\xe6\x7e\x34\xe7\x2d\xb9\x3f\xab\xb5\xd5\xf4\x0c\xe2\xd8\x52\xfa\xb3\x9b\x3b\x61\x4f\xda\xf2\xc6\xd7\x9f\xba\x5a\xaa\xdd\xcb\xc4\xf8\xf4\xa1\xf7\x9c\xfb\x7b\x34\x99\xbb\x4b\x6f\x31\x9e\xba\x1e\x32\x3d\xf0\x4e\x1d\x7c\xff\x59\x4c\x1f\xc7\x8b\xef\xe8\x6f\xe7\x3b\x1a\x99\x1f\x4a\xba\xd1\xe9\xaf\x02\x4d\x74\xda\xc3\x42\xa7\x0d\x9d\x68\x9a\xf0\xab\xc0\xad\xa0\xc5\xce\x60\x05\x2e\xcc\x17\x80\xeb\x8d\xc0\x86\xab\x2d\x17\x40\xea\x92\xb7\x41\x6a\xcb\x25\x90\x96\x3a\xb3\x12\xd8\xea\xf1\x52\xba\xbc\xb0\xea\x79\x8e\x95\x77\x21\x41\x3d\xf0\xa5\x11\x97\xea\xb1\x1e\xbc\x5c\xb4\xf5\x44\xf9\xd0\x30\x75\xef\x9d\x6f\xe7\xa5\x48\x83\x03\x2e\x9d\xa9\xe7\xae\xbd\xa8\xd1\x6a\x39\x75\x3f\xa1\xb5\x4c\x00\xd0\xa8\x78\xc0\x20\x58\xb9\xd3\x2f\xab\x73\x9e\x8c\x40\x7f\xea\xaa\x80\x9f\xa1\x66\x5e\xdd\x10\xf3\xaf\xdb\x2d\x88\xda\xab\x41\x84\x7c\x83\x68\x15\x41\xfb\xf5\x17\xe1\xd4\x9a\x0f\x38\x3b\x83\x17\xc8\xda\x62\x22\x2a\x97\x7a\xac\x53\xf7\x3d\xe0\x94\xd1\x1f\x25\x2c\x6d\x39\x17\xb3\x3c\x82\x5c\x97\xc4\xbb\x3e\x7e\x22\x69\xa0\xb2\xb7\xc2\x83\xba\x05\x38\x3b\xb0\x96\xd8\x2d\xce\x66\x2c\xea\xa9\xeb\xec\x9c\x6b\x49\xc5\xb1\xd9\x6b\xf0\x73\xdc\x2a\x50\x23\x84\x52\xb0\x03\x8e\x72\xbb\x46\x8d\x68\x45\x27\xb6\x07\x76\x74\xe8\x11\xa0\xd9\x61\x6b\x60\x4b\x4e\x75\xd0\xbf\xf7\xef\x15\xed\x72\xf3\x84\xcf\x4e\x56\x0f\xf3\x85\x33\xfd\xe4\xea\xed\xca\xb4\x5d\xa1\x85\xf3\xe0\x2c\xd4\x99\x62\x69\xe9\x9e\xa3\xae\x53\x42\xe7\xa1\x44\x45\x57\xec\x01\x66\x64\x45\xa9\xdb\xa2\xd2\xd6\xd1\x9b\xcf\x2d\x3f\x3f\xa2\xee\xd3\xc8\x4f\x8f\xa5\x67\x9f\xfe\xa5\x6b\xe9\xc2\x8e\xfc\x53\x62\xfc\x7f\x00\x50\x62\xe5\x37\xba\x1f\x00\x00"),
+		},
+		"/app/seed.sql": &vfsgen۰CompressedFileInfo{
+			name:             "seed.sql",
+			modTime:          time.Date(2026, 10, 18, 19, 19, 58, 73829531, time.UTC),
+			uncompressedSize: 372,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x6c\xd0\x4f\x6b\x83\x40\x10\x05\xf0\xbb\x9f\xe2\xdd\x8c\xb0\x09\xf5\x9c\x53\x68\x3d\x08\x21\x2d\xd5\xf6\x2a\x53\x77\x4a\x16\xd7\xdd\xed\xfe\xb1\xf8\xed\x8b\x44\xb0\x29\xbd\x3f\xde\xef\xcd\xec\xf7\x68\x46\xd2\x1a\x92\x22\x21\x70\xc4\xa7\xf5\xd0\xb6\x27\x0d\xc9\x13\x6b\xeb\x46\x36\x51\x20\x5e\x19\xa7\x97\x1a\x03\xcf\x01\x63\x0a\x11\xc6\x46\x7c\x30\x52\x60\x09\x32\xf3\xf7\x95\x3d\x83\x75\xe0\x43\x96\xd5\x97\xa6\x7a\x6d\x51\x5f\xda\x67\x50\xdf\xdb\x64\x22\x76\x4a\x0a\x18\x1a\x59\x80\x9c\x1a\x78\x16\x50\xa1\x23\x39\x2a\x53\xe0\xfd\x74\x7e\xab\x1a\xec\x4a\x81\xfc\xe9\xe6\xb2\xcf\x05\x72\xc9\x53\x0a\xec\x07\x9e\x73\x81\xe8\x13\x17\xc7\xfb\x76\xe7\xb4\xea\x29\x2a\x6b\x7e\x0b\x13\xfb\xa0\xac\xd9\xa8\x75\x45\xa7\xe4\xbf\xd8\x72\xe4\xc2\x95\x87\x87\x55\x25\xe7\x6e\x68\x59\x1c\xb3\xac\xa9\xce\xd5\x63\xbb\x3c\x68\x22\xbd\xcb\xb7\xb6\x2e\xf0\xd7\x1a\xfa\x9b\xd9\x96\xdd\xe7\x7e\x06\x00\xb7\x99\xd3\xe0\x74\x01\x00\x00"),
 		},
 		"/fingerprint": &vfsgen۰DirInfo{
 			name:    "fingerprint",
-			modTime: time.Date(2019, 10, 5, 19, 36, 4, 321211823, time.UTC),
+			modTime: time.Date(2026, 10, 18, 20, 11, 29, 954664396, time.UTC),
+		},
+		"/fingerprint/extensions.sql": &vfsgen۰CompressedFileInfo{
+			name:             "extensions.sql",
+			modTime:          time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
+			uncompressedSize: 120,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x72\x0e\x72\x75\x0c\x71\x55\x70\x8d\x08\x71\xf5\x0b\xf6\xf4\xf7\x53\xf0\x74\x53\xf0\xf3\x0f\x51\x70\x8d\xf0\x0c\x0e\x09\x56\x48\x4c\xce\x2f\x2d\x2e\xc9\x4c\x51\x08\xf7\x0c\xf1\x50\x08\x76\xf6\x70\xf5\x75\x54\x28\x28\x4d\xca\xc9\x4c\xb6\xe6\x22\xa0\x37\x33\xaf\x24\xb1\xa8\x28\xb1\x12\xab\x5e\xc0\x00\xeb\x07\xef\x05\x78\x00\x00\x00"),
+		},
+		"/fingerprint/migrations": &vfsgen۰DirInfo{
+			name:    "migrations",
+			modTime: time.Date(2026, 10, 18, 20, 11, 29, 942013321, time.UTC),
+		},
+		"/fingerprint/migrations/2010_01_01_initial.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2010_01_01_initial.sql",
+			modTime:          time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
+			uncompressedSize: 9321,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x58\x4d\x6f\xdb\x38\x10\xbd\xfb\x57\xcc\x2d\x31\x50\x14\xed\x6d\x51\x23\x0b\xb8\x0e\xd3\x08\x75\xe4\xad\x2d\x6f\x53\x2c\x16\x02\x25\x51\x0a\x61\x89\x72\x29\xaa\x71\xf6\xd7\x2f\xa8\x2f\x8b\xfa\x96\x13\x37\xc9\x25\x16\x39\x7c\x6f\xf8\x86\x9c\x19\x69\x32\xd9\x20\x03\x22\x81\x05\x09\x08\x13\xa6\xa0\x01\x09\x63\x01\x57\xf0\x61\x96\x4c\xf9\xa1\xbd\xab\x8f\x52\xc7\x27\x26\x65\xa6\xe0\x98\x45\xd8\x16\x34\x64\x66\x44\xa2\x48\xfe\xaf\x19\xdb\x3e\x95\xd0\x84\xd9\xa1\x43\x99\x07\x57\x70\xb1\x35\x6e\xfe\xb8\x98\xe5\xdc\xcc\xc1\xdc\x31\xed\x90\xb9\x21\x0f\x28\xf3\xcc\x48\x70\xca\xbc\x08\xae\x20\x64\x19\xc6\x03\xb1\x77\xa6\x1b\xb3\x94\xcb\x0a\x1d\x4a\xe4\xbc\x8b\xfd\x88\xa4\x26\x87\xc0\x0f\xf7\x72\x16\xae\xc0\x0e\x99\x20\x4c\x28\xfc\x01\x65\x66\x40\xa2\x08\x7b\xc9\xca\x47\xcc\x19\x65\x5e\x6a\xc2\xc3\x47\x33\x22\x76\xcc\xa9\x78\x92\xac\xae\x3b\x9b\x4c\x26\x8b\x35\x9a\x1b\x08\xd0\xbd\x81\xf4\x8d\xb6\xd2\x41\xbb\x01\x7d\x65\x00\xba\xd7\x36\xc6\x06\xb0\x1d\xc6\x91\xa0\x0e\x7c\xd7\x8c\x5b\xd8\x2c\x6e\xd1\xdd\x1c\xf6\xb1\xe5\x53\x5b\xae\x9e\xf4\x23\x50\x26\x30\xe7\xf8\xa9\x1d\x41\x3a\xe7\x10\x17\xc7\xbe\x30\x05\xb6\x7c\x12\xed\xb1\x4d\xa4\x84\x17\x33\x75\xf6\x91\x8a\x07\x33\xa4\x4e\x49\x95\x82\xdf\x98\x7f\x5e\xa2\x0c\xf8\xbd\x4b\x99\x47\xf8\x9e\x53\x26\xe0\x72\x02\x00\x40\x1d\xe9\x09\xf1\x08\x4f\x9c\xd3\xb7\xcb\xe5\xbb\x64\xc2\xe6\x04\x0b\xe2\x80\x0c\x69\x24\x70\xb0\x07\xc9\x92\x3c\xc2\x7f\x21\x23\x70\x8d\x6e\xe6\xdb\xa5\x01\x2c\x7c\xbc\x9c\x56\x16\x97\x79\x32\xf8\x7f\xfe\xad\xd8\xf8\x84\x79\xe2\xa1\x85\xdd\xa2\x82\x63\x41\xf2\xd9\x0c\x35\xe4\x01\x16\x26\x75\xd4\x61\xc1\xb1\xbd\x33\x5b\x37\x12\xc5\x56\x40\xd3\xe3\x69\x87\x31\x13\x2d\x66\x8b\x95\xbe\x31\xd6\x73\x4d\x37\xca\xde\x9b\x99\x23\x66\x72\x08\x61\x71\x8b\x16\x5f\xe1\xf2\x32\x1b\x85\x3f\xe1\xc3\x74\xda\xb9\x3e\xdd\x65\x65\x79\x3a\x98\xae\x9e\x4c\x67\xa5\xd3\xb2\x41\xdf\xb6\x48\x5f\x34\x05\xcc\xa4\x8e\x19\x91\x9f\x09\xd9\x7c\x93\xef\x22\x79\xdc\x18\xf3\xb5\x91\x1e\xa4\x8f\xc9\x80\xa6\x2f\xd6\xe8\x0e\xe9\x06\x7c\xfe\x91\x0d\xe9\x2b\xb8\xd3\xf4\xbf\xe7\xcb\x2d\x2a\x9e\xe7\xf7\xc7\xe7\xc5\x7c\x71\x8b\xe0\x63\xe2\xcc\x7c\x69\xa0\xf5\x00\x5f\x60\xf5\x5d\x47\xd7\x92\xa3\x6e\xf2\x9e\x3a\xb3\x49\xdb\x31\x0c\x39\xa1\x1e\xa3\x4e\xdf\x21\xfc\x45\x98\x13\xf2\xf6\xd8\x32\x1c\x10\x10\xe4\x20\x8a\xf1\x1e\x39\x73\xe2\xb2\x98\xbf\x49\xbd\x0a\x75\x5d\xbb\xdc\x60\x90\x72\x66\x2a\x4d\x9f\x80\x89\x40\xf6\x03\xe6\xd8\x16\x84\xc3\x2f\xcc\x9f\x64\x1a\x1e\xab\x56\x11\x87\x57\x13\x4d\xf1\xa0\x5d\xbb\xcc\xae\x4b\xc2\x80\x08\xdc\x27\x5b\x92\x52\xea\xba\xa5\x93\x98\x0b\x1a\x89\xd6\x59\xdf\x8a\x83\xce\x49\xb3\x1b\x20\x4d\x67\x2c\x54\x93\x9c\x43\x23\xbb\x36\xf8\x44\x30\xcf\x47\xba\x43\x29\x37\xfd\xfb\xc3\x57\x62\xad\x85\x4c\xce\x75\x85\x29\x0d\xc1\x39\x8b\x94\x47\x1d\x88\x63\xea\x54\x86\x19\x79\x2c\x25\x9c\xc9\xb4\xdb\x43\x73\x70\x2e\xeb\x29\x53\xe5\xfc\x70\x8e\xdd\xf6\x96\xc1\xee\x03\x54\xd9\xec\xab\x17\xa3\x66\x7f\x6a\xa7\xac\x62\x56\x39\x70\xcd\xa0\x6f\x63\x6b\x5d\x1b\xea\xbd\x37\x66\x60\x3d\xfb\x40\x06\x56\xf3\xfd\x38\xef\x29\x2c\xb2\x9d\x6c\x78\x1d\xb0\xc2\xd0\x27\x98\x15\x98\x49\x77\x3b\xea\xc8\xca\x6d\xbc\x91\x90\x96\x5c\x69\x39\xa8\xd2\x62\x40\x70\x87\x56\xb0\x8e\xe0\xa6\x99\xf9\xcd\x26\x9a\x6a\xbd\x7a\xcd\xa8\x75\x14\xb1\xa3\x45\x7f\xd4\xf6\xf1\xb3\xaf\xe4\x3e\x7e\x8d\x2b\x39\x24\x5e\xd2\xb3\x37\x12\xaf\x92\x2b\x2d\xf1\x92\x16\x79\xbc\x52\xa4\x34\x5c\x2b\x7d\xd9\xf4\x26\x03\xa9\xcd\x62\xb5\xdc\xde\xe9\x32\x76\xf2\xd5\xbb\x50\x94\x1c\xc4\x2f\xec\x5f\x5e\xb4\xbe\x24\x5d\x7c\xfa\xc4\x89\x67\xfb\x38\x8a\xa6\xdd\x94\x45\x27\x31\x8a\xb0\x52\x02\xc7\xd3\xe5\xaf\x12\xa7\xb1\x2a\x8d\xf9\x60\xf2\x24\x85\x8d\x21\x2c\xdd\xc2\xc1\x24\x49\xb4\x47\xb1\x94\x4b\xef\x38\x1a\xf3\xb4\xe8\x35\xb7\x31\x23\xa9\x93\x4a\x3d\x9e\x35\xb0\x4e\x26\x1c\x1b\xbd\x5a\x26\x1d\x49\x98\x24\xbe\xf1\x84\xa5\x54\x30\xfc\x5a\x1c\x2f\x70\x9a\xc9\xae\xaf\xdb\xbe\xed\xec\x77\xe4\x09\xfe\x5a\x6b\x77\xf3\xf5\x0f\xf8\x8a\x7e\xc0\x25\x75\x06\xde\xb9\x46\xe8\x7c\xf2\x39\xc0\xd9\x7d\xec\xc6\xcf\xee\xec\x78\x1a\x19\xc1\x26\xe8\x24\xb2\xe3\xe1\x2a\xa7\xbf\x09\xb9\x7a\x41\x4e\x25\x09\xac\x2e\xfc\xc0\x7a\x0e\x74\x8b\x28\xc7\xd9\x53\xa1\xdb\x51\x4f\xf5\x55\xde\x88\x0e\xd4\xb8\x53\x86\xac\x03\xd0\xf4\x6b\x74\x0f\x6a\xa1\x3b\x64\x1f\x3a\x61\xa5\x37\x55\xd0\xed\x46\xd3\xbf\x80\x25\x38\x21\x90\x7d\xfd\x1c\x00\x59\x74\x43\x03\x40\x73\xdb\x26\xd8\x52\x76\x3d\xe4\xc5\xae\x04\x99\x4f\xab\x80\x45\x5d\x53\x10\xb7\xba\xf6\x6d\xdb\x05\x6c\x26\x5f\xdc\x86\xa3\xbf\x4b\x3e\xd1\x0d\xe1\x28\x96\x1c\x5a\x39\xf2\xcd\x29\x54\x35\xfc\x14\xb8\x5e\x79\x0e\xca\x53\x09\xbf\x62\xaa\xc2\x97\xd7\xb4\x6f\xa3\x89\xad\x28\xb6\x47\xe4\x81\x9c\xf9\xca\x77\x70\x3a\x7b\xcc\xe8\xcf\x17\xe4\x2b\x13\x49\x78\xaf\xbe\x1b\x15\xd3\xeb\x01\xc8\x3e\x43\x75\x62\xa4\x36\x53\xf8\x7e\x8b\xd6\x28\x7f\x04\x6d\x53\xb4\xee\xad\x0c\x59\xed\x3f\x24\x3f\x6a\x32\x24\x83\x0a\x93\x1c\xe9\xd3\xb7\xc0\x2c\x02\x3b\x10\xfc\x28\xef\x28\x9a\xc6\x08\x8e\x42\x57\x60\xd3\xe6\xe4\x90\xff\xa8\x23\xcb\xa6\x47\x15\x25\xb5\xec\x75\x38\x47\x3e\xea\x22\xa1\xfa\xf1\x4b\x9e\x8f\x64\x6a\x96\x66\x24\x41\x19\x39\xeb\xa4\x0e\xc9\x8f\x1a\x72\x32\xa8\x20\xef\xe3\x0a\x58\x83\xb7\x05\x66\xa1\xcb\x40\xf0\xa3\xdb\xa3\x68\x1a\x45\x19\x86\xfe\xba\x7f\x2f\xd0\xaa\xba\xbb\x63\x35\xbd\x59\xad\x91\xf6\x45\x4f\x6b\x7b\x51\x37\x61\x8d\x6e\xd0\x5a\xbe\x4e\x6f\x14\x85\x5e\xaa\xa7\x75\x77\xc7\x0a\xa6\xba\x70\xac\xb4\x0d\x3e\x54\x6b\xdb\x73\x1b\x27\x77\x97\x67\x56\xc5\x87\x3c\x93\x9e\x2a\xc2\x09\x9d\xac\xab\x56\x24\xd5\x1f\xa5\xce\x74\xc9\x72\x26\xcf\xce\x75\x58\x06\x37\xe3\xe7\xf7\xa1\xbf\x6b\x77\x8b\x8c\xaa\xba\x90\x27\xcb\x06\x0f\xe4\xd4\x8b\x3a\x70\x5e\x11\x06\xbc\x0e\xbc\x80\x0f\xff\x0f\x00\x6e\xe4\x10\x98\x69\x24\x00\x00"),
+		},
+		"/fingerprint/migrations/2020_03_01_fingerprint_created_index.sql": &vfsgen۰FileInfo{
+			name:    "2020_03_01_fingerprint_created_index.sql",
+			modTime: time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
+			content: []byte("\x42\x45\x47\x49\x4e\x3b\x0a\x0a\x43\x52\x45\x41\x54\x45\x20\x49\x4e\x44\x45\x58\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x5f\x69\x64\x78\x5f\x63\x72\x65\x61\x74\x65\x64\x20\x4f\x4e\x20\x66\x69\x6e\x67\x65\x72\x70\x72\x69\x6e\x74\x20\x28\x63\x72\x65\x61\x74\x65\x64\x29\x3b\x0a\x0a\x43\x4f\x4d\x4d\x49\x54\x3b\x0a"),
+		},
+		"/fingerprint/migrations/2020_03_03_drop_duplicate_indexes.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2020_03_03_drop_duplicate_indexes.sql",
+			modTime:          time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
+			uncompressedSize: 150,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x72\x72\x75\xf7\xf4\xb3\xe6\xe2\x72\x09\xf2\x0f\x50\xf0\xf4\x73\x71\x8d\x50\x28\x29\x4a\x4c\xce\x8e\xcf\x4d\xca\x4c\x89\xcf\x4c\xa9\x88\x2f\xcd\xcb\x2c\xb4\xc6\x94\x2f\x28\xc5\x2f\x9f\x9b\x5a\x92\x88\x4f\x3e\x2d\xbf\x28\x35\x33\x3d\x0f\xc5\x10\x2e\x67\x7f\x5f\x5f\xcf\x10\x6b\x2e\xc0\x00\xf0\xa3\x9a\x79\x96\x00\x00\x00"),
+		},
+		"/fingerprint/migrations/2020_03_03_meta_gid.sql": &vfsgen۰FileInfo{
+			name:    "2020_03_03_meta_gid.sql",
+			modTime: time.Date(2026, 10, 18, 20, 12, 24, 270016550, time.UTC),
+			content: []byte("\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x6d\x65\x74\x61\x20\x41\x44\x44\x20\x67\x69\x64\x20\x75\x75\x69\x64\x3b\x0a\x43\x52\x45\x41\x54\x45\x20\x55\x4e\x49\x51\x55\x45\x20\x49\x4e\x44\x45\x58\x20\x43\x4f\x4e\x43\x55\x52\x52\x45\x4e\x54\x4c\x59\x20\x6d\x65\x74\x61\x5f\x69\x64\x78\x5f\x67\x69\x64\x20\x4f\x4e\x20\x6d\x65\x74\x61\x20\x28\x67\x69\x64\x29\x20\x57\x48\x45\x52\x45\x20\x67\x69\x64\x20\x49\x53\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x3b\x0a"),
+		},
+		"/fingerprint/migrations/2020_03_03_more_created_updated_indexes.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2020_03_03_more_created_updated_indexes.sql",
+			modTime:          time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
+			uncompressedSize: 607,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x72\x0e\x72\x75\x0c\x71\x55\xf0\xf4\x73\x71\x8d\x50\x70\xf6\xf7\x73\x0e\x0d\x0a\x72\xf5\x0b\xf1\x89\x54\x48\xcb\xcc\x4b\x4f\x2d\x2a\x28\xca\xcc\x2b\x89\xcf\x4c\xa9\x88\x2f\x2d\x48\x49\x2c\x49\x4d\x51\xf0\xf7\x43\x96\x52\xd0\x80\x8a\x6b\x2a\x84\x7b\xb8\x06\xb9\x2a\xc0\x94\x79\x06\x2b\xf8\xf9\x87\x28\xf8\x85\xfa\xf8\x58\x73\x71\xe1\xb6\xa6\xa4\x28\x31\x39\x1b\x6c\x41\x72\x51\x2a\xcc\x02\xb0\xa0\x82\x06\x54\x44\xd3\x9a\x28\xfd\x48\x0e\x84\xea\xa7\x8a\xd3\x0a\x4a\x33\x53\xb0\xba\x0f\x2c\x43\x8a\x23\xe1\x26\xa1\xbb\x14\x6a\x12\x55\x9c\x9b\x9b\x84\xcb\xb9\xb9\x49\xa4\x39\x17\x6e\x12\x86\x73\x21\x26\x11\xe3\x5c\xc0\x00\x73\xdb\x48\x1d\x5f\x02\x00\x00"),
+		},
+		"/fingerprint/migrations/2026_10_18_add_updated_columns.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2026_10_18_add_updated_columns.sql",
+			modTime:          time.Date(2026, 10, 18, 20, 11, 29, 948282398, time.UTC),
+			uncompressedSize: 636,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xac\xd0\xcd\x6a\x02\x31\x14\x05\xe0\xbd\x4f\x71\x1e\xc0\x11\xb1\xcb\xae\x6c\xb5\x20\x58\x85\x3a\x85\xee\x86\x38\x39\x4e\x42\x4d\x32\xdc\xdc\x41\xed\xd3\x97\xd1\xfe\xd0\x75\x07\xb2\xc9\xdf\x77\x0e\xb7\x28\x50\x3a\xa2\x6b\xad\x51\x5a\xd4\xe9\xd8\x85\x98\x71\xa2\x10\xc6\x5a\x5a\xec\x2f\x70\x26\x5a\xec\x79\x48\x42\x04\xdf\x88\x51\x9f\xbe\x1f\x09\xeb\x24\x96\x76\x8c\x9c\x10\xd3\xef\x3d\x6a\xa1\x51\x66\xa8\x63\x18\x8f\x8a\x02\xe6\xa8\x2e\x75\x8d\xeb\x4f\xc0\x73\x9b\x44\x33\x7a\x7a\x36\x9d\x4d\xab\xe9\x5d\xbf\x42\x12\x56\xb7\x9f\xb6\xfa\x6a\x55\xf9\x68\x79\x66\x46\x97\x79\xd5\x26\x28\x9d\xcf\x48\xf1\x78\xe9\x4b\xde\x22\x70\x72\x14\xf6\x39\xea\x78\x81\xb9\x76\xcd\xd9\xc7\x66\x32\x9a\xaf\xcb\xe5\x0b\xca\xf9\xc3\x7a\x89\x83\x8f\x0d\xa5\x15\x1f\x15\xf3\xc5\x02\x8f\xdb\xf5\xeb\xf3\x06\xab\x27\x6c\xb6\x25\x96\x6f\xab\x5d\xb9\xfb\x99\x87\xfa\xc0\xac\x26\xb4\x38\x79\x75\xd7\x2d\x3e\x52\xe4\xfd\x1f\x52\xc5\xd4\xef\x83\x62\x55\xd8\x7b\x3b\xac\xd8\x76\x43\x8b\x81\x6a\xfe\x23\x7e\x0e\x00\xc2\xc2\xbb\x77\x7c\x02\x00\x00"),
+		},
+		"/fingerprint/migrations/2026_10_18_change_log.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2026_10_18_change_log.sql",
+			modTime:          time.Date(2026, 10, 18, 18, 52, 31, 809731672, time.UTC),
+			uncompressedSize: 1702,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x92\xcd\x6e\xa3\x4a\x10\x85\xf7\x3c\xc5\x59\x58\x32\x48\x28\x2f\xe0\x55\x07\xca\x0e\x0a\x69\xa2\xa6\xb9\x4e\x56\x88\x98\x0a\x69\xc5\x06\x2e\xe9\x28\xb9\xf7\xe9\x47\xc6\xf8\x77\x92\x8c\x47\x93\x59\xd2\x55\xc5\x39\xdf\xa9\x0a\x14\x09\x4d\xd0\xe2\x32\x26\x2c\x9e\x8a\xba\xe2\x7c\xd9\x54\x70\x1d\x00\x30\x25\x1e\x4c\xf5\xc2\x9d\x29\x96\x90\x89\x86\xcc\xe2\xd8\xef\x4b\x8b\x8e\x0b\xcb\x25\xac\x59\xf1\x8b\x2d\x56\x2d\xde\x8c\x7d\xea\x3f\xf1\x7f\x53\x33\x42\x9a\x8a\x2c\xd6\xa8\x9b\x37\xd7\x3b\x19\xb6\xc5\xc3\x92\xf3\xba\x58\x31\x2c\xbf\xdb\x93\x6a\xd3\x72\x57\x58\xd3\xd4\x1f\x15\x3b\x5e\x34\x5d\x99\x9b\x12\xa6\xb6\x5c\x71\x77\x52\xaf\xf9\xed\xa0\xb8\x79\x0b\x12\x99\x6a\x25\x22\xa9\x0f\x10\xf3\xf6\x99\xff\xc3\xad\x8a\x6e\x84\xba\xc7\x35\xdd\xc3\x35\xa5\xf7\xd5\xc0\xce\x57\xbe\x78\xe2\xc5\x33\x82\x2b\x0a\xae\xe1\xee\x9e\x11\x49\xb8\xe3\x92\x97\x6c\x79\xec\x63\xbc\xe2\xae\xe2\xb1\xe7\x39\xde\xc4\x71\x86\xa0\x23\x19\xd2\xdd\xe1\x4f\x4d\xf9\x9e\x6f\xb3\x4c\xe4\xd1\x0a\x86\xe7\x83\xe9\x69\x26\x03\x1d\x25\x12\xeb\xc9\x8d\x90\xeb\x41\x91\xce\x94\x4c\x61\x3b\x53\xad\x03\x11\x29\x46\x23\xe7\x92\x66\x91\xec\x69\x22\x99\x92\xd2\x88\xa4\x4e\x8e\xfe\xbf\xdf\x82\xbf\xcf\xdc\xdf\x27\xec\xe1\x1f\x11\x67\x94\xc2\xd5\xb3\xbc\xbf\x90\x5c\x8a\x1b\xf2\xb1\x67\x4c\xe2\xf0\xc2\xac\x1d\x02\x18\x7c\xac\xdf\x26\x0e\xc9\x70\xe2\x8c\x46\x88\x85\x9c\x65\x62\x46\x68\x97\x6d\xf5\xf2\xef\xf2\x13\x16\xdb\x15\x8b\xe7\xbc\x0f\xec\x2f\x02\xf9\xc3\x75\x7c\x0e\xb6\x59\xd9\x96\xcb\x87\xa4\xf9\xc5\x30\x73\xc4\x28\x69\x7e\x0e\xa3\x56\xd1\x6c\x46\x0a\x1b\xbc\x35\x68\x2f\x00\x31\xd5\xa4\x90\xdd\x86\xeb\xa6\x64\xba\x3d\xda\x44\x6e\x3a\x7b\xa5\x69\xa2\x40\x22\xb8\x82\x4a\xe6\x98\x5f\x91\x84\xbb\x77\x83\x28\xdd\xdd\x3d\x84\x0c\x7b\xc3\xfb\x52\x18\xa5\x3a\x92\x81\xc6\x54\x25\x37\x87\x10\xa0\x3b\x0a\x32\x4d\xb8\x55\x49\x40\x61\xa6\xe8\xe7\xf8\xbf\xb2\xbf\x59\xfc\xe0\x3f\xa4\x98\x34\xed\x5c\x1f\x3b\xfe\x58\x68\x7b\xb3\x93\x53\x89\x47\x53\x57\xdc\xb5\x9d\xa9\xed\x57\x42\x07\x6d\x7f\x24\x37\x00\x3f\x98\xf2\x97\x58\x7d\xd7\x37\x88\xb5\xaf\xe7\x88\xb5\xaf\xdf\x22\xb6\x62\x5b\x9c\x41\xc6\xb6\xf8\x06\xb1\xc7\xa6\x63\x53\xd5\xe7\xe0\xed\x5a\x7f\x4f\xf6\xc7\x00\xa3\x01\xe9\xce\xa6\x06\x00\x00"),
+		},
+		"/fingerprint/migrations/2026_10_18_foreignid_created_updated.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2026_10_18_foreignid_created_updated.sql",
+			modTime:          time.Date(2026, 10, 18, 20, 9, 27, 63765474, time.UTC),
+			uncompressedSize: 719,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x90\x41\x8f\x9b\x30\x14\x84\xef\xfc\x8a\x39\x44\x6a\xb2\x6a\xf2\x07\x50\x0f\x04\x5e\x58\x24\x6a\x56\x8e\x51\x7a\x8b\x2c\xf0\x82\x55\x62\x53\xe3\x2d\x69\x7f\x7d\x85\xb7\xdb\xed\x46\xed\x69\x6f\xe0\xf7\x66\xe6\x7b\xb3\xdd\x82\xae\x7a\xf2\xda\x74\x70\x76\x9e\xd0\x29\x0f\xdf\x2b\x78\x7d\x51\xb0\x8f\xe1\xfb\xa2\x3b\x27\xbd\xb6\xe6\x23\x26\xbb\xbc\xfc\x80\x74\x0a\x72\x18\xa0\x4d\x33\x3c\xb5\xaa\x85\x36\x61\xd5\xa8\xab\x87\xba\x8e\xd6\xf9\x5d\x94\x94\x82\x38\x44\xb2\x2f\x09\x8f\xd6\x29\xdd\x19\xdd\x9e\xbf\x2b\xd3\x5a\x87\x24\xcb\xd0\x38\x25\xbd\x6a\x43\xd8\xe4\xe5\x65\xc4\xac\x7d\x1f\x7e\xf1\xd3\x1a\x85\x8c\x0e\x49\x5d\x0a\x18\x3b\xaf\x37\x60\x95\x00\xab\xcb\x32\xfe\xb7\xf3\xfb\x2c\xa3\xed\x16\xa2\x57\x90\xe3\x38\xe8\x26\x9c\x8b\xd6\xaa\xc9\x7c\xf0\x98\x94\xc7\xd3\xd8\x06\xe3\xb9\x57\x06\xda\xa3\xe9\xa5\xe9\xd4\x04\x09\xef\x64\xf3\xf5\xfc\x8a\xe1\xec\x1c\x8a\x5a\x26\xba\xeb\x94\x0b\x3e\x6f\xeb\xb8\xd5\x2c\xe8\x2f\x09\xff\x43\x8f\xa3\x28\xe5\x94\x08\xc2\xa1\x66\xa9\x28\x2a\xb6\x80\x9d\x7f\xcb\xd6\x1b\x70\x12\x35\x67\xc7\x3f\xb1\xc9\x11\xab\x55\xb4\xa7\xbc\x60\x11\x00\x30\x3a\xed\x5e\x42\x3e\x3d\x17\x10\x87\xc1\xb3\x70\x99\xc7\x11\xb1\x2c\x8e\x56\x2b\x94\x09\xcb\xeb\x24\x27\x8c\xc3\xd8\x4d\xdf\x86\xd7\x74\xc1\x8b\x3c\x27\x7e\x7b\xc3\xf9\x2f\x18\xec\xe9\x50\x71\x42\xfd\x90\x2d\x8a\x8a\xdd\x2e\x87\xd8\x43\xc5\x41\x49\x7a\x0f\x5e\x9d\x70\xba\x27\x86\x75\x55\x66\xbb\x3b\x14\x47\x64\xc5\x51\x14\x2c\x15\x38\xf0\xea\x73\x20\xbf\xdb\x80\xbe\x50\x5a\x0b\xc2\x03\xaf\x52\xca\x6a\x4e\x6f\x0b\x88\xa3\x5f\x03\x00\x3b\xfd\xe5\x53\xcf\x02\x00\x00"),
+		},
+		"/fingerprint/migrations/2026_10_18_foreignid_created_updated_indexes.sql": &vfsgen۰CompressedFileInfo{
+			name:             "2026_10_18_foreignid_created_updated_indexes.sql",
+			modTime:          time.Date(2026, 10, 18, 19, 1, 52, 585480704, time.UTC),
+			uncompressedSize: 353,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x72\x0e\x72\x75\x0c\x71\x55\xf0\xf4\x73\x71\x8d\x50\x70\xf6\xf7\x73\x0e\x0d\x0a\x72\xf5\x0b\xf1\x89\x54\x48\xcb\x2f\x4a\xcd\x4c\xcf\xcb\x4c\x89\x2f\x4b\xcd\x4b\xc9\x2f\x8a\xcf\x4c\xa9\x88\x4f\x2e\x4a\x4d\x2c\x49\x4d\x51\xf0\xf7\xc3\x90\x57\xd0\x80\x4a\x6a\x5a\x73\x11\x63\x2a\x2e\xe3\x90\xcd\xc1\x63\x50\x49\x51\x62\x72\x76\x3c\x4e\xe3\xd0\xa4\x89\x73\x1c\x36\x33\x4b\x0b\x52\x70\x9a\x09\x95\xd3\x54\x08\xf7\x70\x0d\x72\x55\x80\x29\xf5\x0c\x56\xf0\xf3\x0f\x51\xf0\x0b\xf5\xf1\xb1\xe6\x02\x0c\x00\x56\x91\xfc\x5b\x61\x01\x00\x00"),
 		},
 		"/fingerprint/schema.sql": &vfsgen۰CompressedFileInfo{
 			name:             "schema.sql",
-			modTime:          time.Date(2019, 10, 5, 19, 36, 4, 321211823, time.UTC),
-			uncompressedSize: 9321,
+			modTime:          time.Date(2026, 10, 18, 20, 11, 29, 954664396, time.UTC),
+			uncompressedSize: 11034,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x5a\x5d\x6f\xe2\x3c\x16\xbe\xe7\x57\x9c\x8b\x4a\x85\x11\x1a\xed\xdc\x0e\xda\x95\x52\x30\x6d\x34\xd4\x99\x09\x61\x3b\xa3\xd5\x2a\x0a\xc4\xa4\x51\x21\x30\x21\xcc\x30\xef\xaf\x7f\xe5\xd8\x8e\x9d\xc4\xf9\x02\xda\xea\xbd\xa9\x88\x9d\xf3\x3c\xe7\xcb\xe7\xd8\x6e\xc6\x36\x32\x1c\x04\x8e\x71\x37\x43\xb0\x7a\xf6\xa2\x80\xb8\x9b\x5d\x00\xfd\x1e\x00\x40\xe8\xc3\x32\x0c\xc2\x28\x01\x6c\x39\x80\x17\xb3\xd9\x30\x1d\x5f\xc5\xc4\x4b\x88\x0f\x49\xb8\x25\x87\xc4\xdb\xee\xe1\x77\x98\x3c\xa7\x8f\xf0\xd7\x2e\x22\x30\x41\x53\x63\x31\x73\x20\xda\xfd\xee\x0f\x0a\xc2\x89\xb7\xdc\x10\x37\xf2\xb6\x04\x12\x72\x2a\x42\xef\xf6\x24\xf6\x92\x70\x17\xe9\x26\x63\xb2\xda\xc5\xbe\x1b\xfa\x10\x46\x09\x09\x48\x5c\x98\x8f\xc8\x6f\x65\x92\x8d\x8d\x2d\x3c\x77\x6c\xc3\xc4\x8e\x62\x9f\x9b\xd1\xb8\xab\x67\xb2\x7a\x81\xf1\x03\x1a\x7f\x81\xbe\x64\x37\x31\xf4\x6f\x7d\xb2\x21\x09\xb9\x1d\xc2\xed\x96\xc4\x01\xb9\x1d\x0c\x7a\x83\x51\xaf\xc7\x9d\x36\x47\xdf\x16\x08\x8f\x55\xbf\xb9\xa1\xef\x1e\xc8\xcf\x94\x78\xee\x18\xb6\x03\x4f\xa6\xf3\x00\x9f\xd2\x01\x13\x8f\x6d\xf4\x88\xb0\x03\x77\x3f\xf8\x10\xb6\xe0\xd1\xc4\xff\x35\x66\x0b\x94\x3d\x1b\xdf\xe5\xf3\xd8\x18\x3f\x20\xf8\x34\xea\xf5\x8c\x99\x83\xec\x1a\x4a\xb0\x9e\x30\x9a\x50\x64\x39\xf5\x31\xf4\x47\xbd\x4c\x5d\x16\xe3\x75\x18\x05\x24\xde\xc7\x34\xa8\x59\x90\xf5\xde\xbc\x28\xca\x2a\x0f\x87\xff\xdf\xff\x0b\xef\x6c\x48\x14\x24\xcf\x15\xec\xcb\x30\x89\xbd\x84\xe4\x83\xb9\xde\xc5\x5b\x2f\x29\xc5\x38\x89\xbd\xd5\x4b\x75\x5a\x1c\x8e\xcb\x6d\x78\x38\xa4\xd1\xde\x1d\xa3\xa4\xe2\xb5\xe3\xde\xaf\xb5\xb7\x94\x4f\x8a\x8d\x2e\x57\x37\x9f\x4e\x7d\x3e\x0a\xff\x81\x7f\x0d\x06\xb5\xf2\xcc\x17\x05\x71\x36\xc8\xa4\xb5\x99\xa7\x22\x28\xa9\x67\xcc\x85\x89\xaf\x9f\x89\x65\x15\x64\x2a\x2a\x73\xda\x5c\xdc\xc5\x24\x0c\xa2\xd0\x6f\xca\xc4\x5f\x24\xf2\x77\x71\xcd\xba\xaf\x28\x26\x67\x66\x70\xea\x6a\x8d\xb3\x85\xba\xaf\xb7\xca\xcb\xde\x2d\x70\x2a\xbe\x15\x33\xdc\xb3\x15\xae\x75\x99\xef\x9a\x3c\x9c\x7a\x70\xf5\xec\xc5\xde\x2a\x21\x31\xfc\xf2\xe2\x3f\x61\x14\xbc\x91\x3b\xb3\xf0\xbe\xbd\x57\x73\xd4\x1a\xe7\xf2\x17\xb4\x3e\xde\x92\xc4\x6b\xf2\x6b\x5a\x99\xca\x8e\x65\x93\x5e\x9c\x84\x87\xa4\x72\x76\xb3\x3c\x6e\x6b\x27\xdd\x7a\x00\x56\x15\xa3\x5d\xbe\x56\xfa\xe1\x61\x55\x1a\xfc\x43\xbc\x38\x3f\x12\x84\x3e\x1c\x8f\xa1\x5f\x11\x3c\x6a\xfb\x1b\x06\x4c\xa1\x93\x41\xa2\x83\xda\xc0\x30\xa7\xbf\x66\x77\x13\xde\x69\xb1\xff\x68\xea\x2a\xbd\x41\x85\x01\x6e\xeb\xf2\xd8\xd0\xfe\xd4\x1a\xf2\x1a\xce\xb8\x52\x7b\xad\xc8\xb4\x82\x2f\xde\xac\xcd\x95\x72\x50\xaf\x88\x4c\xc7\xc2\x7c\x21\x33\x0b\x30\xef\x6c\x85\x56\xf7\xea\xb5\xe4\x6e\x97\x17\x67\xe1\x76\xa9\x5f\x33\x6f\x91\x7a\x7e\x78\xa0\x87\x0e\x1f\x96\xbb\xdd\x86\x78\x51\x86\xb9\xf6\x36\x07\x72\xcd\x3c\xa5\x56\xbe\x77\x70\x15\x1d\x8a\xd9\x49\xa7\xea\xc2\xdc\xb6\xa3\xd5\x84\x99\x55\xea\x7f\x6a\x9d\x29\xf6\xb5\x77\x89\x9f\xae\xd9\xc9\xa9\x9a\xf8\xed\x8f\x17\x2f\xd3\xfd\xf1\xfd\x96\xe9\x45\x91\xa3\x8a\xbf\x77\xe4\x14\x1d\x8a\x91\xa3\x53\x22\x72\x4c\x96\x05\xce\xc2\x33\xf5\xc8\x0e\x6c\x6e\x6c\xcd\x16\x8f\x98\x06\x71\x8e\x1c\xe9\x5a\x72\x4a\x7e\x79\x9b\xfe\x6d\xe9\xf4\x7f\xfb\xf9\x73\x4c\x82\xd5\xc6\x3b\x1c\x06\x7a\x0a\xf5\x3c\xde\x8a\xa3\x7c\xae\x6b\x41\x92\xed\x58\xda\x51\x14\x7a\x69\x07\x02\x71\xac\xe9\xc8\x93\xdb\xee\x37\xd3\xa5\xf5\xb0\x15\x85\xb2\x66\x9b\x61\xd9\xfe\xb4\x15\xae\xda\xae\x5b\x02\xbb\x1d\xa3\xa0\xdf\xd7\xb4\x25\x4b\xdb\x7a\x07\x9e\xed\xb2\x3b\x45\xeb\x28\x94\xea\x67\x5b\x8a\xb4\xea\x75\xa0\x50\x16\x7a\x33\x85\x5c\xad\xac\x30\x4d\x26\x15\x97\x92\xfb\x17\xf2\x07\xbe\xda\xe6\xa3\x61\xff\x80\x2f\xe8\x07\xf4\x43\xbf\x79\x39\xeb\x40\x95\xe9\x4e\xa8\x22\x09\xb4\x98\x62\xf2\x2c\x44\xbe\xf4\xea\x81\xf9\xf2\xec\x80\x4f\x63\xad\xc3\xa4\xe3\x5d\x70\x0a\x8b\x40\x07\x59\x5c\x27\x9d\xd1\xb7\xcb\x3a\xe0\xed\xf2\x2c\xcc\x0a\xfb\xe5\x6c\x67\xcc\x6a\xb8\xce\xda\xd1\x65\x52\x03\x77\xac\xb5\x98\xb7\x79\x13\x4f\xd0\xf7\xfc\x7d\xf7\xc9\x15\xbb\x11\x0b\xe7\xfe\x69\xc1\x87\x07\xa3\x82\x74\xbe\x97\xe5\xc4\x73\xf7\xe1\x52\xbe\x56\x9c\x5f\xcb\x16\xa5\xd9\x70\x93\x70\xb6\xff\x2a\x8a\x8b\x89\x26\x00\xb1\x4b\x2a\xca\xf3\xf1\x01\x3c\x3d\x20\x1b\x65\x9b\x29\x73\x9e\xed\xb2\xa4\x5b\x16\xd8\xfc\xb6\x10\x04\xbc\x5e\x9e\xdc\x80\x69\xc5\x4e\x01\x41\x98\x41\x05\x61\x05\x0c\x57\x50\x69\x1d\x27\xd1\x98\x2d\x2c\xc7\xa1\x9f\xf5\xdd\xc1\x48\xab\x82\x0e\x82\xfd\x8b\xa8\x02\x67\x98\xde\x58\x96\x5c\x95\x83\x51\xa3\x2c\x21\x4a\x31\xae\x50\x24\xa3\x3a\x95\x15\xc9\x2e\x55\xeb\x95\x50\x20\x74\xba\x64\x28\x55\x69\x2b\x32\x22\x8b\x0c\xbf\xd8\x0a\xca\x49\x22\x5f\xe5\x77\x50\xf2\x6d\x36\x20\x62\xc9\x1f\xd5\x78\x56\x63\x29\x5a\x73\xb0\x8a\x15\x22\x45\x94\xec\xe4\x22\x5d\xf2\x52\x45\xe3\x3d\xf6\x94\xfe\xc8\xf0\xd8\x53\x9f\xfe\xad\x08\x61\x41\x3a\xdb\x41\xe9\x60\xc4\xe4\x10\xf2\x80\x5a\xa4\xa2\x37\x38\x46\xad\x4b\x32\xe1\xa2\x5f\xb8\xf0\xb9\xce\xe1\xdb\xa8\x53\xfa\x43\x82\xa6\x4f\x7d\xfa\xb7\xd6\x39\x99\x74\xe6\x1c\x1d\x8c\x74\x4e\x1e\x50\x8b\x54\x72\x0e\xc3\xa8\x75\x4e\x26\x5c\x72\x0e\x13\x3e\xdb\x39\xa2\xa0\xf1\x1f\x0a\x6e\x5a\xda\xf8\x70\xbd\x8b\x04\x86\x74\x11\x95\x2d\x20\x29\x2e\xca\x30\x75\x1a\xe5\x0b\x93\xfa\x24\x11\x95\x1a\xa5\xbe\x50\xab\x65\x1e\x37\x53\x55\x42\xe9\xd0\xa5\xd2\x7a\x9e\x6a\x82\x52\x90\xeb\xea\x6a\x35\x4c\x29\xdc\x0a\x4c\xab\x98\x5f\xb6\x21\x5e\xbf\xc8\x26\x3c\xb5\x6c\x64\xde\x63\xb6\xf3\xc8\x3a\x30\xd8\x68\x8a\x6c\x7a\xb0\x9f\x33\x0d\x2f\xde\x32\xaf\x5f\x64\x37\xc8\x93\xca\xd6\xa8\xb2\x16\x9b\xc4\xd9\x7b\xb6\xf5\x8b\xe8\x08\x39\x56\xd1\x14\xda\x1b\x7a\xc6\x0e\x79\x9d\x0f\x7c\x5e\x83\x5c\xee\x69\x4d\xbf\xb6\x2e\xd7\x0b\x7a\xeb\xfd\xfc\x6b\xb0\x36\xef\xf8\xd7\x59\xf9\xca\x93\x8a\x1a\xa5\x72\xd2\xb1\xeb\x50\x5e\xdb\xd0\x16\x87\x87\x4b\x58\x79\x95\x9a\x2e\xf0\xd8\x31\x2d\x0c\xf4\x68\xc1\xbe\xf7\xe9\x53\x29\x67\x61\x63\x2a\x12\x06\x01\x89\xc1\x98\xc3\xcd\x4d\xef\x0e\xdd\x9b\x98\x5f\x1e\xce\x91\xed\x80\x89\x1d\x2b\x77\x00\x91\xdf\x36\x0d\xe5\x97\x4c\x43\xf9\xdd\xd2\x00\xd2\xdb\xc4\x39\xf4\x9d\x7b\x37\xb5\xd8\xc5\xc6\x23\x1a\x82\xfc\xd4\xc8\x9a\x4d\x3e\xa6\x3a\x02\x00\xd7\x83\x8e\x8d\x7a\x08\x4f\x46\xbd\x9b\x1b\x98\x19\xf8\x7e\x61\xdc\x23\xd8\x6f\xf6\xc1\xe1\xe7\x66\xa4\xb7\x45\x04\x27\x0e\x5e\xd3\xa0\x21\x88\x4a\x52\x65\x18\xfb\x72\x4a\xd8\x35\x04\x8c\x9e\x3e\x72\x99\x9c\x8d\x18\x3d\x75\xb2\xf1\x40\x12\xd1\x48\x5a\xd8\x47\x59\xf9\xdb\xf0\x6f\x76\x47\x7d\x0e\xbb\x63\x9b\xf7\xf7\xc8\x2e\x55\x17\x45\x19\xb8\x43\x34\x15\x61\xf1\x75\x42\x25\xca\x3d\x2e\xa5\x9d\x5a\x36\x20\x63\xfc\x00\xb6\xf5\x44\x5b\x1d\x86\x3e\x75\xd0\x07\xda\xe8\x26\xe6\xdc\x31\xf1\xd8\x81\xa9\x6d\x3d\xa6\x9a\x7f\x18\x00\xfa\x8e\xc6\x0b\x07\xc1\x57\xdb\x1a\xa3\xc9\xc2\x46\x79\x07\x54\xa9\x48\x33\x21\x8d\x00\x18\x53\xba\xca\x84\x56\x53\x28\x9c\x13\xaa\xb4\x92\xe1\x52\x7b\x30\x18\x78\x92\x46\x54\x4e\x95\xb5\x16\x99\x51\x56\xbd\x94\x9f\x75\xea\xb3\x95\xc1\xf5\x9f\xa0\x19\x52\xbc\x9a\xd7\x58\x4f\x24\x16\xf5\xa8\x48\x91\xfb\xfc\xaa\x9a\x48\x79\xed\x22\x3a\xa5\x2d\x34\x99\x95\xbe\x75\x05\xb2\xb4\x48\x36\x92\xed\x8f\x57\x21\x4b\xfb\x40\xb3\x65\x24\xf1\xae\x40\x26\x97\x5e\x23\x63\xf6\x6a\x37\xda\xbf\x07\x00\xf8\x31\xe4\xb6\x1a\x2b\x00\x00"),
+		},
+		"/fingerprint/seed.sql": &vfsgen۰CompressedFileInfo{
+			name:             "seed.sql",
+			modTime:          time.Date(2026, 10, 18, 19, 19, 55, 39280199, time.UTC),
+			uncompressedSize: 1185,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\xdb\x6e\xdb\x38\x10\x7d\xf7\x57\xcc\x5b\x12\xa0\x28\xda\xb7\x45\x83\x2c\xe0\x3a\x4c\x23\xd4\x91\xb7\xb6\xbc\x4d\xb1\x58\x08\xb4\x44\x2b\x84\x25\xca\xa5\xa8\xc6\xd9\xaf\x5f\x90\xba\x52\x77\x39\x71\x93\xbc\xc4\xe2\xe5\x9c\xe1\x99\xe1\xcc\x48\x93\xc9\x0a\x59\x10\x09\x2c\x48\x40\x98\xb0\x05\x0d\x48\x18\x0b\xb8\x82\x0f\x97\x6a\xca\x0f\x9d\x5d\x7d\x94\xba\x3e\xb1\x29\xb3\x05\xc7\x2c\xc2\x8e\xa0\x21\xb3\x23\x12\x45\xf2\x7f\x6d\xb1\xe3\x53\x09\x4d\x98\x13\xba\x94\x79\x70\x05\x67\x6b\xeb\xe6\x8f\xb3\xcb\x8c\x9b\xb9\x98\xbb\xb6\x13\xb2\x6d\xc8\x03\xca\x3c\x3b\x12\x9c\x32\x2f\x82\x2b\x08\x59\x8a\xf1\x40\x9c\x9d\xbd\x8d\x59\xc2\xb5\x09\x5d\x4a\xe4\xfc\x16\xfb\x11\x49\x96\x1c\x02\x3f\xdc\xcb\x59\xb8\x02\x27\x64\x82\x30\xa1\xf1\x07\x94\xd9\x01\x89\x22\xec\xa9\x9d\x8f\x98\x33\xca\xbc\x64\x09\x0f\x1f\xed\x88\x38\x31\xa7\xe2\x49\xb2\x6e\xb7\x97\x93\xc9\x64\xb6\x44\x53\x0b\x01\xba\xb7\x90\xb9\x32\x16\x26\x18\x37\x60\x2e\x2c\x40\xf7\xc6\xca\x5a\x01\x76\xc2\x38\x12\xd4\x85\xef\x86\x75\x0b\xab\xd9\x2d\xba\x9b\xc2\x3e\xde\xf8\xd4\x91\xbb\x27\xfd\x08\x94\x09\xcc\x39\x7e\x6a\x47\x90\xc6\xb9\x64\x8b\x63\x5f\xd8\x02\x6f\x7c\x12\xed\xb1\x43\xa4\x84\x67\x97\xfa\xec\x23\x15\x0f\x76\x48\xdd\x92\x2a\x39\xbf\x35\xfd\x3c\x47\x29\xf0\xfb\x2d\x65\x1e\xe1\x7b\x4e\x99\x80\xf3\x09\x00\x00\x75\xa5\x25\xc4\x23\x5c\x19\x67\xae\xe7\xf3\x77\x6a\xc2\xe1\x04\x0b\xe2\x82\x74\x69\x24\x70\xb0\x07\xc9\xa2\x1e\xe1\xbf\x90\x11\xb8\x46\x37\xd3\xf5\xdc\x02\x16\x3e\x9e\x5f\x54\x36\x97\x79\x52\xf8\x7f\xfe\xad\xac\xf1\x09\xf3\xc4\x43\x0b\xfb\x86\x0a\x8e\x05\xc9\x66\x53\xd4\x90\x07\x58\xd8\x85\xc9\xc9\xb0\xe0\xd8\xd9\xd9\xad\x07\x89\xe2\x4d\x40\x93\xf0\x74\xc2\xb8\x30\xa8\xb2\x6c\xb6\x30\x57\xd6\x72\x6a\x98\x56\xd9\x7a\x3b\x35\xc4\x56\x41\x08\xb3\x5b\x34\xfb\x0a\xe7\xe7\x99\x79\x7f\xc2\x87\x8b\x8b\xce\xfd\xc9\x29\x2b\xdb\xd3\xa3\xab\xdd\x93\x8b\xcb\x52\xb4\xac\xd0\xb7\x35\x32\x67\x4d\x0e\xb3\xa9\x6b\x47\xe4\xa7\x22\x9b\xae\xb2\x53\xa8\xc7\x95\x35\x5d\x5a\x49\x20\x7d\x54\x03\x86\x39\x5b\xa2\x3b\x64\x5a\xf0\xf9\x47\x3a\x64\x2e\xe0\xce\x30\xff\x9e\xce\xd7\x28\x7f\x9e\xde\x17\xcf\xb3\xe9\xec\x16\xc1\x47\x65\xcc\x74\x6e\xa1\xe5\x00\x5b\x60\xf1\xdd\x44\xd7\x92\xa3\xbe\xe4\x3d\x75\xcb\x07\xd3\xc3\x30\xe4\x84\x7a\x8c\xba\x7d\x41\xf8\x8b\x30\x37\xe4\xed\xbe\x65\x38\x20\x20\xc8\x41\xe4\xe3\x3d\x72\x66\xc4\x65\x31\x7f\x93\x7a\x15\xea\xba\x76\xd9\x82\x41\xca\xd9\x89\x34\x7d\x02\x2a\x81\x9c\x07\xcc\xb1\x23\x08\x87\x5f\x98\x3f\xc9\x34\x3c\x56\xad\xdc\x0f\xaf\x26\x9a\x66\x41\xbb\x76\xe9\xba\x2e\x09\x03\x22\x70\x9f\x6c\x2a\xa5\xd4\x75\x4b\x26\x31\x17\x34\x12\xad\xb3\xfe\x26\x0e\x3a\x27\xed\x6e\x80\x24\x9d\xb1\x50\x4f\x72\x2e\x8d\x9c\xda\xe0\x13\xc1\x3c\x4f\x04\x9d\xae\x94\x87\xfe\xfd\xee\x2b\xb1\xd6\x5c\x26\xe7\xba\xdc\x94\xb8\xe0\x94\x45\xca\xa3\x2e\xc4\x31\x75\xab\x57\x86\x3c\x96\x12\x8e\x2e\x6b\xdd\x42\x7b\x70\x2e\xeb\x29\x53\xe5\xfc\x70\x8a\xd3\xf6\x96\xc1\xee\x00\xaa\x1c\xf6\xd5\x8b\x51\xb3\x3d\xb5\x28\xab\x2c\xab\x04\x5c\x33\xe8\xdb\x38\x5a\xd7\x81\x7a\xef\x8d\x1d\x6c\x9e\x1d\x90\x0a\xa2\xe1\x7e\x9c\x36\x0a\xf3\x6c\x27\x1b\x5e\x17\x36\x61\xe8\x13\xcc\x72\x4c\xd5\xdd\x8e\x0a\x59\x79\x8c\x37\xe2\xd2\x92\x29\x2d\x81\x2a\x57\x0c\x70\xee\xd0\x0a\xd6\xe1\xdc\x24\x33\xbf\xd9\x44\x53\xad\x57\xaf\xe9\xb5\x8e\x22\x56\xac\xe8\xf7\xda\x3e\x7e\xf6\x95\x54\x10\xbf\xfd\x4a\x0e\xf1\x97\xb4\xec\x8d\xf8\xab\x64\x4a\x8b\xbf\xe4\x8a\xcc\x5f\x09\x52\xe2\xae\x85\x39\x6f\x7a\x93\x81\x64\xcd\x6c\x31\x5f\xdf\x99\xd2\x77\xf2\xd5\x3b\x57\x94\x1c\xc4\x2f\xec\x9f\x9f\xb5\xbe\x24\x9d\x7d\xfa\xc4\x89\xe7\xf8\x38\x8a\x2e\xba\x29\xf3\x4e\x62\x14\x61\xa5\x04\x8e\xa7\xcb\x5e\x25\x8e\x63\xd5\x1a\xf3\xc1\xe4\x2a\x85\x8d\x21\x2c\xdd\xc2\xc1\x24\x49\x0b\x39\x86\xa5\x5c\x7a\xc7\xd1\xd8\xc7\x79\xaf\xb9\x8d\x19\x49\xad\x2a\xf5\x78\xd6\x52\x39\x1a\x4b\x38\xd6\x7b\xb5\x4c\x3a\x92\x50\x25\xbe\xf1\x84\xa5\x54\x30\xfc\x5a\x14\x17\x38\xc9\x64\xd7\xd7\x6d\xdf\x76\xf6\x3b\xf2\x04\x7f\x2d\x8d\xbb\xe9\xf2\x07\x7c\x45\x3f\xe0\x9c\xba\x03\xef\x5c\x23\x74\x1e\x05\xcf\x00\x4e\xef\x63\x37\x7e\x7a\x67\xc7\xd3\x48\x0f\x36\x41\x2b\xcf\x8e\x87\xab\x44\x7f\x13\x72\xf5\x82\x1c\x4b\x22\x83\xbd\x1d\x5f\x5d\x85\xa3\xa1\x5b\x44\x29\x05\xfd\x91\xd0\xed\xa8\xc7\xda\x2a\x6f\x44\x07\x6a\xdc\x29\x43\xda\x01\x18\xe6\x35\xba\x07\xbd\xd0\x1d\xd2\x0f\x9d\xb0\x30\x9b\x2a\xe8\x7a\x65\x98\x5f\x60\x23\x38\x21\x90\x7e\xfd\x1c\x00\x99\x77\x43\x03\x40\xb3\xb5\x4d\xb0\xa5\xec\x7a\xc8\x8a\x5d\x09\x32\x4f\xdc\x1a\x60\x5e\xd7\x34\xc4\xb5\x69\x7c\x5b\x77\x01\xdb\xea\x8b\xdb\x70\xf4\x77\xea\x13\xdd\x10\x8e\x7c\xcb\xa1\x95\x23\x3b\x9c\x46\x55\xc3\x4f\x80\xeb\x95\xe7\xa0\x3d\x95\xf0\xab\x05\x4e\x83\x2f\xef\x69\x3f\x46\x13\x5b\x5e\x6c\x0b\xe4\x81\x9c\xd9\xce\x77\x70\x3c\x7b\xcc\xe8\xcf\x17\xe4\x2b\x13\x49\x78\xaf\x7e\x1a\x1d\xd3\xeb\x01\x48\x3f\x43\x75\x62\x24\x6b\x2e\xe0\xfb\x2d\x5a\xa2\xec\x11\x8c\x55\xde\xba\xb7\x32\xa4\xb5\xff\x90\xf4\x0e\x55\x19\xd4\xa0\xc6\x24\x47\xfa\xf4\xcd\x31\x73\xc7\x0e\x04\x2f\xe4\x1d\x45\xd3\xe8\xc1\x51\xe8\x1a\x6c\xd2\x9c\x1c\xb2\x1f\x75\x64\xd9\xf4\xe8\xa2\x24\x2b\x7b\x0d\xce\x90\x0b\x5d\x24\x54\x3f\x7e\xc9\xf2\x91\x4c\xcd\xd2\x8c\x24\x28\x23\xa7\x9d\xd4\x21\xe9\xc4\xaa\xc8\x6a\x50\x43\x96\x23\x7d\xd6\xe6\x98\xb9\x2e\x03\xc1\x0b\xb3\x47\xd1\x34\x8a\x32\x0c\xfd\x75\xff\x5e\xa0\x55\xdd\xee\x8a\x6a\x7a\xb3\x58\x22\xe3\x8b\x99\xd4\xf6\xbc\x6e\xc2\x12\xdd\xa0\xa5\x7c\x9d\x5e\x69\x0a\xbd\x54\x4f\xbb\xdd\x15\x15\x4c\x37\xa1\xa8\xb4\x0d\x36\x54\x6b\xdb\x73\x1b\xa7\xed\x2e\xcb\xac\x9a\x0d\x59\x26\x3d\x56\x84\x23\x3a\xd9\xad\x5e\x91\x74\x7b\xb4\x3a\xd3\x25\xcb\x89\x2c\x3b\x55\xb0\x0c\x6e\xc6\x4f\x6f\x43\x7f\xd7\xbe\xcd\x33\xaa\x6e\x42\x96\x2c\x1b\x2c\x90\x53\x2f\x6a\xc0\x69\x45\x18\xf0\x3a\xf0\x02\x36\xfc\x1f\x00\x00\xff\xff\x6e\xe4\x10\x98\x69\x24\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x94\x93\xd1\xaf\x9a\x30\x14\xc6\xdf\xfd\x2b\xce\x1b\x9a\xd0\x85\xb6\x50\x21\x7b\x32\xc6\x07\x33\xbd\xcb\x26\xee\x95\x14\x7b\xf0\x76\x83\xe2\xa0\x9a\xdc\x2c\xfb\xdf\x17\x40\xe7\x70\x68\xbc\x6f\x6d\xf9\xce\xef\x3b\x1f\xa7\x25\x04\x36\x85\xcc\x73\x50\xd2\x4a\xa8\xd1\x42\x56\x56\x90\x97\x3b\x99\x83\xc2\x13\xe6\xe5\xa1\x40\x63\x3f\x8c\x46\xcb\x97\xcd\xe2\x6b\x0c\xcb\x97\xf8\x73\xa3\x41\xbd\x37\x5a\x25\x27\x34\xaa\xac\x60\xac\x95\x0b\x46\x16\x38\x81\x6f\xb3\xd5\x76\xb1\x81\x31\x75\xc1\xa9\x0f\xa5\xd5\xd9\x9b\x33\xf9\x38\x5c\xde\xd5\x75\x8c\x64\x10\xd1\x50\xfc\xe3\x6a\x2b\x5e\xd7\xf3\xef\xeb\xe5\x34\x58\xd3\x19\xb3\x9f\xb6\x5f\xe6\x0d\xb4\x47\x2d\xd0\xca\x0e\x68\x2b\xb9\xfb\xe1\x82\xac\xac\xae\xad\x0b\x32\x4f\x8f\xc5\xf9\x34\x31\xa5\x0b\x6f\x28\xab\x7e\xa3\x31\xd6\x16\xe2\x46\xe0\x5c\x76\xb3\xb6\xfa\xba\x6d\x20\x4e\xdb\x10\xf3\x98\x77\x6b\xde\xc2\x3b\xf7\xbd\x56\x7d\x38\xa6\x9c\x2a\xba\xe3\x24\x0a\x3c\x24\xbe\x08\x53\x12\x21\x17\x04\x7d\x91\xc9\x69\x90\x52\x16\xd1\xff\xd2\x64\xda\xec\xb1\x3a\x54\xda\xd8\x0e\xfb\xcf\x81\x0b\x39\x9a\xbd\x7d\x75\x21\xd5\xb6\x92\x16\x2f\xe1\x1a\x5d\x7d\x4c\x0b\x5d\xd7\xba\x34\xc9\xae\x3c\x1a\x3b\x19\x01\x40\xaf\x9f\x5f\x84\x0a\xe6\xfb\x3e\x13\x8c\xb9\xdd\x9a\x87\x01\x13\xed\x3a\x14\x9c\x85\xdc\x3b\xaf\x59\x38\xe5\xfe\x6f\xa7\xc9\x2c\x5c\xa0\x11\x6b\x7f\x00\x1d\x4c\x9f\x14\xe9\x65\xa2\xd7\x6e\x8a\x74\xb0\xa7\xdb\x11\xa7\x21\xcd\x42\x8e\x48\x7c\x25\x7d\x42\x29\x7a\x24\x42\x15\x12\xcf\x63\x01\x63\x01\x0f\x44\xc6\x9d\xce\x78\xc0\xb7\x3f\xf8\xce\x17\xad\x4c\x9e\xb1\xa6\xf7\xa8\x37\x97\xf4\x8a\xfe\xfb\xe1\x3d\xfc\xd1\x66\xb1\x5a\xcc\xe3\xe6\x85\x9d\x64\x3e\x76\x6e\x5f\x50\xa2\x55\x52\xe3\xcf\x73\xc6\xbb\xe2\x47\xaa\x73\xe4\xfb\x82\x4b\x84\x07\x46\xd7\x3b\xf6\x04\xa9\x99\xed\x33\xb2\xe7\xfa\xba\x13\xf2\xcf\x00\x2c\x7a\x58\xca\xa1\x04\x00\x00"),
 		},
 		"/ingest": &vfsgen۰DirInfo{
 			name:    "ingest",
-			modTime: time.Date(2019, 10, 5, 19, 36, 7, 273251438, time.UTC),
+			modTime: time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
 		},
 		"/ingest/schema.sql": &vfsgen۰CompressedFileInfo{
 			name:             "schema.sql",
-			modTime:          time.Date(2019, 10, 5, 19, 36, 7, 273251438, time.UTC),
+			modTime:          time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
 			uncompressedSize: 5946,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xdd\x6f\xdb\x36\x10\x7f\xf7\x5f\x71\x6f\xb6\x81\xa1\x68\xdf\x86\x16\x19\xa0\xca\xea\x62\xd4\x91\x37\x5b\x5e\x1a\x0c\x03\x41\x8b\x67\x99\x88\x44\x7a\x22\xb5\xb8\xfb\xeb\x07\x4a\xfe\xa0\xa2\x8f\x48\x0e\x56\x23\x79\x09\xa4\xbb\xe3\xef\xee\x7e\xf7\x41\x79\x30\x58\x7a\x01\x28\x4d\x35\x26\x28\x34\xd1\x3c\x41\x99\x69\xb8\x81\xf7\x9f\x72\x51\x2c\xc3\xc7\xea\x5b\xce\x62\x24\x5c\x10\x9d\x52\xa1\x68\xa8\xb9\x14\x44\xa1\x52\xe6\x7f\x45\x39\x8c\xb9\x39\x1a\x45\x28\x19\x17\x11\xdc\xc0\x70\x15\x7c\xf9\x79\xf8\xe9\x88\x2d\x18\x4d\x19\x09\xa5\xd8\xc8\x34\xe1\x22\x22\x4a\xa7\x5c\x44\x0a\x6e\x40\x8a\xc3\x19\x5b\x0c\x1f\xc9\x26\x13\x05\xd6\x5a\x32\x8e\x46\xbe\xa1\xb1\xc2\x42\x65\x9f\xc4\x72\x67\xa4\x70\x03\xa1\x14\x1a\x85\x2e\xe1\x27\x5c\x90\x04\x95\xa2\x51\x6e\xf9\x44\x53\xc1\x45\x54\xa8\xa4\xf2\x89\x28\x0c\xb3\x94\xeb\xef\x06\x75\xb3\xf9\x54\x64\x86\xe1\x86\x66\xb1\x26\x9a\xae\x63\x54\x3b\x1a\xa2\x71\x7f\xf8\x4c\xfa\xc4\xf5\x96\x48\xce\x2c\x8f\x06\x03\x77\xe1\x39\x81\x07\x81\xf3\x79\xe6\xc1\x2e\x5b\xc7\x3c\x7c\xb7\xe1\x22\xc2\x74\x97\x72\xa1\x89\x92\x59\x1a\x22\x8c\x06\x00\x00\x9c\x01\x17\x1a\x23\x4c\xc1\x9f\x07\xe0\xaf\x66\xb3\x9f\x72\x81\x6d\xd1\xa8\xa4\xb2\x75\xc2\x8b\xec\x37\xeb\xe4\x70\xcd\xf2\x30\x45\xaa\x91\x81\xa1\x4f\x69\x9a\xec\xc0\x44\x95\x3f\xc2\xbf\x52\x20\x4c\xbc\x2f\xce\x6a\x16\x80\x90\x4f\xa3\xf1\xc9\x78\x30\x36\xb1\x1e\x83\x5d\x7a\xbf\xaf\x3c\xdf\x6d\x89\x97\x70\x46\x14\xfe\x9d\x43\x2e\x03\x67\x11\xc0\xfd\x34\xb8\x85\x0f\xf9\x8b\xa9\xef\x2e\xbc\x3b\xcf\x0f\xe0\xf3\xc3\xe1\x95\x3f\x87\xbb\xa9\xff\x87\x33\x5b\x79\xa7\x67\xe7\xdb\xf9\xd9\x75\xdc\x5b\x0f\x3e\xe4\x4e\x38\xb3\xc0\x5b\x74\xf7\x01\xe6\xf7\xbe\x37\x31\x50\x8d\x9a\xef\x38\xb3\xc3\x2b\x71\x79\x4e\x7a\x0f\x0e\x8f\x1a\x7f\xfe\xf5\x4c\x27\x46\x11\xe9\x2d\xa8\x84\xc6\xb1\xd1\x2b\x4b\xd7\x5c\xa7\x54\xe3\x49\x7c\x38\x57\xa6\x09\xb5\xcb\xe2\x55\x4c\x76\x2b\x93\x64\xcd\x19\x64\x19\x67\xc5\xe3\x96\x0a\x16\x23\x83\xb5\x94\x31\x52\x71\x3a\x3a\xef\x82\x42\x65\x97\x95\x2c\x12\xd4\xb4\xe2\xf2\x46\xa6\xc8\x23\xc1\x59\x45\xe2\xce\xfd\x65\xb0\x70\xa6\x7e\x60\x57\xf9\x21\x1f\x24\x1f\x0b\xe0\xde\x7a\xee\x57\x18\x8d\x8e\x59\xfa\x05\xde\x8f\xc7\x6d\xe6\x45\xb2\x9f\x59\x1f\x18\xc8\x8d\xdb\xab\xba\xd4\x6e\x3f\xb8\x9a\x2b\xd8\x95\x2a\x3e\x6b\xb4\x55\xaf\x4e\xa9\x19\xa8\xa7\xbc\x77\x9b\x46\xcf\xad\xde\xde\x44\xaa\x8f\xfb\xc7\xf3\xd8\xea\x47\x85\xd3\x7a\xed\x97\xf9\x35\xdd\x4a\xc2\x2d\x15\x51\x47\x6a\x73\x83\x46\x1d\x1a\x86\x32\x6b\x5b\x44\xaf\x1a\x3d\x8c\x2b\xb3\x65\xcf\xc3\xa4\x2c\x16\x52\x23\x68\xdc\xeb\x2e\x04\x5b\x81\x5f\x8b\xdb\xaa\x0b\x0d\xb4\x5a\x8a\x1d\x19\xed\xd3\xac\xed\x8c\xd6\xf6\xe9\xd5\xdb\xd3\x0a\xf2\xaa\xec\x75\x69\x4a\x4b\xb1\x03\x7b\x66\xf9\xf5\x62\xaf\xbc\x2d\xdf\xd6\x94\xb5\xa2\xbd\x1a\x8d\x15\x17\x9a\x68\x3c\x2b\xbe\x4c\xa3\xb9\xd2\xf4\xa2\x31\x37\x78\xab\x34\x5a\xd1\x5e\x8b\xc6\xaa\x0b\x0d\x34\x5a\x8a\x47\x1a\x8b\x73\x0b\x16\xe7\xfe\xac\xe5\xb6\x0f\x85\xaa\x3b\x9f\xad\xee\x7c\x43\xa9\xf9\xc6\x3b\xe5\x0d\xf7\xfa\x1f\x1a\x8f\x86\x2f\x7d\x56\x0c\x3f\x7e\x4c\x31\x0a\x63\xaa\xd4\xb8\xd5\x01\xeb\x03\xa2\x0f\x70\xe5\x06\xd8\x19\xb0\xe1\xce\xd7\x07\xbc\xf5\xda\xd2\xd3\x11\xfb\x72\xd2\xdf\x87\xea\x7a\xbd\x04\xfe\xe2\x14\x54\xf7\x43\x5f\x78\x6b\x15\x5c\x00\x5f\x99\x6b\x3d\xe1\xed\x11\xd6\x1f\xbe\xda\x8f\x9d\xe1\xab\x7d\x93\x4f\x02\x67\x32\xb1\x3f\xd6\x6a\xba\x6b\xf7\x88\xdf\xe1\xb7\xc5\xf4\xce\x59\x3c\xc0\x57\xef\x01\x46\x9c\x75\x6d\xb0\x3a\x08\xab\x8f\xfa\x1f\x5d\xdf\x07\x75\x30\x0d\x1d\x73\x29\xa4\x55\xf6\xcd\x68\x76\x6f\xbc\x0a\xe8\xa5\xb0\xec\x2e\xb8\x18\xe8\x5c\xca\x2d\x40\x56\xbd\x5f\x0a\x64\x15\x6d\x33\x90\x5d\xd9\xcd\x40\x87\x7d\x39\xf5\x27\xde\xb7\xba\x5a\xe5\x6c\x4f\xca\x5b\x7d\xee\xb7\xec\x9d\xd5\x72\xea\xff\x0a\x6b\x9d\x22\xc2\xa8\x64\x57\x03\x57\x92\xef\xc9\xf1\x17\x98\x33\x80\xb5\x57\x4a\x07\x73\x36\x86\xfb\x5b\x6f\xe1\xc1\xe8\x68\x74\xf8\xb9\xb2\x06\xa5\x6e\xc8\xee\x49\xf9\x93\xe2\x0c\x59\x1d\xe8\x25\xe4\x92\x59\x3b\x98\x95\xc0\x0e\x60\x75\xf9\xab\x01\xfb\x3f\xfe\xfe\x0b\x00\x00\xff\xff\x72\x17\xcd\xaa\x3a\x17\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x58\xdf\x6f\xdb\x36\x10\x7e\xf7\x5f\x71\x6f\xb6\x81\xa1\x68\xdf\x86\x1a\x19\xa0\xda\xea\x62\xd4\x91\x37\x5b\x5e\x1a\x0c\x03\x41\x89\x67\x99\x88\x44\x7a\x22\xb5\xb8\xfb\xeb\x07\x49\xb6\x45\x45\x3f\x22\xb9\x6b\x8c\xe4\x25\x90\xee\x3b\x7e\xc7\xfb\xee\x78\x94\x07\x83\xb5\xed\x82\xd2\x54\x63\x84\x42\x13\xcd\x23\x94\x89\x86\x1b\x78\x3f\xc9\x4c\xa1\xf4\x1f\xab\x6f\x39\x0b\x91\x70\x41\x74\x4c\x85\xa2\xbe\xe6\x52\x10\x85\x4a\xa5\xff\x2b\x60\x3f\xe4\xe9\xd2\x28\x7c\xc9\xb8\x08\xe0\x06\x86\x1b\xf7\xf3\xcf\xc3\xc9\x89\x5b\x30\x1a\x33\xe2\x4b\xb1\x95\x71\xc4\x45\x40\x94\x8e\xb9\x08\x14\xdc\x80\x14\xc7\x35\x76\xe8\x3f\x92\x6d\x22\x72\x2e\x4f\x32\x8e\xa9\x7d\x4b\x43\x85\x39\xe4\x10\x85\x72\x9f\x5a\xe1\x06\x7c\x29\x34\x0a\x5d\xe2\x8f\xb8\x20\x11\x2a\x45\x83\xcc\xf3\x89\xc6\x82\x8b\x20\x87\xc4\xf2\x89\x28\xf4\x93\x98\xeb\x6f\x29\xeb\x76\x3b\xc9\x33\xc3\x70\x4b\x93\x50\x13\x4d\xbd\x10\xd5\x9e\xfa\x98\x86\x3f\x7c\x66\x7d\xe2\x7a\x47\x24\x67\x46\x44\x83\xc1\x74\x65\x5b\xae\x0d\xae\xf5\x69\x61\xc3\x3e\xf1\x42\xee\xbf\xdb\x72\x11\x60\xbc\x8f\xb9\xd0\x44\xc9\x24\xf6\x11\x46\x03\x00\x00\xce\x80\x0b\x8d\x01\xc6\xe0\x2c\x5d\x70\x36\x8b\xc5\x4f\x99\xc1\xf4\x68\x04\xa9\xc4\x8b\x78\x9e\xfd\x66\x4c\x46\xd7\x6c\xf7\x63\xa4\x1a\x19\xa4\xf2\x29\x4d\xa3\x3d\xa4\xbb\xca\x1e\xe1\x5f\x29\x10\x66\xf6\x67\x6b\xb3\x70\x41\xc8\xa7\xd1\xf8\xec\x3c\x18\x4f\x06\xc5\x66\xd7\xf6\xef\x1b\xdb\x99\xb6\xec\x97\x70\x46\x14\xfe\x9d\x51\xae\x5d\x6b\xe5\xc2\xfd\xdc\xbd\x85\x0f\xd9\x8b\xb9\x33\x5d\xd9\x77\xb6\xe3\xc2\xa7\x87\xe3\x2b\x67\x09\x77\x73\xe7\x0f\x6b\xb1\xb1\xcf\xcf\xd6\xd7\xe2\x79\x6a\x4d\x6f\x6d\xf8\x90\x05\x61\x2d\x5c\x7b\xd5\x3d\x06\x58\xde\x3b\xf6\x2c\xa5\x6a\x44\xbe\xe3\x6c\x32\x68\xd0\xb2\x48\x7a\x0f\x0d\x4f\x88\x3f\xff\x7a\x86\x09\x51\x04\x7a\x07\x2a\xa2\x61\x98\xe2\xca\x56\x8f\xeb\x98\x6a\x3c\x9b\x8f\xeb\xca\x38\xa2\x66\x59\x7c\x97\x92\xdd\xca\x24\xf2\x38\x83\x24\xe1\x2c\x7f\xdc\x51\xc1\x42\x64\xe0\x49\x19\x22\x15\xe7\xa5\xb3\x2e\xc8\x21\xfb\xa4\xe4\x11\xa1\xa6\x95\x90\xb7\x32\x46\x1e\x08\xce\x2a\x96\xe9\xd2\x59\xbb\x2b\x6b\xee\xb8\x66\x95\x1f\xf3\x41\xb2\x63\x01\xa6\xb7\xf6\xf4\x0b\x8c\x46\xc7\xb7\xf0\x0b\xbc\x1f\x8f\xdb\xdc\xf3\x64\x3f\xf3\xce\x5f\xe6\xce\xed\x55\x5d\x6a\xb7\x57\xae\xe6\x0a\x77\xa5\x8a\x0b\x44\x5b\xf5\xea\x98\xa6\x07\xea\x39\xef\xdd\x4e\xa3\xe7\x5e\x6f\xef\x44\xaa\xdf\xf7\xeb\xeb\xd8\x1a\x47\x45\xd3\x7a\xf4\xcb\xfa\xa6\xdd\x4a\xfc\x1d\x15\x41\x47\x69\x33\x87\x46\x0c\xf5\x7d\x99\x08\xfd\xbf\x4b\x96\x3b\x33\xae\xa8\x67\x1e\x26\x65\xb3\x90\x1a\x41\xe3\x41\x77\x11\xd8\xd8\xf8\xb5\xb4\xad\x86\xd0\x20\xab\x01\xec\xa8\x68\x9f\x66\x8d\xbc\xde\x7d\x7a\xf5\xf6\x34\x36\x79\x55\xf5\xba\x34\xa5\x01\xec\xa0\x5e\x3a\xfc\x7a\xa9\x57\x9e\x96\x6f\xeb\x94\x35\x76\x7b\x35\x19\x2b\x21\x34\xc9\x58\x00\x5f\x96\x31\xbd\xd2\xf4\x92\x31\x73\x78\xab\x32\x1a\xbb\xbd\x96\x8c\xd5\x10\x1a\x64\x34\x80\x27\x19\xf3\x75\x73\x15\x97\xce\xa2\xe5\xb6\x0f\x39\x74\xba\x5c\x6c\xee\x9c\x54\xd2\xf4\x1b\xef\x9c\x37\x3c\xe8\x7f\x68\x38\x1a\xbe\xf4\x59\x31\xfc\xf8\x31\xc6\xc0\x0f\xa9\x52\xe3\xd6\x00\x0a\xd9\x7b\x11\x57\x6e\x80\x9d\x09\x1b\xee\x7c\x7d\xc8\x5b\xaf\x2d\x3d\x03\x31\x2f\x27\xfd\x63\xa8\x8e\xd7\x4b\xe8\x2f\x4e\x41\x75\x3e\xf4\xa5\x37\x46\xc1\x05\xf4\x95\x73\xad\x27\xbd\x79\x84\xf5\xa7\xaf\xf6\x63\x67\xfa\x6a\xdf\x0c\x00\x00\xac\xd9\xcc\xfc\x58\xab\xa2\xc8\xfe\x11\xbf\xc1\x6f\xab\xf9\x9d\xb5\x7a\x80\x2f\xf6\x03\x8c\x38\xeb\xda\x60\x75\x14\x85\xf5\x82\xa5\xeb\xfb\xa0\x8e\xa6\xa1\x63\x2e\xa5\x34\xca\xbe\x99\xcd\xec\x8d\xef\x22\x7a\x69\x5b\x66\x17\x5c\x4c\x54\x94\x72\x0b\x51\x01\xba\x98\xc8\x28\xda\x66\x22\xb3\xb2\x9b\x89\x8e\xf3\x72\xee\xcc\xec\xaf\x50\x3b\x09\x0e\xa4\x3c\xd5\x97\x4e\xcb\xdc\xd9\xac\xe7\xce\xaf\xe0\xe9\x18\x11\x46\x25\xbf\x1a\xba\x92\xfd\x40\x4e\xbf\xc0\x14\x04\x05\xa0\xbc\x30\x67\x63\xb8\xbf\xb5\x57\x36\x8c\x4e\x4e\xc7\x9f\x2b\x6b\x58\xea\x0e\xd9\x03\x29\x7f\x52\x14\x94\x15\x74\x99\xb9\xe4\xd6\x4e\x66\x24\xb0\x03\x59\x5d\xfe\x6a\xc8\x7e\xc4\xdf\x7f\x03\x00\x72\x17\xcd\xaa\x3a\x17\x00\x00"),
 		},
 		"/musicbrainz": &vfsgen۰DirInfo{
 			name:    "musicbrainz",
-			modTime: time.Date(2019, 10, 5, 19, 36, 22, 433452974, time.UTC),
+			modTime: time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
 		},
 		"/musicbrainz/schema.sql": &vfsgen۰CompressedFileInfo{
 			name:             "schema.sql",
-			modTime:          time.Date(2019, 10, 5, 19, 36, 22, 433452974, time.UTC),
+			modTime:          time.Date(2023, 1, 29, 6, 43, 59, 0, time.UTC),
 			uncompressedSize: 356619,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xfb\x77\xdd\xb6\xb1\x28\xfc\xbb\xff\x0a\x9e\x7c\xe9\xa7\xad\x56\xf6\x95\xec\xc4\x49\x9c\x28\x77\xa9\xb6\x9c\xfa\x5e\x47\xea\x95\xe4\xd3\xe6\x4b\x73\xb9\xa8\x4d\x48\xe2\x31\x45\xee\x90\xdc\x92\xd5\xa6\xe7\x6f\xff\x16\x01\x3e\x00\x10\x8f\xc1\x83\x16\xe4\xec\xae\x75\x72\xac\xcd\xc1\xbc\x30\x33\x00\x06\x03\xe0\xd1\xa3\xd3\xc3\xb3\xa8\x6e\x92\x06\x5d\xa3\xa2\x89\x9b\xec\x1a\x95\xeb\x26\xda\x8f\x76\xbf\xc5\x9f\xf2\x72\xf9\x7e\xfa\x6b\x96\xe6\x28\xce\x8a\xb8\xa9\x92\xa2\x4e\x96\x4d\x56\x16\x71\x8d\xea\xba\xfd\xff\x13\xe0\x65\x9e\xb5\xa8\x51\xb1\x2c\xd3\xac\xb8\x8c\xf6\xa3\xad\x77\x67\xaf\xbf\xde\xfa\xb6\xa7\x5d\xa4\x49\x95\xc6\xcb\xb2\xb8\x28\xab\xeb\xac\xb8\x8c\xeb\xa6\xca\x8a\xcb\x3a\xda\x8f\xca\xa2\xc3\x71\x85\x96\xef\xe3\x8b\x75\x41\x68\x9d\x97\x69\x86\xda\xef\x17\x49\x5e\x23\x02\xf2\xe1\x3a\x2f\x57\xed\xd7\x68\x3f\x5a\x96\x45\x83\x8a\x86\xa1\x7f\x9d\x15\xf1\x35\xaa\xeb\xe4\x12\xb7\xbc\x4d\xaa\x22\x2b\x2e\x09\x48\x55\xde\xc6\x35\x5a\xae\xab\xac\xb9\x6b\xa9\x5e\x5c\x7c\xfb\xe8\xd1\xa3\x97\x27\x87\x07\x67\x87\xd1\xe9\xcb\xbf\x1c\xfe\x78\x10\x2d\xcb\x1b\x54\xc5\x49\xd5\xc4\x49\xb5\xbc\xca\x6e\x50\x0b\xc2\xc1\xa4\xe5\x72\xdd\xea\x31\x69\xf9\x10\x7c\x47\x37\x2d\x27\x6a\x1c\xd7\xeb\x3a\x5b\x9e\x57\x49\x56\xfc\x53\xf0\xb5\xed\xaa\xac\x6e\xb2\x65\x2d\xf8\x78\x9b\xbd\xcf\xd2\x92\xfd\x74\xf8\xf7\xb3\xc3\xa3\xd3\x37\xc7\x47\xd1\x9b\xd7\xd1\xd1\xf1\x59\x74\xf8\xf7\x37\xa7\x67\xa7\xd1\x72\x7d\x8e\xa2\xbf\xbd\x39\xfb\x4b\xdf\x78\xb5\x3e\xcf\xb3\x25\x6e\xaa\x6f\x8d\x92\xaa\xb9\x4a\xb3\xb6\xf3\x96\x00\x34\x67\x3f\xfd\xf5\x90\x16\xec\xc9\xa8\xcc\x55\x85\x6a\xd4\x22\x39\x38\x8d\x0e\x8f\xde\xfd\x18\x2d\x1e\x45\x51\x14\x6d\x25\xe7\x35\x2a\x9a\xad\x1d\xf2\x17\x81\x1a\xfe\x4c\x93\xea\x3d\x2a\x50\xba\xf5\x68\xfb\x5b\x15\x99\x51\xdf\xb3\x92\xb9\xc8\xd7\xa8\x58\xde\xf1\xb8\xcf\x93\x3a\x5b\xf6\xb8\xb2\xa2\x41\xd5\x35\x4a\xb3\xa4\x41\xfd\x6f\x49\x7a\xd3\xea\x2f\xed\xff\x2e\x92\x26\xbb\x41\x1c\xb5\xd7\xef\x8e\x5e\x9e\xb5\x3d\x40\x53\x8c\x31\xa6\x62\x91\x14\x77\x49\x55\x25\x77\xdb\xd1\xc9\xe1\xd9\xbb\x93\xa3\xd3\x28\x29\xee\x50\x8e\x9d\x19\xe3\x7c\x7b\x70\xf4\xc3\xbb\x83\x1f\x0e\xa3\xfa\xd7\x3c\x7a\xf3\xe3\x8f\xef\xce\x0e\xfe\xfc\xf6\x10\x7f\x3a\x38\x8d\x3e\x8f\x3f\x7f\x14\x91\xee\xfb\xb5\xfd\x9b\x30\x1e\x45\xa7\x87\x6f\x0f\x5f\x9e\x45\x37\x49\xde\xfd\xf0\xfa\xe4\xf8\xc7\x68\x5d\x14\xa8\x6e\x16\x9f\xef\x6d\x53\x5f\xfe\xf6\x97\xc3\x93\xc3\xe8\x3f\x0f\xde\x46\x6f\x4e\xb1\x85\x1c\xbd\x7b\xfb\xb6\xfb\x76\x7c\xf2\xea\xf0\x24\xfa\xf3\x4f\x1d\xf8\xf6\x23\x0e\x33\xc6\xfa\xeb\xa3\x28\x7a\xfb\xe6\xc7\x37\x67\xd1\xde\xa3\x28\x7a\xfc\x38\x3a\x5d\x9f\x37\x55\x1b\x54\x8a\xcb\x68\x51\x44\x7f\x8a\xf6\xb6\xa3\x3f\x44\x4f\xa3\x65\x85\x92\x06\xd5\x51\x12\xe5\xe8\xa2\x89\xce\xb3\xa4\x7e\x14\x45\xc7\xaf\x5f\xb7\x1e\x7c\x49\x3e\x36\x8b\xdd\x9d\xe8\x22\x2f\xcb\x6a\xb1\xa8\x51\x8e\x96\x4d\xb4\x2c\xd7\x45\xb3\xf8\xe3\x76\x47\x6d\x3b\xfa\x1f\xd1\xd3\x27\xbb\xdb\xd1\xe3\x68\x0a\xf2\xa7\x68\x6f\x00\xfb\x43\xf4\x74\x7b\xfb\xdb\x47\x9f\xc7\x9f\x6b\x7b\x23\x89\x53\x94\xc7\x49\xde\xa0\x8a\x74\x21\xee\x9e\xf5\x75\x1b\x1f\x97\xef\x17\x63\xe7\x34\x55\x76\x79\x89\x2a\xb6\x67\x56\xf9\xea\xb2\xfe\x35\x1f\xfa\xe4\xf3\x47\x7f\x3e\xfc\xe1\xcd\x11\xfe\xfb\xaf\x87\x27\xaf\x8f\x4f\x7e\x8c\x52\xb4\x8c\x2b\x74\x11\x13\x4e\xb7\x68\x52\x98\xc6\xd6\x4e\x74\xfc\xf6\xd5\x93\xc9\xef\x3b\xd1\xde\xf6\xb7\x18\x13\x61\x01\xf7\xce\xb7\x8f\x0e\x8f\x5e\x7d\xfb\xe8\x73\x1b\xc1\x2a\x94\xa3\xa4\x46\x71\x59\xf9\x15\xae\x58\xe7\x79\x72\x9e\xa3\xd6\x59\xb3\xba\x89\x97\x15\x4a\xb3\x66\x81\x85\xa2\x7f\x71\x97\x26\x2b\xea\xa6\xc2\x51\xda\x85\xf7\x57\x87\x6f\x0f\x5b\x3a\xad\xb1\xe4\x59\xf1\x3e\x4e\x9a\xa6\xca\xce\xd7\x0d\x8a\x9b\xbb\x15\xea\xdc\xe2\x32\x4b\xa3\x7d\xdc\x33\x97\x59\x4a\x38\xef\xe2\xe8\xeb\xe3\x77\x47\xaf\xa2\xb3\xbf\x1c\x1e\x75\xbe\x12\x45\x27\x07\x6f\x4e\xdb\x88\xfb\xf2\xf0\xaf\x98\xf5\xad\xa2\x14\xa2\xbe\x28\xd7\x45\x1a\x5d\x94\x55\x34\x8a\x12\xfd\x61\x6b\x27\x3a\x3a\xfc\xdb\x48\xe7\xf0\xed\xe9\xe1\x88\xba\x53\xd7\xe1\xdf\xba\x8f\x47\xaf\xa2\x37\xaf\x4d\x35\x57\xa1\x65\x59\xb5\x43\xf8\x1c\x16\x4d\x77\x72\x6f\xcd\xf4\x6f\x5e\x2c\xb9\xb3\x5e\x17\xfe\x1f\x3f\x6e\x59\xaf\x70\x84\x8d\x06\x01\xa2\xf2\x22\x6a\xae\x50\x54\x24\xd7\xc8\x93\x98\x1c\x21\xe2\x76\x0c\xb1\x55\x52\x51\xdf\xa2\xcb\xaa\x5c\xaf\x70\xd3\x77\x7f\x7d\xd5\x2a\xa2\x6f\x84\x3f\xc4\xd7\xa8\x49\x22\x3c\xd9\x61\x70\xed\x73\x7f\x3f\x8e\xf6\x3a\xe3\x1d\x6c\x97\xc1\xe3\xab\x0f\x62\x3c\x36\xdb\xf7\x44\xaf\xe0\x1a\x35\x31\x2b\xe8\x45\x56\xd5\xe3\x6f\x69\xd2\xa0\x05\x03\xb0\xdd\x8f\x3c\xdd\xaf\x8f\xfa\x41\xac\xfb\xfb\x09\x2f\x78\x2b\xb2\x07\x81\x31\xf1\x07\xeb\x3a\xb3\x0d\x65\x96\x1e\x81\xf9\xe9\x6c\x36\x2b\x68\x7f\x20\x63\x2f\xed\x08\xe4\x17\x6c\xfc\x74\xb3\x7d\xe6\x2f\x81\xe1\x93\x76\xdf\x32\xec\x5f\x27\x0d\xaa\xb2\x24\xcf\x6a\x34\x06\xc4\x38\x47\xc5\x65\x73\xb5\x20\x46\xd3\xfd\xe8\xa6\xf3\xac\xa8\x67\x9d\x51\x64\x85\x76\x46\xd1\x8e\x27\xfe\x67\x14\xbc\x60\x73\xcc\x28\x5a\xe1\x24\x33\x0a\x2c\x94\xbf\x19\x05\x96\x06\xa3\x73\x1c\x54\x92\x34\x8d\x92\xa8\x40\xb7\x11\x2a\x9a\xea\x2e\x6a\x4a\x6c\xd3\x1d\xab\x38\x78\x37\xad\x38\x64\x12\x71\x74\x7a\x78\x72\x16\xbd\x39\x3a\x3b\x66\x20\x16\x59\xba\xdd\xce\xc5\xdf\x1d\x9e\x46\x58\xd2\x2c\x75\x17\xaf\x55\x52\x5c\x94\x8d\xd3\xb0\x49\x73\x3c\x20\x6c\x1d\x28\x5b\xb5\xab\xf3\x68\x31\xfc\x73\x67\xfc\xbe\x3d\x2c\x48\x86\x85\x43\xfb\xed\x49\xfb\x9f\xb2\x22\x06\x9a\xa5\x03\x48\x17\xd6\xdb\xaf\xe3\x6f\xc4\xa5\x71\x33\xec\xd7\x6d\x1b\x16\x22\x8a\x0e\x8e\x5e\xd1\x88\xa3\xff\x18\xc1\x4a\x22\xa3\x1f\x2d\x96\xd5\x3c\x46\x42\x70\xc7\xb7\x49\xb3\xbc\x6a\x57\xba\x17\xa8\xc2\x6b\x5d\xb1\xc1\x48\xa0\xeb\x68\x41\xbe\x08\x2c\xa8\xa7\x7f\x7e\x17\xa5\xe8\x22\x59\xe7\x4d\x84\x9b\xe3\x59\x68\xcb\x4e\x79\x71\x91\x2d\xb3\x24\x8f\x92\xfc\x7c\x7d\x5d\xab\x69\xb2\xc3\x35\x9e\xd3\x2e\xfa\x2e\x9d\x7e\xdb\x1e\x7a\x8a\x65\x6b\x27\x7a\xda\xf5\x8a\x96\x50\xdd\x24\xcd\xba\x9e\x12\x21\xbf\xcb\x09\xec\xf5\xa2\xbb\xf4\xbb\xdb\x34\x47\xd9\xed\x38\xbb\xa1\x08\x0d\x14\xc0\x2c\x91\xc1\xcf\x52\x0a\xa7\x1f\xb2\xa2\x46\x55\x83\xd2\xb8\x2a\x6f\x6b\xc2\x2d\x95\x8f\x60\xc5\x12\x2d\x8a\x16\x64\xf0\xdf\x89\xaa\xb2\x6c\x76\xa2\xe5\x55\x96\xa7\x71\x59\xa5\xa8\xda\x69\x57\x61\x3b\x78\x66\xbe\x13\xa5\xa8\x5e\x56\x19\x4e\x13\x4e\xbb\x7d\xef\x8b\x9d\xa8\xfd\xbf\xdd\x61\x29\x45\xfe\x41\x9a\xb6\xff\x12\x36\x27\x82\xbf\x39\xfa\x21\xea\x62\xd1\xf6\x94\x5b\x32\xd4\x90\xe1\x88\x63\x9c\xfd\x7b\xbb\x0f\x74\x59\x4a\xa2\x19\xa3\x18\xb6\xc7\xda\xd5\x9c\x59\x87\xe5\xc9\x39\xca\x7d\x85\x71\x8c\x6c\x46\xeb\xf2\xba\xdc\xe4\xa7\x3b\xdc\xac\x73\x32\x2b\x18\xa7\x39\xb4\xc8\xe3\x84\x6f\x4e\xb1\x7d\xac\x51\xb3\x02\xbe\x46\xb5\xd7\x0d\x47\xe8\x63\xad\x51\xff\xc4\x4e\xd5\x5b\x06\x05\x6b\xd4\x2e\x70\xb6\x61\xb3\xff\xda\xe2\x17\x74\xea\xf8\x51\xd1\xa5\xa2\x26\x38\x8d\x9d\x54\xcd\xac\x96\x10\xfa\x4a\x99\x52\xbf\xc3\x4a\x99\x16\xd8\xdb\x4a\xd9\x97\xd7\x4f\x6c\x74\x96\xfe\x9e\x6d\x6d\x67\xe9\xd0\xde\x97\xd8\x02\xbf\x35\x5e\x62\x13\x6b\xf3\xb6\xc4\xbe\x2d\x2b\x27\x95\xd3\x76\xd2\xe2\x9a\xc9\x3c\xd6\xab\x74\x96\x6c\xc0\x9b\xd7\xe2\x55\x7e\xf4\xe6\x34\x7a\xf5\xe6\xf4\xec\xcd\xd1\xcb\x33\x12\x02\x84\xdb\x0b\x6c\x02\xdb\x5f\x6a\x21\xf2\xba\xf5\xd1\x67\xbb\x3d\xea\xdf\x67\xd2\xa2\xef\x03\xda\x23\x25\xfa\x67\x40\xa4\xba\x37\xcc\x7c\xf0\xca\x36\xdc\x8a\xf1\xa4\x5d\x4c\xc3\x5d\x8b\xdd\x52\xef\x3f\x48\x02\xaf\xfb\x8b\xd6\x54\x17\xaf\x70\x8e\x81\x88\x13\xf5\x25\x10\xeb\xba\x0b\x4a\xdd\x1f\x63\xea\xa0\xfb\xbd\xdf\x5b\xe1\xd0\xe0\x89\x70\x64\x8e\xc6\x93\xe6\xfc\xac\xc2\x3a\x81\x44\x6b\xab\x56\xb0\x76\xd2\xd8\xb1\x3f\x59\x49\x75\xbf\xd3\xbf\xd0\xdb\x5f\xcc\xb6\xd4\x03\xdd\xfe\x6a\xf5\xec\x65\x3d\x22\x74\xf6\xce\x5a\x01\xee\x6d\x9f\x3c\xf7\x33\x3f\xf0\x64\xb2\x1e\xd6\x38\x9f\x90\x22\x3b\x51\x98\x69\x66\x2f\x0a\xfb\x23\x23\xca\xe3\xc7\xec\xb2\x2a\xca\xea\x68\x79\x95\x14\x97\x28\xdd\x91\x6e\x1d\x76\xf3\xb8\xb2\xca\x2e\xb3\x22\xc9\xa3\x93\x1f\x76\xa8\x19\x5f\xf7\x15\x67\xf4\x0a\x34\x50\x9a\x7f\x3f\xf1\xe3\xac\x08\xe9\xae\x03\xad\x86\x26\xdc\x0a\x6c\x00\x84\x68\xc2\xd0\x2c\xfe\x14\xf6\x4a\xf1\xcd\x51\x44\xeb\x61\x87\xb6\x85\x6d\x87\x95\x23\xad\x00\xe7\x95\xe3\x27\x14\x56\x1c\xd4\x39\xcf\x9c\xf6\x61\xaa\xb1\x13\xa5\x5b\xe0\xfe\x07\xbd\x33\x3c\x89\xc7\xdd\xcf\xe2\x40\x2c\x58\x4e\x0f\x61\x98\x34\x84\x87\x62\x1f\x3b\xda\xa6\xe8\x34\xab\x77\x4e\x67\xcc\x56\x78\xf4\xdd\xf7\x11\xb3\x70\xa7\x35\x67\xbd\xb3\x4e\x53\xfc\xb8\xb9\x83\xf3\x71\x63\x74\x20\xf8\xcf\x7e\x3f\xc9\xc5\x6f\xa8\x65\xc3\x7e\xb4\xe8\xb6\x02\xba\x1f\x86\xcd\x4d\xbe\x17\x04\xbb\xd8\x26\x5b\x03\xe7\xd8\xe5\xf3\xa4\x6e\xda\x7f\x24\x0d\x4a\x63\xbc\x53\xe1\x2a\x07\x8d\xb1\xe5\xf4\xf8\x6f\x0b\x67\x2e\x3d\xcc\xc1\x3b\xdb\x24\x26\x31\x5d\x67\x63\xce\xf1\x37\xd2\xf6\xe8\x55\x5f\xf3\xdc\x77\xc7\xd9\xc9\xbb\xae\xf0\x8f\xe4\x40\xfa\x81\xae\x37\xed\x7e\x91\xb7\x3d\x20\x18\x71\x4e\xe9\x91\x42\x5e\x12\x71\x69\x3b\x25\xed\x07\x37\xa1\x50\xec\xab\xda\xb4\x0a\xee\xfd\xe2\x91\xb0\x1f\x8a\xf2\x76\xc1\x0c\xb7\x26\xbd\x40\xea\xf0\xbb\x4d\xd6\x76\x21\xe8\x38\x48\xf4\x3a\xed\xca\x6e\xcb\x3c\xa5\x91\x77\xaa\xcd\xcb\x5b\x54\x2d\xda\x1f\xb6\xa3\xfd\xee\xaf\x7e\x21\xba\x4d\xb4\xac\x5e\x49\x1e\x34\x0d\xba\x5e\x35\x51\x53\x46\xeb\x1a\x45\x49\xb4\xaa\xd0\x4d\x56\xae\xeb\xfc\xee\xf1\xba\x46\x69\xb7\x6b\x8c\x17\xb9\x4f\xb6\xe4\x23\xa9\xb9\xa6\xae\x92\x1a\xcf\x96\x9c\x82\x42\xab\xa7\x56\xde\x73\x74\x99\x15\x18\x5d\x7c\x87\x92\x8a\x2e\xb8\x8e\x8e\x4f\x7a\xf1\x39\xc8\xeb\xb2\x20\x66\xa7\x07\x4d\x93\x3b\x05\x20\x2a\x52\x10\xed\x01\x4e\x47\x79\x00\xd4\xd2\xc5\x76\xdb\xba\xdd\xb0\xe9\x8a\xbd\xea\xf8\x6c\xb0\x9f\x41\xcf\x54\x45\x2e\x55\x87\x3b\x84\xca\xe1\x03\xe3\x5c\x2a\x83\x49\xd2\x34\x22\x98\xdb\x3f\xda\xf9\x2c\x3e\xdc\x51\x5f\x65\xab\x08\x53\x68\xae\x92\x26\x4a\x4b\x54\x47\x45\xd9\x44\xf5\x7a\xb5\x2a\xab\x86\x34\x21\xb6\x34\x5a\x92\x95\x1d\x95\x45\x53\x95\x79\x8e\xd2\xf8\xa2\xac\xe2\xdb\xab\xac\x41\xf5\x2a\x59\xa2\x45\x83\x3e\x34\xa3\x55\x9d\x97\x65\x8e\x92\x82\xb5\xaa\x69\xd1\x3f\x4e\x52\xa1\xa4\x5a\x5e\xc5\xab\xa4\xb9\x8a\xce\x8e\xa3\x2d\x8a\xdc\xd6\x4e\xb4\x45\x4e\x70\x6c\x0d\xa6\x88\xcf\x08\x74\x7a\x6e\x75\xbe\x4a\xd2\x14\xa5\xf1\xf9\x1d\xcd\xcc\xe7\x7b\xdb\xb8\x53\xc6\x9f\xe2\x65\x99\xe7\xc9\xaa\x46\x69\xfb\x11\x56\x48\x4f\x4a\xfc\xe3\xf3\x72\x5d\xe0\x71\x7b\xb9\x3e\x47\x8b\x74\x5d\x11\x95\x47\x59\xd1\xa0\x4b\x54\xfd\xfc\xcb\x4e\x74\xb1\xfe\xe7\x3f\xef\xfa\x1f\x46\x2d\x10\xde\x9f\xb4\xed\x84\xfe\x25\x38\x02\xf1\xf9\xa3\x57\x87\x2f\xdf\x1e\x9c\x90\x5f\x56\x65\x56\x34\xed\x3f\x5a\x14\xc4\xfb\xeb\xa6\x22\x46\xf7\x9f\x07\x27\x2f\xff\x72\x70\x42\x7e\xcd\xfa\x68\xf3\xe6\xe8\xec\xf0\x87\xc3\xee\xd7\x14\xd5\xcd\xf4\x57\x32\x7f\x9a\xc0\x66\xd7\x04\xc3\xcb\xe3\xa3\xd3\xb3\x83\xa3\xb3\xfe\x73\xb4\x1f\x3d\xef\x48\xe3\xf3\x09\x28\xed\xbf\xfc\xfc\x4b\xf7\xfb\x32\xc9\x51\x55\x47\xf4\xef\x24\x5e\x50\xf4\xf6\x23\x7c\x34\x24\x5e\xaf\x56\xa8\x1a\xb5\x48\xed\x71\xbd\xee\x20\xbf\xc3\xbc\x30\x31\xf4\xf5\xf1\x49\x94\xb5\x4b\xb6\xbd\x27\x4f\xda\x8f\x6f\x8f\x8f\xff\x3a\x7c\xa4\x59\xfb\x39\xfb\x85\x1c\xf5\x62\x3e\x12\xfe\x26\xdf\x5a\x57\x68\x31\x7d\x2b\xa4\x43\x98\xd1\x50\x1a\xe4\xf8\x39\xfb\x45\x41\x74\x4f\x46\x94\x49\x0c\x06\x24\x25\xb6\x9c\xfd\x68\xd1\xb2\xf1\xc7\x68\x91\x3d\xde\xdb\x8e\xfe\x07\xe9\x1f\x7c\x2e\xe5\x5b\x31\x5f\x6d\xb3\x96\x3c\xf7\xc3\x9f\x00\x7a\x1a\x9a\x32\x7f\xff\x49\xa1\xba\x71\x5e\x41\xbc\x62\x3f\xda\x5a\x74\xc3\xa5\x52\x93\x6f\x5e\x47\x59\xf4\x7d\xb4\xc7\x9a\xd8\x88\xa5\xfd\xef\x6f\xbf\x45\x5b\x3b\x5b\x2c\xe9\x7e\xfc\x9d\x40\x2e\x93\xba\xe9\x4f\xef\x90\xce\x79\x1c\x2d\x48\x48\xf8\x23\xd5\x2d\xdb\xdb\x51\x52\x47\x38\x4e\x8e\x12\x8c\xf2\xb0\xc4\xb7\x77\x42\x91\xe5\x4f\xee\xb2\x6c\xb1\x85\x6b\x75\x53\xbd\x78\x41\x22\x1a\x78\xdc\x21\xa1\xb8\x6d\x14\x5f\x54\xe5\x75\x3c\x18\x94\x28\x20\x87\x13\x81\x25\xb1\x56\x18\x97\x2d\x23\xb0\x79\xa4\x1d\x2c\x6a\x57\x60\x51\xc2\x18\x33\xf6\xae\x51\xa8\x76\x08\xa1\xc0\x30\x79\xaf\xe1\x2b\xe8\x78\x44\x2b\xf9\xa3\xba\xaa\x62\xd3\xb6\x2a\x6f\xe3\x2c\x9d\xce\x93\x6e\xca\xae\x56\x11\xba\x00\xe9\x10\xd1\xd3\x74\x9b\xcc\x1d\x41\xa3\xca\x57\x9a\x89\x3d\x92\x6a\xce\xf3\x68\x79\x95\x54\xc9\xb2\x41\x55\x74\x93\x54\x77\x59\x71\xd9\x13\xec\x15\xb0\x13\xdd\x24\xb9\x9d\x36\xe8\x10\x35\x96\xd4\x75\xa8\xbe\xd5\x1f\x10\xbb\x28\x2b\x92\xf2\xcb\x53\x52\x8b\xda\x03\x97\x55\x94\xa2\x1c\x35\x28\xca\x9a\x28\xbb\xa0\x9a\xdc\x96\xeb\x3c\x8d\xd2\xaa\x5c\xb5\x6b\x8f\x5d\xa2\xb0\xbf\x1f\xbe\x7c\x77\x76\x18\x6d\x75\x13\xf2\x11\x1a\x2f\x7c\xb6\x5a\x9b\x6a\x55\xd1\x9a\x16\xbd\xf8\xc1\x1f\x3a\x65\xe0\x6f\xad\x83\x90\xec\xdf\x56\x5f\xe5\xd4\x61\x1a\x26\x87\x23\xee\xef\xf6\xb1\xe2\x98\x1e\x1f\x38\xa1\x8f\x42\x42\xe8\x0b\x02\xcc\x80\xab\xcb\x47\xb2\x68\xc8\xf6\xcf\x05\xb5\xf5\x73\x31\x64\x38\x31\x64\xcb\x9b\x9e\xa0\x93\xa9\xe5\x78\x39\x83\xba\xab\x0e\xd6\xe7\x71\x59\xc4\xa4\xdb\xcc\x57\xf6\x51\x34\xd9\x76\xef\x92\x1e\xf5\xfa\xbc\x5e\x56\xd9\x39\xa2\xa8\x45\xf5\xfa\x9c\x28\xaa\xd5\x43\x72\x93\x64\xd8\xd1\xa3\xfd\xe8\xf5\xc1\xdb\xd3\xc3\x9d\x08\xe7\x77\x6a\x84\x8a\xb8\xdb\xa4\x3f\x7e\xfb\xea\xc9\x50\xe4\x39\xa6\x0d\xcb\x8a\xc6\xda\xfe\x93\x00\x10\xa5\xd5\xeb\xf3\x27\xd4\x67\x82\x25\x4b\xf1\xa2\x6e\xf2\xad\xfd\x03\x97\x31\xd0\x91\xeb\xf8\xed\xab\x6e\xb9\xeb\xa0\xd3\x55\x95\xdd\x24\xae\x4a\xed\x52\xf6\x64\x2a\xd2\x2b\x0a\x4b\xd2\x0a\x35\xfc\x8c\x13\x79\x94\x4d\x9b\xf4\x85\x6d\x6f\x00\xfa\xc3\xa5\x47\xe8\xfc\x48\x0b\x33\x1e\x68\xc1\x00\xe4\x4f\xc1\xe8\xc9\x56\x48\x18\x74\x21\x6a\x50\x5c\x56\xab\xab\xa4\x40\x54\x76\xd6\x22\xdd\x45\xf7\x5f\x3f\x98\xb4\x1d\xf4\x68\xd0\xd9\x98\x61\x2d\xd7\x0d\xaa\x62\x82\x72\xb2\xb7\xdc\x01\x3d\x1a\x15\xd1\x4a\x5d\xc7\x2b\x54\x74\xe9\xd9\x5d\xea\x1b\x75\xa7\xc5\xe4\xac\xd1\x40\x9e\xe9\xb6\xe8\x7f\x1d\xbf\x39\x22\x85\x3f\x23\x47\xa8\x8a\x8e\x8f\xa8\x53\x46\xa8\x62\x0f\x19\x75\x35\x40\xd5\x13\x3a\x4d\xdc\x89\xc1\x1e\x61\x6a\x99\xc2\x79\xa5\x37\x47\xd1\xe2\xab\xbd\x9d\xe8\xe9\xee\x57\x3b\xd1\xd3\xbd\xaf\xb7\x29\xa0\xf1\xbe\x84\x08\x9f\x37\x80\x48\x32\x4d\x59\xe3\x7f\x4b\x38\x72\x20\x91\xc7\x49\x85\x12\x4a\x39\x9d\xf0\x45\x93\x35\x77\x7b\x32\xb9\xdf\x1d\xb5\xd6\x75\x30\xdc\x1d\x21\x41\x8c\xa7\x13\x73\xa0\x26\x67\x65\xe6\xc0\x3c\x96\x29\xcd\x82\x9e\x1c\xc3\x98\x03\xf3\x2a\x4f\x96\x68\x16\xcc\xe3\xce\x1c\x04\x7b\x74\x7c\xd2\xfd\xbe\xeb\x8d\x2a\xa9\x9b\xa1\x69\x7a\xc6\xdd\x55\xec\xcc\x41\xa1\x46\x55\x86\xea\x59\x50\xdf\x96\xd5\x7b\x5b\xc4\x6a\xcc\xeb\x2a\xd7\x23\xde\x1e\x97\xbb\x93\x0a\xc1\xc7\x8f\xa3\x13\x74\x5d\xde\xa0\x88\x3a\xb2\x78\x51\x95\xd7\xe4\xf0\x5b\xdd\x27\xe2\x8b\xad\xa6\xdb\x7d\x8f\x6e\xaf\x50\x73\x85\xaa\x76\x8a\x5d\x94\x4d\xd4\x5c\x65\x75\xc4\x0f\x10\x8f\x1f\x47\x59\x1d\xf5\x43\xd8\x93\xee\x57\x7a\x4e\x9b\xd5\xd5\x52\xb0\xc9\xc7\x8c\x36\xdf\x0a\xda\x8d\xb2\x27\x79\x96\xd4\x8e\x28\x8a\xa2\x24\xd7\x4a\xb9\xe1\xb9\xcc\xda\x61\x3a\xcd\x2a\xb4\xec\xf7\x91\x0b\x84\x57\x05\x46\x68\xda\xc5\x39\xfe\x7f\xb7\x6e\xec\x34\xc9\xa5\x33\x02\x18\x17\x2a\x2c\xd2\x49\x84\x62\xa6\x84\xeb\x05\x8c\xa7\x4a\x44\x6f\xf5\x02\x15\x0d\xd9\x3c\x42\x1f\x9a\x9d\x28\x4b\x85\xa9\x34\x9c\x25\xeb\x0e\xad\x8e\x2b\x58\x82\x22\xaa\xaf\x93\x3c\xcf\x8a\x66\x5b\x3d\xb1\x8a\xd9\x95\x2b\x76\x15\x3c\x31\x3d\x3b\xfc\xfb\x19\xbd\x6c\x1d\xbf\xec\x47\x3d\x77\xed\x9a\x8a\xea\xea\x2d\x66\x37\xf4\xff\xbc\x3b\x3c\xf9\xa9\x77\x7e\xc5\x62\x70\xc0\x4b\xad\xd0\xf0\x17\x9a\x4a\xb4\x1f\x25\xc5\xdd\xe2\xf3\xbd\x6d\x26\x1d\x13\x31\xc7\x2b\x87\xe3\xbb\x98\xa3\xad\x11\xf2\xdd\x29\x39\x7d\x59\x8b\x56\x77\x80\x6d\xa7\xae\x73\xd6\xc5\xba\xc6\xc5\x0f\x97\x8b\xd6\xac\x6c\xf3\x26\xf4\x4c\x96\x56\xc7\x68\xeb\xd8\xd0\x08\x09\x6c\x43\xfd\xc6\x63\x3f\xa1\x3d\x8a\x2e\xca\x0a\x65\x97\x45\xfc\x1e\xdd\xc5\x37\x59\x49\xf6\x1c\x71\x34\x1c\xa4\x33\xb6\xbd\x4e\xbc\x75\x95\x2f\x24\x06\x67\x96\x07\x59\xe6\x28\xa9\xe2\xf5\x6a\x9a\x1a\x1d\x86\x82\x83\x93\x93\x83\x9f\xc8\x54\x91\x3b\xd6\xda\x0e\x07\xeb\x2a\x8f\xab\xf2\x96\x56\x4a\x6b\x04\x59\xda\x1d\xc9\x26\x7a\xa1\x5b\xb7\xad\x86\x89\x38\x1e\x52\x86\xc9\x78\x3b\xff\x5e\x50\x13\xf0\x0e\x80\x14\xc7\xd0\xb7\x00\x0c\xe5\x35\x7b\x12\x22\xfd\x14\x84\x9e\xcc\xae\xab\x1c\x0a\x8d\x67\xa8\x60\x78\x32\xed\x04\x83\x53\x73\x49\x70\x1b\x32\x41\x04\x83\x93\x59\x1f\x18\x9c\x19\xe1\xa1\x4d\xc8\x0c\xc9\xb8\x01\xa9\x45\x05\x37\x23\xf3\x24\x38\x7c\x6b\x8f\x3a\xe0\x5d\x6b\xe0\x76\x6a\xf5\x88\x1c\x0e\x3f\x3b\x1e\x9c\x07\x8f\x2a\x74\x94\x68\x41\x55\x83\x74\xeb\x21\x7d\x63\x9c\x44\xe5\x1a\xf3\xde\x44\xc1\xc2\xe3\x45\x71\x17\xa7\x68\x55\xa1\x25\xae\x06\xcb\xb3\xe2\xbd\x43\x01\xcb\x9b\xd7\xd1\xe2\xec\x87\xf8\xf8\xaf\xd1\x7e\xb4\x45\xce\xcb\x6d\xb5\x53\xfa\xf1\xc7\x3e\x0f\xd9\x67\x68\xc6\xf2\x8d\xae\x6e\x70\x2c\xdb\x18\x8b\xaa\xfa\xd2\x8f\xac\xa6\x78\xf5\x5e\xfe\x41\xb6\xc1\xda\x19\x64\x37\xa7\xe4\x4a\x40\xb2\x02\x97\x85\x50\x1c\x4c\x2a\x44\x3c\x14\x81\xe0\x1e\xa9\x57\x68\x99\x25\x79\xbc\x5a\x57\xab\xb2\x46\x24\xfd\x98\x95\x85\x4b\x69\x91\x4c\x74\x94\xb6\xc2\x77\x79\xe9\x24\xea\x48\x47\x1d\xe9\xa8\x2a\xdb\xc9\x00\x94\x7b\x54\xa4\x24\x8a\x66\xd7\xab\xbc\xf5\x48\x5c\xd2\xe3\xa1\xbc\xb8\x47\x3c\xb9\x7a\x92\x49\x54\xf3\x45\x44\x7e\xea\xbb\x86\xea\xa5\x39\x84\xfa\x28\x75\x56\x1f\x4f\x53\xf5\xba\x42\xc4\x02\xd8\xb3\x5c\x71\x92\xe7\xe5\x6d\x1d\xb7\xb3\x60\x2f\xd5\xe6\x2c\x05\xf4\xa1\x11\x5c\x4a\xaa\xc8\xfb\x45\x92\xdc\x1f\xf9\x1f\x0e\x2d\x02\x29\x58\x30\x12\x71\x04\x60\xe3\x61\x79\x2d\x0e\xc2\xe2\x45\x85\x88\x10\xf8\x23\xa4\xce\xf1\xac\x5d\xe0\x0e\x88\x49\x1a\x71\x99\x14\x78\xf1\xbb\x2c\x8b\x26\xc9\x0a\x8c\x15\x2f\x3b\xb6\x66\x38\x28\x37\x74\x35\x9e\x04\xcd\xdc\xd9\x3c\x8d\x79\xba\x5b\x20\x89\xb8\xc3\x05\x80\x74\x97\x6b\xf1\x3c\xf4\x4e\xef\xee\x5a\x9e\xb3\xcf\x27\x24\xe6\xe8\x72\x91\x1c\xa2\x1e\x17\xc1\x51\x57\x8c\x69\xb1\x3c\xf4\xfe\xa6\x96\x22\xb3\x76\xba\x98\xce\x1c\x3d\x2f\x95\x48\xd4\xfd\x52\xe0\xd1\x06\x80\xf8\x1e\xba\x21\x90\xf5\xe5\xac\x36\x30\x21\x31\x47\xf7\x8b\xe4\x10\xf5\xbc\x08\x6e\xec\x74\x3d\x96\x87\xde\xdf\xdd\xdd\x23\xb3\x76\xf8\x94\xc6\x1c\x3d\x2e\x94\x44\xd4\xe5\x42\xc0\x27\xdc\x31\xb8\x4f\xba\xd3\x49\x56\x68\xd6\x3e\x9f\x90\x98\xa3\xcb\x45\x72\x88\x7a\x5c\x04\x37\x76\xb8\x1e\xcb\x43\xef\x6f\x6a\xe7\x69\xce\x3e\x17\x92\x99\xa3\xdf\x65\xf2\x88\xfa\x5e\x06\x4b\x5f\x77\x06\xc1\xf6\xf0\x6d\x80\xa4\x5d\x67\xb6\x80\x09\x91\x79\xfa\x5f\x24\x8b\xb8\xf7\x45\x90\x93\xab\xee\x7e\x17\x3d\x4f\x12\xee\x3e\xfb\x5f\x50\xa6\x27\x25\x66\x63\x07\x93\x1a\x04\x95\x28\x8f\xc4\x3d\x2f\x02\x9d\xf6\xbf\x12\xe1\xc7\xed\x7e\x69\xb7\xc3\xf7\x08\xbb\x8e\xef\xb6\x4c\x66\xf5\xf8\x29\x8d\x39\x1c\x5e\x28\x89\xc8\xdf\x85\x80\x63\x77\x03\xf0\x3c\x74\x6f\xc7\xf7\x16\xce\xda\xe5\x3c\x05\xd7\x0e\x27\x5d\x2c\xe0\x5b\xd4\xc1\x02\xb0\xb1\x7b\xb5\x38\x1e\x6c\xe7\xe2\x43\x5b\x57\xe8\xc3\xa2\x89\xd8\x93\xc2\xdd\xc6\xbf\xb0\x13\xc7\xd3\x59\xd1\xe9\xd9\xc9\x9b\x97\x67\x54\xaf\x32\x27\x20\xa2\x93\xc3\x97\xc7\x27\xaf\xe8\x0a\x92\xd7\xc7\x27\x51\x15\xbd\x39\x9a\x9c\x51\xf8\xb0\xb5\xb5\xf5\xdb\x6f\xcd\x6f\xbf\x6d\x6d\x6d\xbd\x78\xd1\x91\x6f\x91\x5e\xa1\x0f\x5b\xec\xc9\x9c\x4e\xe8\xea\xc9\x15\xfa\xc0\x9f\x9d\x39\x3c\x7a\x05\x91\xfb\x12\x15\xa8\x4a\x1a\x14\xaf\xd7\x59\x1a\xdf\x3c\xc3\x17\x07\xe0\x13\xc9\xa2\xf3\x21\xb8\x32\x65\xf2\xfb\xa8\xac\x16\x89\x93\xa6\x6e\x92\x7c\x8d\x5a\xbc\x2d\x91\xc5\xb3\xe7\xdd\xc1\x97\xf3\xbb\x06\xd5\xfd\xcf\xb4\x16\xc9\x87\xfd\xe8\x3a\xfd\x72\x91\xa2\x65\x99\xa2\x51\x80\x9d\x68\xab\x55\xd9\x76\xf4\xdb\x6f\x11\xf5\x6d\x27\xda\x42\xf5\x32\x59\xa1\xad\xed\x0e\x3b\x21\xba\x1f\xd5\xeb\xf3\xba\xa9\x16\x18\xe7\x4e\xb4\xf7\xa7\xdd\x9d\xe8\x6b\x0e\x84\xfc\xff\xdf\x7e\x8b\xb6\x1e\x6f\x49\xbe\xf0\x58\x9e\xfe\xf1\x8b\x9d\xe8\x0b\x73\x3c\xf9\x2a\x49\x17\x4d\x89\x8d\x72\x31\x98\xe7\x14\xfb\xf3\x9d\xe8\xe9\xf6\x76\xf4\xff\x46\x7b\x5f\x6e\x47\xbf\x45\x5f\x7c\xbd\xbd\x13\x3d\xdd\x89\xb6\x76\xb7\x64\x34\xa7\x38\xbe\x1a\x9f\x07\x98\x81\xc3\xaf\x7b\x0e\x9f\x3f\x6b\x39\xdc\x7b\x6a\xc3\xe2\x37\x56\x2c\x4e\xf1\xec\xed\xee\x44\x7b\x3d\xa6\x0a\x35\xeb\xaa\x20\xe0\x2f\x5e\xb4\xd6\x0b\x8f\x18\x9c\xe7\x7c\xb1\x00\xb8\x81\xd2\xea\xbb\xe3\x9a\xc4\xea\x47\x13\xef\x65\x1a\xfe\x47\xab\x7d\x89\xb2\x7c\x51\x25\x45\x5a\x5e\x2f\xb6\xa3\x3f\x46\x4f\xbf\xfc\x72\x1b\x07\x0d\xad\x86\x3f\x3d\x2c\x30\x43\x0d\x9c\xcb\xc1\x9f\xa4\x14\x06\x4f\x7f\xfe\xc5\xf6\x03\xe2\x79\xf4\xfd\x30\x98\x0e\xc4\x1c\x36\x58\x1e\x51\x33\x19\xab\x40\xac\xb8\xc4\xf9\xa3\x1f\xe8\xd5\x5c\xa2\xe7\xf3\x40\x2f\x4b\x6a\xee\x03\xbd\xda\xe7\x32\xfa\x13\xbb\x05\xba\x1d\xdf\xcc\x98\xe3\xfc\xed\xb7\x2c\x66\xc3\x33\xb0\x7f\x32\x38\x03\x6b\xde\x23\x35\xaa\x70\xf9\x37\x39\x43\x57\xa1\x5f\xd7\x59\x85\x6a\xfc\x58\x77\x56\x5d\xa3\x34\x46\xd7\x49\x96\xc7\x49\x9a\x56\xa8\x76\x2b\x12\xc4\xf7\x39\x3d\xa2\x56\x7a\x04\x75\x47\x0a\x97\x30\x31\xe6\x8a\x8f\xf6\x4d\x41\xbe\xeb\xae\x38\x23\x6b\x92\xf1\xb0\xe5\xa3\x88\x79\x0d\xac\xac\xd8\xf7\xc0\x30\xc0\xb6\xa2\x28\xf0\x90\xd4\xe1\x37\x55\x46\x8a\xe3\xba\xca\x40\x5c\x7b\x7c\x9b\x35\x57\xe5\xba\x89\x92\x68\x50\x0c\x61\x2d\xea\x14\x43\x6a\x00\xfb\xe5\xdd\xe4\xb4\xa5\xd1\xc2\x4e\x79\xc5\xe1\xf8\x83\x6b\x94\x18\xae\xe3\xed\x0f\x49\xe0\xe7\xe0\xd9\x2b\xe8\x1e\xf5\x2a\xee\x8b\x31\x45\x37\xd3\xd1\x2c\x6d\x77\x10\xdb\xe4\xf0\xe1\xa3\x49\xca\x9d\x74\x0a\xdd\xe4\x51\xbf\x06\x1f\x61\x64\x77\xe9\x91\x03\x8d\x84\x82\x81\x3e\x35\x3c\x4f\xd5\x28\x5c\x41\x73\xa7\x2e\x06\x2b\xee\x5e\xea\x26\xbc\x11\xf4\xdb\xea\xeb\x03\x3f\xdf\x83\x1d\x5b\x10\x5e\xc4\x65\x75\x2b\x18\xcf\xf3\x79\x53\x65\xd7\xf8\x4e\xaf\xef\xbe\x87\xb3\x53\x91\xa2\x97\xac\xb8\x49\xf2\x2c\x1d\xb3\x2a\xae\x97\xdf\x99\x9d\xd1\x5d\xd0\xef\x6e\xe4\x59\xf1\xbe\xbb\xe9\x95\x7d\x66\x6b\x3b\xca\x93\x68\x41\x3e\x73\x9f\x06\x74\xf8\x6c\x41\x0b\x12\xe5\xd1\xf1\x51\x94\x13\xdb\xcc\x13\x8c\x75\x0a\x45\x2a\x8e\xf3\x86\xc0\x8e\xbf\xec\x47\x79\x43\x9f\x9d\x1b\x1b\x70\x37\xdb\xe7\x09\x69\x9b\x34\x03\x25\x49\x66\x8a\xa5\x39\xc1\xd3\x74\x88\x9a\xa4\xe1\x30\x60\xac\xcd\x93\xaa\x2c\x1b\xec\x53\x18\x44\xcc\xea\xb6\xfe\x52\x45\x3a\xb7\xf5\x87\x28\xab\xa3\xae\xeb\xf1\xd0\x89\x15\xd7\xdf\xcb\xcf\x72\xb1\x33\x14\x63\xfb\xa9\x30\xad\xf0\xb9\xc0\xfe\x80\x8b\x55\xb9\x3a\x77\x4e\xaa\x6c\xae\x50\x15\x27\x55\x1d\xa3\x0f\x59\xdd\x44\x7f\x3e\x3e\x7e\x7b\x78\x70\x44\xaf\x62\xf9\x99\x80\xf4\x96\x50\x3c\x0a\xff\xba\x2e\x1b\x14\x67\x29\x2a\x9a\xc5\xd9\x0f\x31\x76\xbd\xf8\xe8\xe0\xc7\xc3\xed\xe8\xb7\xdf\x98\x8c\x63\x3f\x82\x63\xfd\xb5\xc1\x60\x9b\x9c\x74\xc2\xc7\x06\x38\xbe\xf0\x07\x72\xfa\xa9\xaf\x9b\x1f\x4f\x50\xb6\x6e\xc3\xcb\x41\x77\xa9\xfc\x09\x73\x96\x83\x11\xb3\xb6\x61\x7f\xa9\xb3\x75\xfb\x36\x7a\xc5\x64\xe2\x6d\x86\x83\x3f\xc2\x37\x35\x2e\xc6\xba\xa8\xdb\x7e\x01\x91\x8d\xb5\xaf\x75\xe5\xf6\x84\xde\xeb\x88\x31\x80\xe8\xed\x9b\xff\x7d\x18\x6d\x91\x43\x22\x7f\xd8\xa2\xbb\xa8\x37\xb1\xe9\x01\x2e\x7c\xb0\xea\xe7\x56\xd0\xee\xa4\xc9\x2f\xdb\x02\x69\xa5\xa4\xfe\xd0\x22\xb1\x24\xb5\x67\x42\xca\x86\x4c\xff\xec\x62\x96\x0a\x29\x59\x5d\xd8\x5c\x21\x52\xb2\x51\xe6\x69\x7f\xd3\x48\x92\x5a\x54\xeb\x9b\x5d\xdd\xf2\xa8\x1b\xa9\xd8\x8b\x42\xda\xc8\x20\xb8\x27\xa4\x15\x68\xa7\x53\x13\xfe\x88\x8f\xb0\xd5\xa8\x68\x86\xca\x32\xfa\x47\x02\x49\xcf\x67\x99\xc9\x2c\x8e\xee\xcc\x0d\x21\xed\xb7\xf1\x07\xc5\x41\x6b\xee\x84\x2b\xb3\x2d\xe1\xbe\xd1\x68\xfe\xb6\x80\x87\xf5\xae\xfa\x69\x89\x29\x03\xe4\xec\xc5\x3e\xf9\xf2\x64\xf8\x65\x87\x09\xd4\xe2\xff\x09\x90\x91\x03\x1a\x0c\x36\xfc\x93\x25\xba\x34\xb9\x63\x91\xa5\xc9\x5d\x87\x89\x4c\x80\xf8\x39\xd2\xc8\x7f\x44\x11\x8f\xb8\xb6\x93\xd6\xc6\xed\x27\x4f\x40\xf4\xff\x7b\x7b\xf8\xfa\x8c\xcc\x59\x98\x57\x3b\xaa\x3b\x7c\x68\x93\xfb\xad\xdf\x60\x1f\xdf\xf8\x18\xee\xd2\x8e\x28\x9b\xef\xbf\xb1\xd7\x2d\xec\x47\xbc\xe9\x50\x0d\xf1\x95\x05\x1f\x45\xbc\x75\xf1\xbe\x28\x6f\x0b\xa1\x98\xdc\xb7\xb9\xc4\xdd\x8e\x86\x9b\x8a\xa2\xe3\x93\x57\x87\x27\xd1\x9f\x7f\x1a\x45\xc5\xde\x7d\x1a\xbd\x3d\x38\x3d\xa3\xa5\x9e\xfe\xdc\x1a\xdb\xf8\xe3\x80\x71\x72\x3b\xcc\x29\x31\x48\x6a\x91\xdd\x2d\xe4\x58\xf6\xe0\x11\xbb\xc6\xe7\xaa\x2e\xee\xe2\xee\xb6\xe2\xab\xac\x68\x9c\x17\x12\x87\x7f\x7b\xd2\xce\x3f\x49\x06\x77\xbf\x1d\xb1\x0e\x4e\x7e\xf8\xcf\x9f\x77\x7f\x21\xbf\x4c\x0e\x43\xd5\x65\xd5\x90\xf8\xfc\x62\x7c\xfb\xea\x5b\x06\x84\xbf\x94\xfb\xc5\x3e\x15\x39\x05\x40\x44\xcf\x3a\xa8\x56\xed\x42\x18\xf6\x74\x98\x1a\x44\x41\x8a\x39\x13\x66\x0f\x81\xd2\xf6\x13\xbe\xf9\x8a\xfd\x96\x97\xcb\x24\x47\x6c\x3b\xc0\x2c\xbf\x8d\xc6\xdd\x03\xe3\xe4\x0d\x02\xb2\x6f\xbb\x1f\x6d\x6d\x7d\xcb\x7e\xbd\xcd\x9a\xab\xb8\xcc\xd2\xba\x0d\x85\x49\x5e\xa3\x6f\x47\x7b\x22\xbb\xb2\xfc\x12\x7f\x7d\xdd\xc5\xb6\x71\x38\x19\x72\x49\x24\x12\xf7\x9e\x28\xfe\xfa\xd9\xaa\xac\xb3\x76\xe8\xfc\x4c\x02\x70\x51\x56\xd7\xc9\x70\x25\x20\xf9\x4d\xbc\xbd\x1c\xbd\x3a\x7c\x7d\xf0\xee\xed\x59\xb4\xb5\xf5\xe2\xc5\xf4\x2b\x8b\x96\xbd\xbd\xaa\x27\xdd\x63\xd8\xe5\xa0\x99\xcb\xfe\x9b\xec\x1a\xd5\x4d\x72\xbd\xc2\x09\x2a\xfc\x67\xf4\xcf\xb2\x40\x43\x6b\x9c\x28\x23\x0d\xd9\xd7\x49\xd4\x44\xf0\x75\xa9\x27\x07\x6f\x8e\xce\xfa\xca\x68\x86\xc9\x18\xdf\x7d\x1f\xbd\xfc\xcb\xe1\xcb\xff\x1d\x2d\x16\xac\x00\xdf\xef\x47\xbb\xdb\xdb\x8f\xb6\xb5\x01\x80\x62\x28\xbe\x4e\x9a\xe5\x15\xaa\xe3\x65\xda\x94\xcb\xc5\xb4\x67\x77\xa6\x13\x04\xd3\xcc\xc7\x30\x1c\x7c\xbe\xf7\x84\x7d\x03\xe5\xf3\xa7\xd1\x9f\xa2\x97\xc7\x07\x6f\x0f\x4f\x5f\x1e\x8e\xc3\x63\xbf\xd0\x23\xd9\xea\x3f\xd2\x39\x9d\x47\x5c\xf0\xee\xcc\xaf\x5d\xcc\xf5\x17\xb8\x2d\x7a\x6b\x8a\xf6\xa3\xdd\xe8\xf8\x04\x1f\x63\x4e\x9a\xa4\x7b\x57\x73\x3f\x6a\xaa\x75\x97\x8a\xd8\xde\x89\x76\x81\xf7\xa7\x37\xd5\x65\x3c\xbd\xcc\xc2\xcb\x5d\x6c\x53\xb4\xf2\x77\x4d\xe1\xb3\x41\x21\xc3\x71\x85\x2e\xe6\x62\xba\x5d\x60\x34\x89\xf0\xf1\x17\x38\xd7\xeb\x22\xfb\x75\x8d\xe2\x55\x95\x5d\x27\xd5\x5d\x77\xb2\x35\xcf\x12\x1f\xe3\x53\x8f\xf4\xa2\xac\xe2\x2e\x8a\x4e\xaf\x28\x1c\x29\xe2\x09\xac\xa0\x4d\x17\x95\x1f\xd1\x66\x38\x7c\xa2\x22\x74\x6b\x8b\x59\x1a\xfd\x47\xff\x68\xc9\x60\xba\xed\x07\x7c\xb6\x7a\x3c\xb3\xea\x27\x59\x33\x51\x1e\x39\x19\xf9\x71\xd5\x37\xd2\x9c\x59\x81\xf8\x81\x51\xfa\x0c\xe8\x2c\x4a\xec\x4e\x1b\x7e\x4c\x1d\x52\x24\x67\x55\x21\xa6\x43\x1f\xaa\x9c\x45\x81\x97\xa8\xa8\xd0\xc7\x55\x20\x45\x72\x56\x05\x62\x3a\xfd\x33\xac\xed\xbf\x67\x51\x20\x7d\xd6\xf1\x63\x6a\x91\xa7\x3b\xab\x2a\xa9\x07\x67\xf9\x13\x9e\xb3\x28\xb5\x3b\x4b\xf8\x31\xf5\x49\x91\x9c\x55\x95\xe4\xcd\x64\xea\xc8\xe4\x2c\x0a\xec\xce\x69\x7d\x4c\x05\x52\x24\x67\x55\x20\xa6\x43\x1f\x47\x9b\x45\x81\xdc\x1d\x86\x1f\x4b\x89\xfc\xd5\x89\x73\x2a\x92\x7f\xb8\x4d\x72\xf9\x9f\x37\x85\x76\xa7\x87\x3e\xae\x3a\x29\xa2\x33\x2b\xb3\x4f\x66\x51\x47\x65\x66\x55\x64\x77\x0c\xe7\x3e\xd4\x49\x91\xfe\x18\x4a\x1d\x72\x7e\x92\xd7\x8d\x3d\x2b\xb8\x3f\xf8\xf2\x31\x35\x4b\xd3\x9c\x55\xa5\xdd\x15\xba\xf4\x11\x9f\x59\x94\x48\x8e\x97\x7c\x4c\x15\x8e\x14\x67\x55\x20\xbe\x28\x78\x3c\x42\xe3\x47\x79\xc2\xc7\xe3\xbc\x14\xb4\x7c\xbe\x17\xfd\xc7\x7f\x47\x87\x5b\xff\xf8\x47\xfd\xaf\xa7\x3b\xff\xde\x9a\xa4\x53\x0e\x7e\xf8\xe1\xe4\xf0\x07\xfc\x16\x2e\xfd\x1a\x32\x7e\xd6\x27\x59\x2e\xd7\xd7\x8b\xa4\xb8\x43\x39\xae\x11\xdc\xee\xcb\xd4\x5a\x21\x86\xc7\x7f\x92\xd5\x0a\x15\x29\x49\x90\x9d\x9e\xfd\xf4\xd7\x43\x72\x01\x1f\xfe\x48\x7e\x7d\x73\xf4\xe6\xec\xe5\xf1\xd1\xab\x68\x3f\xda\xfa\xd7\xbf\xb7\xd8\x1c\x98\x98\x81\xae\x78\xc8\x3b\x6d\xf2\xe3\xeb\x37\x47\x07\x6f\x3b\x44\x34\xd5\xb8\xab\xee\x62\x18\x24\x59\xd5\x65\x79\x83\x0b\x0a\x9a\x38\xa9\x96\x57\xd9\x0d\x6a\x97\xb5\xa4\x78\x44\x93\x5d\xc5\xa9\x50\x7c\xf2\x8b\xfd\x7d\x95\x54\xa8\xe0\xd2\xa6\xcb\xab\x2c\x4f\xe3\xb2\x4a\x51\xa5\x4d\x46\xa6\xa8\x5e\x56\xd9\x0a\xe7\xd2\xf0\x75\xba\xf8\xd7\xcb\x2c\xc5\x87\x16\xc6\x33\x66\x8c\x30\xa7\x87\xff\xe7\xdd\xe1\xd1\x4b\x95\x3c\x71\x96\xc6\x35\xfa\xb5\x37\x27\xba\xe6\xeb\xf4\xec\xe0\xe4\x2c\xfa\xdb\x9b\xb3\xbf\x74\x5b\x20\x6f\x8e\x5e\x9e\x1c\xfe\x78\x78\x74\x16\xfd\xf9\xa7\xee\xa7\xa3\xe3\xe8\xc7\x37\x47\xb8\x16\x69\xf8\xfb\xe0\xef\xe3\xdf\x2f\x0f\x5e\xfe\xe5\x30\xda\xc3\x4c\x1d\xbc\x3d\x3b\x3c\x31\xe0\x29\x3a\xfe\xdb\xd1\xe1\xab\x96\x96\x1c\x94\x3c\xcf\xa1\xed\xbd\xe1\x97\xb1\xfb\xce\xb3\xcb\xac\xe0\x7b\x49\x9d\x1b\x5f\x96\xd7\xe4\x89\xeb\xb6\x7b\xe9\xe4\xb6\xa0\xbf\x71\x81\x88\x18\x0d\xee\x70\x3a\xcb\xcd\xf5\x34\x3e\x9b\xb2\xca\xcb\x24\x05\x27\xb7\x9d\x92\xe9\xd7\xd9\x75\x57\x36\x25\x90\xe3\x22\xcb\x51\x9d\xfd\x13\xb1\x96\xdb\x5c\xad\xaf\xcf\xe3\xa7\x5f\xee\xc6\xaa\xef\x5f\xee\xaa\xbf\xef\x3d\x95\x02\x50\xb9\xf7\xb1\x37\x4d\xd3\xef\x0a\x54\x7d\x1f\x70\x58\x86\xae\xf9\x7e\x9a\xbe\xd7\xda\x16\x17\x1f\x84\x06\xd6\x99\xf7\xa4\xeb\x61\xa4\xb2\xeb\xe4\x12\xd1\x64\x94\x5d\x57\xaf\x2f\x2e\xb2\x0f\xec\x17\x18\x1d\x76\xea\xc7\x3b\x0f\x3b\x51\x33\xdb\x64\x12\xd1\x4f\xcb\x25\x4e\x2e\xe0\xfb\x44\x9f\x74\x57\x11\xe3\xff\xa0\x0f\xc9\xf5\x2a\xd7\xc6\x5c\xfc\xd4\x4d\x7d\x85\xd2\x7e\xdc\xd4\xc6\x64\x03\x36\x70\x0e\xf5\xfe\x19\x21\x89\xc8\xfb\xe7\x83\xca\x44\xdd\x3f\x33\x24\x8d\x73\xff\x7c\x90\x6c\xc8\xfd\xf3\x31\x26\x14\x42\xe0\x85\x44\x89\x70\x38\x21\xd1\xec\xfe\xf9\xe9\x96\x7f\xf7\xcf\xc8\xba\x0a\xc1\x79\xf0\x52\xee\x9e\xd9\x20\x1b\x65\x41\xc4\x7a\xc2\x43\x08\xd1\x1e\x73\x12\x4e\xbc\xc7\xec\x04\x11\xf1\x31\x27\x41\xc4\x7c\xf6\x9d\xb0\x40\xb8\x09\x22\xee\x33\xbc\x04\x11\xf9\x31\x47\x61\xc4\xfe\xfe\xf1\x8e\x20\xf8\xb8\xff\xf8\xdf\x45\xdb\xfb\x8f\xb9\xfd\xc9\xb2\x30\x42\x2e\xe1\x26\x80\x88\x4b\x18\x09\x20\xe0\x72\xaf\x27\x86\xc1\x4c\x00\xe1\x96\x65\x25\x80\x68\x4b\x18\x0a\x21\xd8\x0e\x2f\x1f\x85\xc0\xc6\xfd\x87\x5a\x2a\xb6\x85\x12\xe6\x28\x3e\x02\x88\x75\x14\x37\x01\x04\x3c\xd1\xa3\xae\x01\x71\x14\x40\xe8\x13\xf0\x13\x40\xfc\xa3\xb8\x0a\x21\x08\xb2\xef\xb9\x05\xc3\xcb\xfd\x87\x43\x12\x70\x02\x08\x3b\x84\x85\x00\x22\x0e\xf7\xce\x73\x18\xcc\x04\x10\x67\x58\x56\x02\x08\x31\x84\xa1\x10\xa2\xcb\xf0\xf2\x63\x08\x6c\xdc\x7f\x4c\x21\x4e\x1c\x80\x2b\x73\x0f\xab\x87\xc1\x4c\x00\xae\xcc\xb2\x12\x80\x2b\x13\x86\x42\x70\xe5\xe1\x55\xd6\x10\xd8\xb8\x7f\x57\xa6\x9f\xdb\x0f\xc2\x8b\xa6\xef\xff\x07\xc6\x4e\x00\xde\x34\x32\x15\x82\x47\x31\x0f\x17\x87\xc2\x4a\x08\x9e\x45\xdb\x4b\x50\xd6\x43\xb3\x12\x86\x05\x71\xcf\x52\x87\xc4\x4e\x38\x96\x14\x48\x40\x64\x99\x09\xc8\xa0\x43\x32\xe5\x60\x8c\xf8\xfe\xcd\xb7\xeb\x96\x10\x7a\x67\x7c\xc8\x3e\x08\x3e\xee\xbf\x6f\xba\xc7\xf7\xef\x9f\x89\xfb\x57\x05\xe6\xe0\xde\xd9\x18\xae\xa2\x64\x7e\xd7\xb1\xc2\x02\x8b\x6a\xc5\x89\x48\x75\x77\x47\x44\x1a\xd5\xd7\x49\x9e\x67\x45\x23\x28\xd9\x16\x71\xd9\x1d\x52\xff\x64\x4e\x2b\xc8\xe5\xb9\xbf\xd3\x0a\x5a\x9e\xc6\xd3\x0a\x72\x50\xe1\x69\x85\x29\xf8\xf0\x8b\xa6\x98\x9c\x5c\x1a\xb0\x39\xab\xd0\x7f\xbc\xff\xb3\x0a\x63\x5f\x3a\x9f\x55\x18\x51\x59\x9f\x55\x50\x58\x96\xef\xb3\x0a\xcc\x71\xb2\xbc\x41\x55\x91\x34\xd9\x4d\xff\x8a\xae\x2e\x0c\x75\x50\xe2\x8f\x34\x3a\xf5\xf9\x1c\xf1\x35\x54\x13\xcd\x4e\xf9\xc3\x97\x9f\x71\xea\xc5\x6f\x0e\x6d\x77\xfe\xf2\xdd\xf7\x83\xef\xf0\xaa\x1e\x22\x84\x5a\x05\xf7\x17\xb9\x40\x7c\x8d\xd1\x4b\x0d\x2e\x8c\x60\x1a\x0a\xe4\xae\xa7\xc5\xa4\x33\x95\xbd\x4e\x1a\xe9\x2d\x42\x0c\x67\x62\xa2\xbd\x4d\x69\x6c\x74\x32\x78\x91\x9f\xed\x2d\x92\x79\x63\x82\x0b\x39\xad\x73\x8a\x31\xe6\x49\x71\xb9\x4e\x2e\x65\x9f\xc9\xb0\xab\x19\x13\x26\x0c\x2d\x9e\x7e\xf9\xe5\xb6\xd1\x75\x6d\x12\x77\xea\xd7\x35\x73\xfa\x53\x4f\x23\x38\x87\x62\x19\xd3\x7b\xd4\x78\x11\xa6\xb1\xb1\x3e\xe0\xc9\x1d\x44\xb2\x70\xfb\x56\x38\xe1\x83\x34\x32\xea\x65\x3a\x62\xaa\xbb\xd7\x3c\xb6\x8c\x4f\xaa\xe8\xfa\x59\xe2\xe3\xdd\xb5\x81\xac\x73\x4b\xbd\x9b\x5c\x03\x88\x3f\xd3\xcf\x9a\x6c\x47\xc7\x27\xd1\x82\x65\x93\xfe\xbc\x6d\x1c\x16\x08\x5b\xc1\x19\x0e\xcd\x96\xde\x62\xc8\xf3\x19\x5a\x53\x29\x8a\x12\xb6\xf4\xec\x6e\xc9\x96\x0c\xb1\x6d\x5f\x8d\x0e\xbc\xbc\x4a\x8a\x4b\x94\x97\x97\x92\xf1\xa1\x83\xc2\xef\xc1\x40\xe7\xfa\x90\x3e\x1c\x84\x09\xa4\xf3\x78\x7e\x24\xbd\x36\x80\xe9\xbb\x6b\xb5\xca\xb3\x25\xa8\xbf\xca\xdb\x02\xc9\xba\x4b\x16\xce\xcb\x64\xdd\x5c\xb5\xf3\x74\xe9\xb7\x1a\x2d\x2b\xd4\xc8\xbf\xb7\xee\x57\xa1\x65\x13\xaf\xab\x0c\x43\x41\xba\x6d\x14\x2a\x90\x7e\x9b\x30\x24\xe9\xb8\x11\x4e\xdf\x73\x15\x4a\x2c\xe7\x85\x92\x2b\x71\xa7\x2b\x2c\x36\x3a\x7f\xa4\x6b\x70\xf9\xeb\x9c\xfb\x84\xd3\xe4\x2b\xb9\x5c\x59\xfa\x39\x4d\xee\xb8\x8f\xec\xcd\xcd\x92\x6f\x22\xac\xcc\x4d\xcc\x93\x4f\x54\x1e\xaf\x17\x07\xdf\x87\xfc\x71\x66\xb8\x15\x4a\x26\x03\xde\x82\x95\x74\x32\xb6\x71\xc2\xca\xbf\xb7\xf2\x32\x43\x1f\x19\x32\x89\xd0\xdd\x0d\xb9\xa4\x89\x80\x24\x46\xd7\xc3\xf3\xf4\x04\x1f\x7b\x62\xdd\x18\x2b\x16\xd5\xed\x9a\x63\xb1\x1b\x75\x97\xf5\x68\x9c\x09\x3b\x9c\xc9\x74\x87\x77\x08\x72\xa7\xcf\x38\xa8\x7d\xac\x6b\xa5\x27\x8e\x3c\xde\xa9\xae\xe3\xf9\x61\xf9\xa2\xe0\xa6\x25\x88\x63\xc2\x5d\x98\x37\x46\x6c\x37\xbf\x27\xef\x23\x02\x3b\xe7\x0f\xfb\x8e\xe2\x35\xd7\x75\x1a\xcb\xa1\xa0\x57\xdf\x9c\x92\x0b\xb4\x3a\xe9\xa9\x66\xe3\x1c\x1d\x32\x4f\x18\x45\x0a\x63\x9a\xc0\xf3\x23\x99\x25\x0c\x60\xa0\x49\x42\x87\xf1\x13\x59\x95\xb3\x02\x05\xd7\x6f\xfa\x35\x38\x0b\x0b\xec\x41\x7e\x51\xa5\x18\x8b\x28\x58\xe3\xcc\x23\xa6\x35\x3c\x41\x66\x3f\x1e\xb2\x88\x62\x45\xca\x50\x00\x19\x27\x79\x5e\xde\xa2\xb4\x7b\x84\x8c\x31\x3a\x1e\x9c\x5d\x25\x4e\xa2\xd5\xf8\x28\x1a\x17\x68\xf4\x64\xd9\x18\x24\xa2\xcb\x46\x66\x1c\x85\x61\x68\x87\x60\xaf\x44\x6d\x90\x69\x60\x31\x04\xe4\x12\x1c\x4f\x2a\x8f\xe8\x41\x81\x0e\xc1\xda\x96\x55\x36\x8a\x4c\xbb\x67\x9b\xa3\x5f\x54\xa8\xeb\x4d\xf1\x26\xff\xbd\xc4\x54\x90\x3a\x39\x93\x05\x84\x01\x98\xaf\x13\x74\x23\xaf\xa1\x8d\x2a\x0a\x25\x04\xe9\x54\x52\x36\x21\x7e\x26\x68\xcd\xb9\x1e\x98\x85\x60\x75\x63\xa8\x0d\x58\xe8\xb9\xcc\xd2\x21\x29\xd5\xf9\x86\x2c\xcd\x82\x6e\x45\x5b\xd4\x73\xe5\x2a\xf1\x0d\x68\xc1\x74\x85\x4e\xf7\x30\x65\x37\xc9\xa5\x7e\xc6\xd3\x02\xc9\x36\x15\xd7\xd2\x22\x14\x8b\xf5\x34\x28\x96\xe2\xd7\x73\x92\x5b\x3d\xdb\xea\xfc\xb7\x54\xa6\xac\x8e\xd7\xab\x9b\xb2\x99\xae\x6d\xdb\xf5\xa0\x59\xdc\xf7\x3b\x78\x06\x16\xcf\x03\x8b\x4d\xb0\x88\x04\x8c\x43\xf8\x4d\x9b\x19\x93\xbf\x9f\x6a\x86\x68\x9a\x0b\xa3\x1d\xb4\xd3\x1b\x2a\x28\x6b\xf5\x3e\x2f\xfc\x48\x59\x3f\x78\x52\x8b\x74\xc2\x54\x0f\xad\x1a\xa7\xbf\x32\x6b\x2c\x72\x1f\x9c\x6b\x36\xa8\xc7\xd3\x72\xfc\x69\x26\xd3\xf4\xc1\x98\x7a\x0d\x4b\x3b\xdf\xc6\xce\xbf\x49\x44\x07\x1c\x66\x3e\x6e\x22\x7a\xb4\x9d\x4f\xd3\x7b\xd4\x22\x3f\x88\x64\xf4\x84\x28\xfd\xa4\x6e\x1b\x65\x63\x74\xbd\x6a\xee\x46\xea\xd8\x57\xbe\xfb\x3e\x7a\xd6\x29\x15\xff\xbd\xdf\xfe\x49\x6a\x56\x06\xcf\xe9\xeb\x5a\xf6\x23\xba\xcc\xa5\x03\xe3\xbd\x86\x95\x65\xe2\x35\xd2\xcf\x74\x87\x70\x7d\xe5\xa5\x93\xf5\xba\x25\x10\x5c\x97\x40\xd3\x63\x94\xb5\x04\x32\x1f\x9c\x70\x24\x9b\x12\x8e\x80\xc0\x59\xe1\x27\x96\xed\xe7\x44\x0a\xb0\xff\x00\xf3\x7a\x0e\x1a\xdc\x93\xd3\xac\xbf\x62\xe8\x77\xca\xfb\x13\x7a\xf0\xcc\xbf\x8a\x0f\x0e\x99\x3a\xfb\x2f\x80\x55\xe7\xff\xf9\x06\x8a\x1d\x00\x0e\x74\xba\x07\xa0\x27\xce\xef\x02\x88\xa8\x0b\xf7\x01\x60\xa8\xf9\x9d\x00\x19\x7a\xf3\x60\x17\xda\x6e\x80\x90\x2b\xb5\xbf\x18\xec\x08\x88\x2c\x6e\xb3\x27\xe0\xb0\x27\xa0\x37\x5f\x50\x88\x78\xf8\xfb\x02\x5a\x45\x04\xea\x60\xc6\x7b\x03\xda\xf6\xb0\xdd\x01\x11\x9a\x80\x35\x64\xac\x13\x68\x30\xea\xca\xc6\xad\x82\x90\xd8\x95\x48\x2e\x7b\x38\x7d\xcb\x9f\xf4\x91\x16\xce\xdb\x6c\x37\xd8\xa4\x05\xa6\x43\x2f\xd1\x81\x63\xc5\x9e\xaa\x33\x3b\x02\x21\xd9\x17\xc3\x92\xd2\xae\x08\xa4\x99\x3d\x91\x5c\xc9\x82\x31\x8b\x4a\x71\x42\xf6\xb3\x55\x59\x67\x6d\x1c\xfc\x4c\x66\x38\xee\xd9\xa4\xff\x2a\xb3\x22\x5e\x5d\x55\x49\x8d\x34\x07\x7a\x81\xc3\x4e\xb8\x1b\x6c\xe4\xd1\x89\x80\xac\x4d\x6f\x66\x50\xfb\xca\x56\x19\x64\x91\xd1\x82\x0d\x06\xb1\xd8\xdb\x73\x3b\x30\x6d\x13\x94\xa6\x61\x26\x5b\x65\xde\x72\xd0\x2d\xae\xf6\xff\x58\x0c\xad\xd4\xff\x1d\x6d\xfd\xdf\x7f\xa4\xff\xda\xdb\xfb\xf7\xe7\x92\xc3\x81\x72\xdd\xd6\x05\x4c\xb9\x2d\x1c\xa5\xdd\xe7\x41\x68\xb7\x2e\x3c\xaa\xb7\x45\x86\xff\xc3\x29\xb8\x95\xbc\xd7\xf0\x97\xff\xfe\xf9\x1f\xe9\xdf\x7f\x31\x56\xf3\x35\x6a\xb4\x07\x22\xaa\xa4\x69\xb9\x64\xb3\xb5\xe4\x47\x76\xfc\x94\x49\xd0\x12\x89\xfb\x06\xec\x82\xb2\xc3\x8d\x35\x40\x16\x74\xdd\x2f\xdf\xed\x47\x7b\xbb\xbb\xf0\x6d\x80\x0e\x3d\xbd\x89\xac\xb0\x1a\xe5\x36\x32\x27\xaf\x76\xc8\x1e\x49\xcf\x2d\x24\xbd\xb3\xaf\x90\x2e\xa0\xbd\xfd\x9e\x6d\x2f\x1d\xf3\x11\xf6\xf7\x09\xbf\x9f\xec\x0e\xff\x20\x5e\x50\x23\x32\x74\x55\x01\x5b\x4b\xac\x9b\x92\x58\x51\x8c\x72\xb4\x84\x1c\xd2\x5b\x26\x45\x9a\xb5\x76\x2f\xf9\xbe\xaa\xca\x55\x59\x4b\xcf\xf1\xd5\x68\x59\x16\x29\xaa\xe2\x3d\x6e\xa3\xae\xff\xfd\x29\xf7\x7b\x93\x34\xeb\x7a\xd2\xef\x7b\x1c\xda\x3b\x54\xc7\xad\x49\x4f\x21\x79\x0b\x29\x4a\x20\x60\x27\x48\x8c\xdd\xd9\xea\x72\x97\x72\x85\x0a\x75\xf3\x4e\xa5\xb9\x96\xce\x34\x9c\x4e\x7b\x2e\x26\xba\xe2\x02\x6a\xa7\xc0\xfd\xe8\xe0\xe8\xa7\x68\x71\x70\x72\x72\xf0\xd3\xcf\x7b\x3b\xd1\xd3\x9d\xe8\xd9\x4e\xf4\xc5\x4e\xf4\xe5\x4e\xf4\xfc\x17\x60\xea\x4f\x40\x33\x0c\xd7\x90\x32\x26\x71\x91\x29\xbc\x8d\xab\x60\x43\xd2\xe6\xa9\x04\x2e\x26\xc9\x52\x95\x8d\xd4\x6d\x30\x25\xf9\x27\x07\x23\xd5\xd8\x14\xc6\x8e\xff\xc3\x9a\x15\x66\x88\x31\xaa\xad\xc7\x7b\x5b\x2f\x5e\xf4\xce\x1b\xed\xee\x44\x7b\x0e\x76\x85\x49\x06\x6b\x5c\x14\x77\x60\x0b\xc3\x8d\xb4\x66\xb6\x4c\x9b\x72\xa9\xbd\x53\x2d\xab\x97\x59\x4a\xcd\xe4\x9f\x7e\xcd\x77\xeb\x45\x85\x50\x7a\x1e\x33\x50\x13\xa0\xee\x32\x03\xd5\x3c\x07\x25\x69\xb9\x6e\xe2\xf2\xe2\xa2\x46\xd2\x29\x14\x46\xc3\x82\xfc\xfc\xcb\x64\x64\xbe\xac\x12\xf0\x49\x56\xdf\x4b\x6b\xac\xd6\x30\xec\x89\x66\x45\x6c\x3c\x18\x02\x66\x29\xd4\x54\x51\xbe\x24\x51\x5e\xd2\x03\xb0\xa5\x8f\x63\x26\xe0\x5e\x6c\xd7\x0e\x01\xf5\xe4\xc8\x8e\xa2\x37\x5b\x28\x7d\x8f\xb6\x1a\xae\xee\x62\xea\xe0\xbd\xb0\xba\x57\x37\x33\xef\x2e\x52\x8c\x51\xd1\x64\xcd\x9d\x3a\xc3\x95\x26\x4d\x12\xfd\x57\x5d\x16\xe7\x13\x7f\x25\x48\x92\xc6\x74\x5c\xd1\xb1\x07\xc9\x5b\xab\x97\x36\xed\x92\x43\xb2\xe2\xec\xa6\x3b\xb2\x64\x64\x17\x96\x55\x77\x4c\x42\xe7\x6e\xa2\xec\x06\x78\x26\x87\x3e\xac\xb2\x4a\x37\x6c\xf3\x4b\x4e\xf6\xee\x2b\xf2\xeb\xaf\xeb\x24\x6f\x3b\x79\x22\xd0\x9e\x51\x7f\xd0\x36\xa7\x48\xf7\x5a\x99\x63\x87\x9f\xaa\x28\x56\x52\x50\x2c\x78\x65\x9d\x0b\xe2\x00\x5b\xba\x96\xbe\xc0\x1f\x40\xd8\xc9\x6d\x94\x5a\xf4\xe2\x4b\x2b\x01\xa1\x2f\x9c\x7d\x08\xed\xf6\x03\x68\xd7\x81\x60\x19\x5e\xa3\xd2\x6b\x8e\x82\xb5\x32\x40\xfc\x40\x8d\x9e\x0c\x01\x9b\xc1\xfc\x0a\xc0\x5a\x41\x19\xf5\x14\x4c\x0f\x35\x2a\xfc\x12\xb6\xac\x1b\x93\x20\x06\xb5\xc3\x22\x98\xc9\x39\xcf\x8e\xdc\x22\x31\x14\xcc\x2c\x31\xbe\x0a\x2d\xb3\x55\x36\x9a\xe6\xf8\xb7\xbc\x7b\x48\x27\x5b\x99\x27\x7e\x74\x45\x6f\x9e\x04\xcc\x8a\xc2\xf0\x00\x85\x9e\xca\x08\x6a\x49\x89\xbe\x53\x52\x49\x47\x3c\x33\x35\xa1\x42\x6e\xf9\x07\xd3\xea\xc0\xad\x28\x92\xab\xd1\xf5\xa4\x3a\x38\x2b\x1a\xeb\x0a\x10\xa4\x5a\x20\x2b\xec\xb7\x65\xf5\x5e\x8f\x1e\x43\xd9\xe0\x2f\x2b\xcb\x4c\xf0\xf3\x2f\x26\x89\xe0\x2a\xbb\x11\xe4\xe8\x3a\x5f\xbb\x4e\xb2\x5c\x82\xa6\x07\x6d\x51\x09\xea\xa5\x3a\x09\xd1\x79\x9d\x35\xd2\x9c\x34\x08\xc7\x79\x56\x52\x29\xe6\x6b\x74\x7d\x8e\xaa\xb8\xce\x8a\xa5\xd9\x94\x11\xcb\x12\x2f\xcb\xe2\x22\xab\xae\x71\xe1\xb2\x66\xea\x88\xb7\x1e\xf2\xb2\x2f\x9d\x36\x22\x66\x7f\x65\x56\x56\x35\x57\x84\x5e\xfb\x9f\x2e\xad\x2e\x38\xc7\x34\x3d\xcf\xb3\x4a\xea\xfa\xb6\xac\x52\x81\xb2\xf7\xa6\x6b\xcd\xab\x64\x8f\x5a\x8b\x3e\x7b\xca\x7f\xef\x2f\x89\x57\x67\x11\x60\xc6\x1a\x2f\xcb\x1c\x98\xf5\x96\xac\x9e\x94\x03\x36\xa8\xb8\x01\xdf\xd4\xbf\x04\x25\x45\xf8\xbd\x0d\xdd\xc5\xe6\xc2\x82\x38\x63\xcd\xd0\x2b\x03\x4a\x5f\x06\xeb\x83\xee\x53\x0a\xbf\x24\x7d\x52\x5b\xa2\xdd\x89\x36\xb8\xef\x9d\xbe\x65\x5c\x28\x6c\xdc\xd3\xe5\x52\x9e\x14\x3f\xb0\x9b\xbf\x44\xe8\xa9\x55\x10\x40\x9b\xaa\x2a\xe8\x07\xa2\x4f\xbc\x19\x35\x9f\x46\xdb\x7f\x26\xe7\x65\x95\x8c\x83\x8f\x56\xaf\x12\xaf\x35\x27\x2e\x4c\xb4\x68\xc9\x4b\x62\xc9\xc3\xe8\x50\x56\xe4\x19\x3b\x96\x5e\x50\xeb\x7b\x54\xf1\x16\xc4\xc3\xd0\x2b\x79\x9b\xc0\x4e\x9d\xd2\x95\x11\x4b\x22\x98\x05\x9b\x88\x2d\xf9\xc2\x8d\x81\x06\x2d\xe0\x38\xfc\x7c\x92\x41\x6b\x4d\x8a\x54\xc3\x43\x32\x29\xfa\x31\xfd\xd9\xdc\x94\x4e\xab\x68\x15\xab\x4a\xae\x3c\x0c\x9d\x76\x2f\x85\xcf\xa6\x4e\x3a\x0d\xa0\x55\xa7\x38\x19\xf0\x90\xd4\xd9\xbd\xd6\x3c\x9b\x3a\xf9\x9c\x87\x56\xa5\xf2\xcc\xc7\x43\x52\xeb\xf8\xd6\xe8\x9c\xaa\xa5\x93\x3c\x00\xc5\xaa\x36\x21\x1f\x8a\x5a\x49\x0a\x69\x76\xa5\x32\x39\x2d\xa8\x6a\x25\x99\xad\x87\xa8\x60\xf2\x70\xe7\x7c\x6a\x66\x12\x79\x5a\xfd\x4a\xd2\x79\x0f\x49\xb1\xdd\xa3\x8e\xf3\x69\xd4\x6f\x15\x68\xb7\xb8\xc0\x48\xa7\x0d\xbe\xdc\x0d\xb7\x6a\x54\xac\x99\x50\xa7\xde\xda\x5a\x52\x71\x13\x8b\x49\x38\x95\x78\xd6\xfa\x9b\x30\xfd\xfc\x90\xbc\x0d\xbf\xd4\xe9\x7f\x29\x17\x92\x11\xe9\x8d\x06\x6a\x24\x43\x59\xc3\x42\x9f\x51\xd5\x3c\xff\x75\x91\xaf\x51\xb1\xbc\x63\xa8\xf4\xbf\x19\x26\x79\xc8\xd3\x20\x4d\xf9\x1e\xb9\xbd\x32\x43\xbf\x7e\x22\xad\x0f\xbd\x2a\xab\xec\x9f\x49\x97\xce\x4a\xe9\x83\xca\x15\xba\xa8\x50\xdd\x33\x32\xfe\x9e\x2c\x97\xa8\xae\x27\x3f\x5b\x54\x95\xd4\xcb\x92\xca\x12\xcb\x5c\xe3\xb2\x4a\x0a\xf8\x96\x81\x45\xc0\xa4\xf4\x1d\x94\x9d\x4f\xf9\x52\x06\x4a\x0a\x1c\x6a\xff\xab\x0a\x5d\xa0\x0a\x15\x4b\xb7\xea\x00\xc9\xe0\x3a\x1d\x2b\xc9\x59\x78\xc1\x5e\xcc\xee\xae\x4d\xc7\x8d\xec\x07\xd5\x6f\x13\xb6\x94\xdd\x36\x42\x43\x7b\xad\x5e\x9f\xb7\x53\x86\x73\x14\xc3\xae\x4a\x54\x07\x09\x55\x9e\x1f\x6f\xd9\x75\xdb\xcf\x96\xb5\x2f\x02\x7e\x87\xc7\x96\x01\x51\x57\x56\x39\xd8\x65\x82\xcf\xef\x6c\xeb\x99\x44\x7c\x85\x64\x44\x12\xe6\x94\xa6\xc4\xb7\x31\x37\x28\xf8\xa6\xa4\xb2\xd7\x00\xc9\x38\xa5\x61\x75\xa6\x79\x93\x64\x79\x72\x2e\xb8\x5e\x8e\x39\x2b\x46\x61\xac\x11\x2a\x24\x37\xee\xe1\xa9\xbe\x8d\x6d\x04\x9a\xc4\x56\x30\x08\xb4\x11\xf3\xc4\xf6\xd8\x16\x56\x70\xa1\xb4\x91\x01\x59\x1a\x6b\xe6\x5d\x06\x31\x08\xa6\xb1\x00\xe7\xb4\x12\xe6\x80\x5d\x69\x36\xeb\x1d\xdb\xd1\x09\x74\xbb\x5e\x54\x65\xd5\x7d\x8f\x1e\x24\xff\x1d\xdc\xe0\x41\xd8\x0a\xd3\x98\x68\xde\x80\xb6\x84\x9b\x98\x9b\x12\x93\xd6\xb2\x8c\x08\xaa\x5c\x97\x6f\x63\xea\xb2\x52\xc1\x59\x53\xc7\x57\x98\xe6\xc4\x30\x07\xb4\x27\xd2\x06\x6a\x50\xb7\x49\xb3\xbc\x62\xe7\xb5\x36\xc7\xd0\x81\xe6\x40\xa8\x8d\x33\xf0\x1a\x62\x07\x45\xd9\x64\x17\x77\xf1\x4d\x96\xc4\xa4\x16\x10\x30\x3b\xc1\x6d\xfa\x37\x32\xdb\x95\xeb\x45\xd5\xce\x52\x5a\x02\xd5\x4d\x92\x8f\x19\x9f\xaf\xa2\x34\xb9\xab\xbb\xb3\x8c\xf8\x93\xc0\x0d\x70\x56\xc7\x75\x45\xac\x51\x0a\x9b\x0e\xa7\x52\xac\x4a\xdd\x08\x5a\x39\x75\x4d\x8f\xaf\xab\x7e\x37\xe0\x80\x3b\xbb\x0d\xa6\x4e\x55\x7e\xcc\x72\xf7\xfc\xc3\xba\xea\xf9\xb3\xd6\xa6\x3e\x23\x96\xd5\xda\x58\xb9\x6e\xf8\x92\xd0\xe9\xbd\xd7\xcb\xa4\x58\xa2\x3c\x07\x1e\xb7\xac\x51\x93\xb7\xfe\x4d\x3d\x87\xfc\x09\x5f\x47\x4f\xe7\x6e\x71\xd5\x0d\x72\xbd\x7c\xa6\x43\xf3\xfb\xbd\x5e\x9e\x28\x00\x74\xbb\xbc\xaa\x54\x6b\x73\xb9\x7c\x10\x11\xe7\x63\x5e\x2e\x4f\x59\xce\xa7\xe9\x3a\x4a\x89\x9d\x23\x4f\xd0\x57\xcb\x3f\x65\xaf\x96\x7f\xba\xb9\x5a\xde\xf8\x6a\x79\xda\x58\xc2\x58\x8f\x4c\x18\x92\xac\x41\x46\x38\xfd\xba\x83\xc2\xf9\x69\x5c\x2b\xcf\x4b\x14\x5e\xdf\xe9\xb7\xfe\x39\x60\x68\x2f\xf2\x57\xca\x2b\x4b\xb3\xed\x6f\x94\xef\xa8\x41\x2f\x94\x57\x71\xc1\xa1\x52\x5d\x24\x2d\x02\x55\xdd\x26\x3f\x81\x97\x5e\x26\xcf\x43\xf2\x01\x1d\x40\x99\x8b\x55\x22\xd2\x82\x9b\xe4\x81\x88\x87\x81\x55\x8d\xdc\x38\xb2\x85\x75\x8d\xbc\x98\x29\xa5\x83\x80\x2f\x91\x17\x9a\xd9\xe6\x0e\x79\xeb\x3b\xe4\x01\x86\x0b\x09\x0a\x0f\xfd\x06\x79\xbd\x1a\xc2\x74\x2d\xc3\xfb\xe3\xf5\xcd\x21\xd7\xc7\x0b\xb1\x84\xab\x1e\x53\x85\x00\x63\x50\xa8\xf7\x5f\x13\xee\x02\xea\x0e\xad\xfe\x81\x0a\x9f\xe1\xe2\xe0\xae\xfb\xab\x26\x5e\x55\xa8\xc6\xe5\x43\x02\xeb\xa0\x3f\x0f\x43\x40\x72\x5e\xa3\xa2\xd9\x7a\xf1\x42\xd3\x40\xb3\x66\x9f\xf5\xa2\x62\x42\x62\x72\x4f\xb1\x72\x1a\xe7\xe5\x9a\x62\x9e\xf0\x6c\x12\xe6\xdd\x91\x3f\xd8\xa6\x5d\x9e\x15\xb2\x9a\x58\x52\x2c\xbd\xab\xfc\xba\xa7\xd0\xd9\x47\x48\x9d\xb5\xdc\x03\x07\xc6\x4e\x9a\xfe\xd5\x01\x48\x2d\x6e\x27\xa2\x49\x13\xaa\xc3\xd9\x7e\x70\xcf\xc8\x70\xf8\x46\xd1\x39\x64\x94\x4e\x60\x45\xf7\xd8\x04\x00\x66\xa2\x9a\xc6\x3c\xac\x3c\x24\xb5\x1a\x12\xbf\x3c\xe2\x34\x20\x39\x24\x2d\xb1\x9a\x7f\xc7\xe9\x7e\x2c\x3f\xbb\xfa\x63\x66\xdc\x8a\x68\x05\x9a\x6d\x53\x08\x8d\xde\xf7\x18\xcd\x5f\xe3\x27\xae\xd3\x77\xd9\x75\x20\xe3\xb9\x95\x5d\x50\x31\x2e\xd5\x60\x0f\xd4\x00\xbe\x20\x14\xaf\x30\xb0\x86\xba\xb7\x54\x40\x68\x2a\x74\x83\xaa\x1a\xc5\xc6\x0d\xf3\xb2\xb8\x34\x6f\xb5\xaa\xb2\xb2\xca\x9a\xbb\xf9\xc6\xa2\xac\x8e\x53\xb4\xaa\xd0\x32\xd1\x5f\x23\x43\x5a\x5c\x25\x35\xf6\x92\x1a\x52\x65\x30\x8c\x5f\x49\x95\x66\x05\xb9\x9b\x11\x36\xe6\xed\x01\xdb\xe8\x9c\x00\x36\xa1\x70\xd9\x3f\xf7\x98\x6b\x50\x04\x01\xec\x89\xed\x20\x3c\x26\xf9\x34\x80\xaa\xc4\xdd\xc7\x99\xe8\x30\x9b\x24\x56\xf3\x09\xb6\x77\xff\xf3\xcd\xe1\xdf\x04\xf3\xe2\xae\x8b\x0f\x4e\x1f\x45\xa7\x87\x6f\x0f\x5f\x9e\x45\x79\x55\x3f\xe9\x27\x82\x07\xa7\x64\x16\xdb\xc9\x32\x7c\xd9\x6b\xbf\x90\xa6\xe3\xa7\x2c\x6d\x7f\xad\x50\x8e\xd3\xb0\xf5\x55\xb6\x1a\xbf\x8d\x53\x14\xf6\xb7\x5e\xd6\x83\xb7\x87\xa7\x2f\x0f\x17\x79\xd2\xdc\x3c\x19\x43\xf6\xce\x30\xe9\xda\x6e\x51\x8f\x1f\xda\x56\xaf\x4f\x8e\x7f\xc4\xe3\xa4\x62\x2a\x9c\x57\x35\x26\x10\xfd\xaf\xe3\x37\x47\x22\xd3\xae\xa3\xe3\xa3\x68\xb1\xc0\xcc\xef\xd3\x02\xb6\x43\x97\xb8\x25\x1e\x91\x72\xd2\x2e\x1f\xdb\xb5\x3f\xab\x1b\x11\xa3\xca\x1b\xd2\x74\x91\x37\x5d\xe3\xf1\x63\xbf\xbd\xd3\x3c\xb9\xc4\x9f\xb6\xbe\xda\xfd\x2a\xfd\xe6\x8b\xaf\xd2\xc7\xdf\x7c\xf9\xfc\xd9\xe3\x67\x4f\xbf\x4e\x1e\x7f\x93\x7c\x95\x3e\xde\x5d\x7e\x79\xfe\xcd\xf2\x59\xf2\xcd\x57\xdf\xec\x6d\xbd\x78\xd1\x7a\xdf\xf6\x40\xfc\xed\xe1\xeb\x33\x09\x07\xc2\x81\xb6\x55\x7a\xcf\x53\xab\x7f\x6e\x5c\xdd\x8f\xea\x27\x53\x07\xea\x59\x6d\x1b\x60\x8d\xb4\x82\x0c\x5c\x1c\x9f\xbc\x3a\x3c\x69\x97\xb9\x94\x46\x77\x38\x43\x80\xac\xda\xc6\x87\x57\x54\xcb\xb5\x60\xde\x5d\x19\x98\xf6\xb1\xce\x9c\xfd\xd5\x95\x8e\xdb\x4f\xf4\xd1\x95\x51\xba\x90\xd2\x40\xc0\x5c\x1c\x28\x03\xd7\xdd\x99\xf7\x09\xf6\x1d\x91\x2c\x8c\x7e\x63\x78\x11\xf7\x19\x01\x81\xf4\x57\xa5\x75\xb5\x40\x26\x53\x1f\x7f\x92\x83\xb5\xe3\x38\xc7\x91\xe8\x1c\x56\x4d\x46\xba\xe7\x01\x56\x93\x59\xd6\x58\x4d\x74\xff\x80\xea\x88\x60\x31\x64\x90\x29\x94\x40\xc2\x31\x24\x8d\x26\x3d\x1c\x64\x1f\x8a\x80\x07\x24\xa1\x56\x36\x6d\xa0\x9c\x5c\xb3\x36\x4b\xb4\x9c\x16\x61\x7e\x24\x7f\xf5\x18\xa6\x4d\xef\x71\xa5\xdc\x94\xba\xd2\xcd\x77\xd0\xa5\x50\x83\x22\xaf\xf6\x92\xbc\xa0\xc3\xef\xa6\x98\x77\x8e\x62\x5e\xde\x86\x3e\xcd\xec\xb8\x5e\xec\x07\x31\x1c\x0b\x32\x56\x9b\xb2\xde\x8f\x54\xd6\x3b\xb1\x98\x20\xa6\x02\x12\xae\xc4\xb3\x02\x1e\xd8\x60\x82\xf0\x49\x95\xfa\x0a\xc5\x0a\xb4\x3f\xb5\x89\x0c\x61\x0b\xa3\x9e\xe5\xcb\x7f\xf5\x77\xe9\xda\xd7\x00\xd3\x74\xa1\x85\xc0\x5a\x7e\x44\x48\x55\x3b\x0b\x52\x78\x55\x5d\xb0\xb8\x91\xb4\x38\x58\x08\xce\x8f\x0d\x50\x46\xd8\x00\x27\xe7\x44\x50\x2b\x6c\x42\x82\x2d\x18\x56\x93\xb1\x0b\x9c\x61\x95\x0e\x2b\x38\xd3\xfb\x1a\xb8\x88\x58\x6e\x9d\x9b\x4a\x62\xeb\x4a\x62\xa8\x59\x83\x43\xcb\x43\xaf\x29\x06\x2a\x24\x60\xc7\x33\xac\x2e\x06\xe2\x80\xa4\x76\xe4\xa8\x02\xd7\x96\x95\x7e\x4c\x02\x56\xa8\x65\xc7\x14\x8b\xa1\x75\x11\xac\x4f\x4c\x3a\x61\xdc\x9c\xd5\xce\x84\x82\xd9\xa1\x65\xd9\xa7\x9f\x3c\xd6\x89\x70\xbf\x7b\xb5\x34\xdf\x9f\xe8\x86\x2d\x27\x62\x70\x0e\x64\x12\xd9\x60\xf1\xac\x2e\xe3\x67\x7b\xcf\x9f\xc7\x7b\xaa\x17\x9a\x7b\x37\x49\x11\xfd\xb0\x36\xfc\x1a\x98\x81\xca\x53\x53\x2a\xd4\x7d\x9e\x16\xe4\x9e\x19\x0b\xf5\x85\x09\x95\x4a\xfb\xb2\xbd\xee\xfd\x05\x8c\x63\xa4\xbe\x37\x79\x1f\xae\x2e\xd7\xd5\x12\xf1\xe9\x51\xa3\xa4\xb2\x4d\x19\x31\xbd\x58\xab\xab\xa5\x7b\x06\x10\x63\xc1\xff\x61\x1b\x63\x05\xfc\x77\xb4\xf5\x7f\x7f\x3e\x78\xfc\xff\xfd\xf2\xaf\xa7\xff\x6e\xff\xff\xee\xe3\x6f\x7e\xf9\xd7\xb3\x7f\xff\x8c\xff\xff\x57\xff\xfe\xbc\xaf\xbf\x02\x8d\x7e\x98\x50\x10\x6e\x3b\x72\x22\xf1\xd5\xba\xd2\x3f\x8b\x9f\xd5\xb7\x5a\x2b\x53\xdc\x30\x8e\x9b\x53\x06\xf6\xe5\xf6\x3d\xd9\x95\x22\xcb\x5e\xdf\xb6\xa6\x71\x3b\x35\x8d\x5b\x62\x1a\x67\x8f\xff\xe7\x3f\xd2\x7f\x3d\xfb\xf7\x13\xe6\xff\xfd\xfc\xf8\xc9\x2f\xff\xf3\x1f\xa9\xa1\x71\xdc\x06\x63\x1c\xb7\x3a\xe3\xb8\xd5\x1b\x47\x4e\x1e\xf8\xa3\x9e\x34\xdc\x9c\xb8\xb9\x87\x13\x37\x43\x27\xf8\x38\x6e\x33\x22\xb3\x3d\x6b\x23\x34\x38\x1a\x71\x10\x1e\x30\x65\x48\xec\x08\x14\x1c\xdc\x1f\x20\x77\x58\x6f\x3c\x62\x76\x8f\xc0\xb7\x4c\xfb\xf3\x09\x8c\x6e\x1e\xaf\x08\xe7\xb2\x6e\x11\x4b\x1a\xcf\x00\x5d\xcc\xdd\x41\x83\x6e\x23\xdc\xb8\xc6\xcc\xae\xe1\xe9\xa2\x3e\x06\xdb\x1c\x8e\x11\xd0\x11\x76\x01\x47\x4a\xb7\x80\x1d\x68\xef\x80\xe1\xd5\x5e\x1b\xd7\x98\xd9\x35\x1c\x6a\xc2\x00\x28\xe7\x70\x92\xd0\x12\xae\x32\xb6\x94\xee\x62\x90\x7e\xed\x5a\x80\x6e\x7a\xdf\xb8\xcb\xcc\xee\x42\xae\x63\xf7\xe5\x29\x04\xdb\x1c\x4e\x12\xd0\x95\xf6\x02\x8e\x94\xae\x01\xbb\xbe\xbe\x03\xa6\x5f\xbc\xdd\x78\xc5\x7d\x79\x05\x79\x88\xd7\x97\x57\x10\x6c\x73\x78\x05\xc1\x1c\x92\x57\xd0\x1c\x29\xbd\x02\x03\x42\xbd\x82\x7f\xb8\x78\xe3\x19\xf7\xe5\x19\xe3\x5b\xca\xbe\xbc\x63\xc4\x38\x87\x87\x8c\xd8\x43\xf2\x12\x9e\x2b\xa5\xa7\x0c\xc0\x70\x6f\xa1\xdf\xa2\xde\xf8\xca\xfd\xf9\x0a\x79\xf8\xc1\x9f\xa7\x10\x7c\x1e\x2f\x51\x62\x11\xd3\xef\x6c\x6f\xcc\xe6\xbe\xcd\x86\xbc\x58\xe2\xdb\x78\x08\xd6\x79\x42\x2d\x4d\x21\xac\x70\x3b\xe5\x4c\x13\x72\xa9\x06\x90\x32\x37\xae\x5d\x88\xc2\xc3\xc5\x86\x8e\x33\x9b\xbb\xfa\x82\x88\x17\xfe\xae\xea\xa3\xd1\xcd\x11\x21\x42\x7a\x49\x4c\xc4\x92\xd2\x39\x80\xaf\x86\x75\xd0\xeb\x6a\x93\xdb\xba\x6f\xc7\x58\x57\xfe\x32\x5b\x2d\xae\x39\x5c\xa2\xc5\x1b\x92\x3f\x8c\xfc\x28\x9d\x61\x5d\x81\x33\x5a\xb8\xba\x68\xe3\x0a\xf7\xeb\x0a\x6d\x27\x78\xf3\x05\x8c\x6c\x0e\x67\xc0\x88\x43\xf2\x06\x8a\x21\xa5\x3b\xb4\x70\x20\x7f\xc0\x9b\xf2\x9b\xfa\x92\xfb\xf7\x09\xaa\x23\xfc\xf8\x05\x8d\xd0\xbb\x6f\xd0\xc8\x83\xf1\x8f\x29\x53\x72\x1f\xa1\x60\xe1\x7e\xb2\xa9\x35\x09\xc3\x4d\x3c\x56\x9b\x50\xf8\xe6\x71\x92\xc0\x2a\x4e\x26\x3c\x69\x5c\x04\x5e\x75\x42\xdc\x6e\x53\x77\x12\x8e\x9b\xf8\xae\x3c\xe1\x91\xce\xe3\x30\x21\x56\x9f\x88\x19\xd3\xb8\x8e\x61\x05\x0a\x19\xa8\x37\x35\x28\x41\xb8\x8e\xc7\x2a\x14\x0a\xdf\x3c\x0e\x13\x58\x25\xca\x84\x27\x8d\x9b\xc0\xab\x51\x30\xf8\xa6\x1e\x25\x0c\x0f\xf1\x58\x91\x42\xe1\x9b\xc7\x43\x02\xab\x4a\x99\xf0\xa4\xf1\x10\x78\x65\x0a\x06\xdf\xd4\xa6\x84\xe3\x25\x9e\xab\x53\x38\x9c\xf3\x78\x4b\x80\x15\x2a\x42\xbe\x34\x5e\x63\x56\xa5\xd2\x35\xd9\xd4\xa9\x84\xe2\x37\x1e\x2b\x55\x18\x8c\x7e\x6b\x55\x18\xd4\x9b\x6a\x95\xb0\x8c\xc7\x63\xbd\x8a\x00\xef\x5c\xc1\x37\xc8\x9a\x15\x29\x6f\xda\x20\x6c\x51\xb7\xc2\xb4\x0c\x53\x05\x26\xc2\xc3\xc7\x9f\x4d\xfd\x4a\x20\x11\xc4\x67\x05\x0b\x8d\x70\x9e\x98\x11\x5a\x15\xcb\x94\x29\x8d\xa3\x18\x54\xb2\x60\xf8\x4d\x2d\x4b\x08\x4e\xe2\xad\x9a\x65\xc0\x36\x8f\x7b\x04\x55\xd1\xc2\x71\xa4\x71\x0c\x68\x55\x0b\x06\xde\xd4\xb5\x04\xe1\x16\xfe\x2a\x5b\x46\x74\xf3\x38\x46\x58\xd5\x2d\x3c\x4b\x1a\xd7\x00\x56\xb8\x74\x5b\xc5\x9b\x8d\xfb\xfb\xf5\x0d\xaa\x1b\xfc\x3d\xe1\x3d\xcb\xb6\x3d\x8d\x3a\x10\xdf\x98\xb2\x24\xf3\x0d\x0a\x12\xec\x1b\x9b\x2d\xfb\x70\x1c\xc4\xef\x8e\xfd\x04\xe7\x2c\xae\x12\xde\x7e\xbd\x84\x2f\xb5\xd3\x18\xed\xd6\x77\x91\x67\xb3\x59\x1f\x82\xd3\x78\xdb\xab\xa7\xd1\xcd\xe2\x2a\x41\xed\xd4\x4f\x59\x52\x3b\x08\x74\x9f\x9e\x40\x6f\xb6\xe9\x83\xf0\x0d\x6f\xbb\xf4\x34\xba\x59\x7c\x23\xa8\x3d\xfa\x29\x4b\x6a\xdf\x80\xee\xd0\x13\xe8\xcd\x06\x7d\x30\xfe\xe1\x75\x7f\x9e\x47\x39\x8b\x9f\x04\xb7\x3b\x2f\x66\x4b\xed\x2f\x26\x7b\xf3\x7d\x8b\xcd\xd6\x7c\x20\x1e\xe3\x6d\x67\x9e\x45\xe8\x73\x63\x9e\xc5\xbc\xd9\x97\x0f\xca\x72\xbc\x6d\xcb\x8b\xd0\xce\x14\x73\x03\xdc\x94\x97\xb3\xa6\x8b\xbd\xc6\x5b\xf2\x6c\xc3\x20\xe5\x37\x90\xdc\x44\xe6\xb0\xb6\x56\x05\x3c\xa9\x25\x06\x6f\xac\x12\xf0\xcd\xbe\x6a\x00\x61\xd2\xd3\xb6\xea\x88\x6c\x96\x90\x18\xd0\xa6\x2a\xcf\x90\xda\x25\x60\x5b\xaa\x04\x76\xb3\xa3\x1a\x82\x43\xf8\xda\x50\xa5\xb0\xcd\xe2\x12\x21\x6d\xa7\x4e\x38\x52\x3b\x05\x70\x33\x95\x4e\xa9\x6f\x76\x8d\x02\x70\x10\x61\x87\xf8\xf0\x15\x31\x62\xcf\x6e\x23\x26\x12\x88\x07\xa9\x98\x93\x39\x93\xb0\x8d\x99\x5f\x6d\xf6\x94\xc2\x71\x29\x6f\x1b\x4b\x13\x9c\xf3\x39\x52\x50\x5b\x4c\x12\xbe\x00\xee\x03\xdd\x6c\xa2\x9a\x6c\x76\x9c\xc2\xf1\x1c\x6f\xdb\x4e\x13\x9c\xf3\x79\x4e\x50\x1b\x50\x12\xbe\x00\x9e\x03\xdd\x8a\xa2\x9a\x6c\xf6\xa3\xc2\xf2\x1e\xaf\x9b\x52\x42\xbc\xf3\x79\x51\x70\xdb\x53\x0a\xde\x00\xde\x64\xb2\x51\xc5\x34\xdb\xec\x56\x85\xe4\x4f\xde\xb6\xac\x04\x58\x7d\xee\x5b\x09\xd0\x6f\x36\xaf\xc2\x33\x24\x6f\x3b\x58\x52\xdc\x73\x06\xe8\x00\xf7\xb2\x34\xfc\x81\x02\xb5\xf1\xae\x96\xa0\x75\xb8\xea\x30\x55\x84\xd9\x78\xb5\x39\x78\x1a\x50\x94\xf1\x77\xf8\x74\x8a\x74\xbe\xb8\x12\xd6\x4e\xa9\x8c\x31\x80\x03\x81\xf7\x4c\xa9\x36\x9b\x8d\xd3\x50\x9c\xc7\xd3\xee\x29\x87\x71\x3e\xb7\x09\x68\x1f\x55\xc8\x15\xc0\x61\x60\x3b\xaa\x54\x83\xcd\xb6\x6a\x30\xee\xe2\x6b\x6f\x95\x47\x39\x9f\xc3\x84\xb4\xcb\x2a\x66\x0b\xe0\x32\xc0\xfd\xd6\x6e\xb3\x60\xb3\x25\x74\xbf\xfe\x42\x75\x83\x0f\x5f\xa1\xd1\x79\xf6\x13\x1a\x75\x20\x3e\x32\x65\x49\xe6\x1f\x14\x24\xd8\x37\x36\x9b\x3e\x41\xf8\x86\xb7\xfd\x1e\x1a\xdd\x2c\xbe\x11\xd4\x2e\xcf\x94\x25\xb5\x6f\x40\xf7\x76\x08\xf4\x66\x5b\x27\x18\xff\xf0\xba\xa3\xc3\xa3\x9c\xc5\x4f\x82\xdb\xc7\x11\xb3\xa5\xf6\x17\x93\xdd\x9b\xbe\xc5\x66\xe3\x26\x10\x8f\xf1\xb6\x67\xc3\x22\xf4\xb9\x5d\xc3\x62\xde\xec\xd4\x04\x65\x39\xde\x36\x69\x44\x68\x67\x8a\xb9\x01\x6e\xcd\xc8\x59\xd3\xc5\x5e\xe3\x0d\x19\xb6\x61\x90\xf2\x1b\x48\x0e\x1e\x73\x36\x9b\x2f\x61\x04\x0e\x7f\xfb\x2e\x0c\xbe\x59\x42\x45\x58\xbb\x2d\x02\x9e\xd4\x2e\x02\xde\x63\x21\xe0\x9b\xed\x95\x00\xdc\xc3\xd3\xce\xca\x88\x6c\x16\xc7\x08\x68\x3f\x85\x67\x48\xed\x12\xb0\x5d\x14\x02\xbb\xd9\x40\x09\xc1\x21\x7c\xed\x9d\x50\xd8\x66\x71\x89\x90\x76\x4c\x26\x1c\xa9\x9d\x02\xb8\x4f\x42\x32\x68\x9b\x5c\xf0\x3d\xbb\x05\xd5\x0d\x3e\xfc\x82\x46\xe7\xd9\x31\x68\xd4\x81\x78\xc6\x94\x25\x99\x6b\x50\x90\x60\xdf\xd8\xe4\x82\x83\xf1\x0f\xaf\xb9\x60\x1e\xe5\x2c\x7e\x12\x5c\x2e\x58\xcc\x96\xda\x5f\x4c\x72\xc1\x7d\x8b\x4d\x2e\x38\x10\x8f\xf1\x96\x0b\x66\x11\xfa\xcc\x05\xb3\x98\x37\xb9\xe0\xa0\x2c\xc7\x5b\x2e\x58\x84\x76\xa6\x98\x1b\x60\x2e\x58\xce\x9a\x2e\xf6\x1a\xe7\x82\xd9\x86\x41\xca\x6f\x20\x39\x78\xcc\xd9\xe4\x82\xc3\x08\x1c\xfe\x72\xc1\x0c\xbe\x59\x42\x45\x58\xb9\x60\x01\x4f\x6a\x17\x01\xe7\x82\x09\xf8\x26\x17\x1c\x80\x7b\x78\xca\x05\x8f\xc8\x66\x71\x8c\x80\x72\xc1\x3c\x43\x6a\x97\x80\xe5\x82\x09\xec\x26\x17\x1c\x82\x43\xf8\xca\x05\x53\xd8\x66\x71\x89\x90\x72\xc1\x13\x8e\xd4\x4e\x01\xcc\x05\x8f\x59\x81\x4d\xce\x2b\x00\xf7\x10\x74\x87\x0f\x3f\x11\xa1\xf5\xec\x30\x22\x12\x81\x78\x8e\x9c\x35\x99\x0b\x09\x5a\x18\xfa\xd2\x26\x17\x16\x90\x27\x79\xcb\x87\x4d\x91\xfa\xcc\x89\x4d\xb1\x6f\xf2\x62\xc1\x59\x91\xb7\xdc\x98\x0c\xf5\x8c\x71\x39\xc0\x1c\x99\x9a\x3d\x48\x7c\x36\xce\x95\x4d\x1b\x07\xab\x0b\x43\x2d\x18\x8d\x51\x9b\xdc\x59\x38\xc1\xc5\x5f\xfe\x6c\x82\x73\xb6\x70\x12\x56\x1e\x4d\xc2\x97\xde\x75\xc0\xf9\xb4\xb1\xc9\x26\xa7\x16\x88\xdb\x78\xca\xab\xb1\x08\x67\x73\x98\x80\xf2\x6b\x22\xa6\xf4\xae\x02\xcb\xb3\x8d\xf0\x9b\x5c\x5b\x28\x8e\xe2\x2b\xdf\xc6\x61\x9c\xcd\x55\x42\xca\xbb\x09\xb9\xd2\x3b\x0b\x38\xff\x46\xcf\x7a\x37\xeb\xbe\x60\x5c\x47\xda\x2d\x7e\xfc\x48\x8e\xde\xbb\x53\xc9\x49\x05\xe3\x61\x3a\x16\xe5\xee\x26\x6d\x69\xec\x7b\x9b\xf5\x50\x58\x4e\xe7\x73\x4d\x24\xc0\x3b\xab\x9b\x85\xb6\x36\x92\xf2\x06\x73\x2c\x83\x35\x12\xdd\x6c\xb3\x4e\x0a\xc8\x9d\xbc\xad\x95\x78\xa4\xb3\x3a\x52\x50\x6b\x26\x31\x63\x30\x17\x82\xae\x9d\xe8\x36\x9b\xf5\x53\x48\x0e\xe4\x6f\x0d\x35\xc1\x3a\xab\x0b\x85\xb5\x96\x92\x70\x06\x73\x22\xc3\x35\xd5\x66\x17\x36\x18\x17\xf2\xba\x07\xcb\xa2\xf4\xbb\x03\xcb\xe2\xde\xac\xc3\x03\xb3\x1f\xef\x2b\xf0\x8f\xb2\xf6\x0e\x7a\xd5\x6d\xb3\xde\xb6\xda\x75\x65\x9b\x06\xaa\x05\x23\xf9\x0d\xc6\xa2\x4d\x76\x21\x94\x50\xe2\x3f\xaf\x30\x6f\x46\x21\xcc\x5c\x82\x51\x16\xc1\x38\x7f\xb0\xc9\x1c\x04\xe1\x2a\x9e\x73\x06\x33\x66\x0b\x02\xcc\x13\xc0\x33\x04\x66\xb9\x81\x4d\x56\x20\x0c\xe7\xf0\x9d\x0f\x98\x33\x13\x10\x62\x0e\xc0\x60\xf5\x0f\x5c\xf7\x77\x63\xd2\x66\xaa\x75\xdf\x2e\xc2\x74\x84\x0f\x1f\x61\x11\x7a\x76\x12\x16\x79\x20\x5e\x22\x62\x4a\xe6\x26\x0c\x2c\xdc\x4f\x36\x93\xac\x10\x9c\xc4\xd3\x1c\x8b\xc2\x36\x8f\x7b\x04\x34\xc3\x9a\x70\xa4\x71\x0c\xd8\xfc\xaa\x03\xde\x4c\xaf\x82\x70\x0b\x5f\xb3\x2b\x1a\xdd\x3c\x8e\x11\xd2\xdc\x6a\xca\x92\xc6\x35\x80\x33\xab\xd6\xd5\x36\xc3\xc5\xbd\xfa\x45\xd7\x05\x3e\x7c\xa2\x47\xe5\xd9\x1f\x7a\xb4\x81\xf8\x02\xcb\x8e\xcc\x0f\x3a\x28\xa0\x0f\x6c\x06\x87\xfb\x77\x02\x5f\x23\xc3\x80\x6b\x06\x37\x08\x69\x4c\xe0\xf8\x51\x39\x02\x70\x34\xc0\xd8\x36\xae\x70\xbf\xae\x30\x74\x82\x0f\x5f\x18\x91\x79\x76\x86\x11\x71\x20\xde\xc0\x33\x24\x73\x87\x01\x4e\xef\x0f\x90\xf7\x26\x2f\xb3\x34\x5a\xaf\xb3\x94\xfb\xb9\x48\xae\x51\xb4\xbc\x4a\xaa\x64\xd9\xa0\x2a\xba\x49\xaa\xbb\xb6\x9f\x58\xa0\x73\x74\x99\x15\x71\x6b\xbf\xf1\x1d\x4a\xaa\xa8\xbe\x4e\xf2\x3c\x2b\x9a\xc9\xd7\xeb\xb2\x68\xae\xe4\x9f\xd3\xe4\x8e\xfb\x88\x8a\x54\x8a\x77\xf8\x26\xc2\x3a\x7c\x9c\xe2\x24\x37\x51\x2f\xcb\x14\xf5\xaa\x20\xbf\x37\x77\x2b\xee\x97\xa4\x42\x09\xfb\xcb\xb2\xbc\xbe\x46\x45\x33\xd5\xc8\xe2\xe9\x97\x5f\x6e\x33\xee\xa1\x53\xda\x47\x8a\x11\xa8\x48\x51\x1a\x9d\x97\x65\x8e\x92\x62\x00\xb8\x48\xf2\x1a\x29\x9c\xd7\xd3\xd3\x98\x04\x4d\xcb\x01\xd7\x7c\xb1\x58\xb0\x5d\xfb\xe6\x74\xe0\x66\x3b\x3a\x3e\x89\x16\x5c\xef\xca\xbf\xb7\x1d\x4c\x7f\xdd\x8e\x0e\x8e\x5e\xe1\xef\x28\x8d\xf6\xa3\xa6\x5a\xa3\x6d\xd2\x44\x40\x12\xa3\xeb\xe1\x79\x7a\x82\x8f\x3d\x31\x4c\x48\x2a\xf1\x68\x61\xbc\xd8\x94\xed\x7d\x1f\xed\x76\xd8\xa9\x1f\xbf\x8b\xf6\x76\xdb\xff\x6d\x03\x6a\x81\x70\xab\x24\xcf\x12\x7d\x26\x19\x07\x00\xf1\x37\x90\x83\xe7\xe5\x32\xc9\x11\x1e\x02\x3e\xaa\xf1\x4e\x5d\xb2\x2e\xab\x26\xfe\x04\x83\xd2\xaa\xca\xae\x93\xea\x2e\xbe\x28\xab\xb8\xd3\x36\xc4\x67\x1d\xbc\x1b\x5b\xce\xef\xca\x2d\x89\xc4\xce\x51\xad\xef\x2a\x5e\x75\x5d\xb7\xb1\x2c\x0a\xfa\xf5\xcd\x69\xf4\xfa\xe0\xed\xe9\x61\x27\x3e\xd5\x6c\xd0\xd5\x94\x68\x8d\x92\x6a\x79\x15\x5f\x65\x45\x53\xc7\x49\x85\x62\x74\xbd\x6a\xee\x46\xea\xd8\x53\xbe\xfb\x3e\x7a\xda\xe9\x14\xff\xbd\xdf\xfe\x89\xd9\x58\x0c\x7e\xb3\xdd\x4d\xdc\xf6\xa3\x05\xf5\x67\x07\xc6\xfb\x0c\x2b\xcb\xc4\x67\xa4\x9f\xe9\xfe\xe0\xba\xca\x4b\x1f\xeb\x75\xdb\x45\x56\xb6\x4b\x26\x51\x55\x3c\x05\xa3\x8c\x25\x8c\x39\xe1\x84\x21\xc9\x9c\x70\x84\x83\xcd\x09\x3b\x9c\xd8\x56\x34\x43\x08\x0e\xb9\x82\x19\xff\x2a\xa9\xda\xf9\x10\x3b\x49\xba\xca\xf2\x14\xb8\x2e\x49\x51\xbd\xac\xb2\x55\x93\x95\x05\x35\xbc\x4c\x26\xa3\x86\xdd\xd6\x4a\x14\x5e\xdf\x51\x5c\x69\x3b\x10\x03\x43\x7b\xb1\x28\xca\x26\xc1\x2a\x5c\x68\xc7\x7b\x0a\x98\x07\x00\xce\x38\x9a\xa6\xca\xce\xd7\x8d\xbe\x6a\x5d\xc1\x05\x87\x2a\xa6\x07\x7a\x00\x68\x9c\xe4\x79\x79\x8b\xd2\xf8\x26\xc9\xd7\xdc\x0c\x61\x02\xdf\x1a\xed\x68\x5a\xd3\x51\x61\x80\x9c\x04\x74\x3d\x65\x36\x20\x09\x49\xb3\x83\x20\x0e\xce\x40\xc4\xc3\xc0\xaa\x46\x6e\x1c\xd9\x06\x14\x21\x79\x08\xc7\x94\xd2\x41\x7a\x58\xa8\x7f\xb0\x66\x06\x89\x74\x92\xf5\x1d\x0b\xe9\x71\x31\x78\x51\xa1\xae\x47\xfb\xb9\x5c\x00\x61\x16\xa6\x4f\xce\x70\x21\x41\x01\xe6\xf8\x04\xdf\xc8\x6d\x70\x43\x8d\x42\x0d\x61\xba\x96\x94\x4f\x90\xb7\x09\x9a\x83\x0a\xeb\x45\x58\xc2\x55\x8f\xa9\x42\x80\x31\xe8\x32\x4b\xe3\x0a\xa5\x59\x85\x96\x4d\xe7\x23\xb2\x8c\x1b\xba\x8d\xa5\xee\xb3\xac\x90\xc1\x22\x1a\x6c\xca\x01\x75\x87\x56\xff\x40\x85\x67\xab\x0c\x30\x1b\x6a\xa1\x86\xd8\xbc\xd8\xdb\xe3\x43\xbc\x59\x9e\xc3\xac\x77\x24\xf3\x91\x6c\x95\xf9\xca\xbc\xb5\xa8\xda\xff\x63\x11\xb4\x32\xff\x77\xb4\xf5\x7f\xff\x91\xfe\x6b\x6f\xef\xdf\x9f\x77\x99\x7c\x68\xc2\x29\xab\x0b\x90\x62\x5b\x30\x4a\xb3\xcf\x43\xd0\x6c\x5d\xf8\x53\x6d\x8b\x0b\xff\x87\x53\x6e\x2b\x77\xaf\xdd\x2f\xff\xfd\xf3\x3f\xd2\xbf\xff\x62\xaa\xe2\x6b\xd4\x24\xba\x71\xb4\x4a\x9a\x96\x47\x36\x89\x44\x7e\x8c\x97\xe5\x9a\x1f\x27\x27\xfc\xb7\x34\xe2\x1e\x9e\x9d\xf7\x76\xa8\xb1\xf8\x64\xea\xd9\xfd\xf2\xdd\x7e\xb4\x67\x90\x9a\xec\xb0\x57\xc9\x2d\xc0\x5e\x5a\xd5\x97\x15\x4c\x58\x5d\x66\x6b\x24\x3c\xb3\x84\x4d\x72\x09\x10\xad\x85\x92\x44\x73\xba\xa3\xdc\x73\xa6\x60\xa6\x7d\xf4\x89\x5c\xaa\xac\x8e\xd7\xab\x9b\xb2\x99\x66\x30\x9b\x6a\x8d\x0c\xe7\x9a\x7e\x67\xec\xa1\x4d\x21\x43\x9b\x0b\x01\x67\x40\xc0\x79\x4f\x71\xb9\x4e\x2e\xb5\xbd\x97\xd5\x25\xd9\x2a\x79\x4a\xad\xa4\x16\xcf\xb6\xf9\xaf\xe7\xaa\xaf\x7b\xd4\xc7\xa7\xdb\x4a\xb3\x68\x1d\x7c\xba\xfa\xfa\x75\x8d\x8a\xe5\x9d\xd6\x00\x06\x82\xcf\x04\xdc\x50\x81\x68\x80\xe3\x22\x0f\x2d\xec\x24\x77\x4e\x21\x67\xb3\xc1\x20\x7b\x22\xda\x0e\xc5\x9a\x18\x6e\x64\xb6\x44\x80\xf4\x96\x94\x15\xef\x27\x69\x27\x45\xe5\x06\x68\x75\xe9\x32\x93\xd6\xb1\xd8\x97\x4c\x78\xe3\x34\xcd\x1a\x94\xc6\x49\xcd\x66\x60\x0d\x99\x82\x84\x52\x51\x80\xac\xca\x52\x36\x48\x99\xc4\x4e\xa3\x02\x03\x51\xf0\x16\x07\x5f\xe7\x71\x52\x6c\xc0\x53\xd5\x05\xe2\x59\x52\xc6\x24\x4e\x36\x85\x87\xf9\x1b\xb1\xba\xe4\x3c\x47\x62\x13\xd2\x98\x2e\x54\xc7\xe1\x28\x55\xa7\x45\x98\xda\x5a\xc3\xf4\xa0\x30\x19\x76\x9c\x81\x11\x61\x1f\x3e\xbb\x44\x9a\xeb\xac\xe0\x56\x14\xd7\xc9\x87\x49\xf5\xcc\x6c\xfe\x16\x98\x97\xc1\x7c\x0b\xe4\x51\xd7\x28\xcd\xd6\xd7\xf0\xad\x13\x02\xaf\xfc\x08\xcb\xa1\x0a\x61\x55\xbb\x27\xd3\x06\xd2\xed\x93\x09\x28\xbf\x7f\x02\x21\xce\x6e\xa0\x88\xa9\x0b\x76\x50\xa0\xa8\xd9\x2d\x14\x39\x7a\xf8\x1e\xca\x04\x47\x10\x06\x2b\xe1\x4a\x6c\xb7\x3c\xb0\xb1\xf9\x6e\xf6\x51\x1c\xf7\x51\xd4\xe6\x7b\x51\x56\xd7\x49\x3f\x7b\xec\x40\xbb\xdf\x5c\x22\x82\x1b\x53\xa0\xdd\x1d\x93\xd0\x14\xc4\xf6\x8e\x95\x16\x3c\x77\x94\x38\x1c\x9b\xcc\xa4\x20\x3c\x87\x19\xa6\x0c\x77\xa4\x00\xed\x21\x5b\x52\x62\x34\x01\x6b\xc8\x58\x27\xd0\x90\xbe\x4c\x9b\x72\xe9\x34\x1b\x21\x18\xee\xf5\x18\xc4\x74\x4e\x82\x99\x32\x4e\xf5\xc3\x3d\x8d\xe0\x0f\xc9\x60\x68\x8e\x94\x76\x82\x01\xa1\xe6\xc1\x04\x38\xd3\x91\x5e\x90\x68\x73\x8d\xf1\x82\x82\xdb\xab\xa4\x8e\xd3\xac\x5e\x66\x69\x0d\xaa\x83\xf5\x97\xc2\x65\x74\x14\x94\x31\x30\x2c\x29\xad\x81\x40\x1a\xc4\xcc\x90\xc4\xd4\xcb\x07\xb5\xf3\xac\x48\xd1\x07\x66\x1c\x97\xed\x7a\x94\xcb\x68\xb5\x3e\xcf\xb3\xe5\x93\xe5\xfa\x1c\xe9\x26\x13\x65\x9e\xc6\x64\x17\x85\xd4\xac\x2f\x54\xfe\xf2\xfc\x8b\x6d\xf0\x2c\x05\xbb\x0b\x4e\xce\x8c\x0b\x7f\x58\x22\x80\x94\x20\xb4\xd6\x3f\x6c\xa7\xf5\xee\xb2\x27\xdf\x58\x13\xd0\x8b\x07\x4c\xfc\xc2\x6f\x24\xb1\x1f\xed\x91\x05\x1b\xfd\xd3\x53\xc0\xfe\x1a\x7e\x21\x78\xce\x23\x4b\x82\xc3\x3e\x69\x5a\xa1\xba\x16\xb4\x34\x59\x80\x88\x8e\x0c\xe1\xf7\x56\x92\x06\xd5\xd1\xaa\x1c\xe2\xd6\xc3\x3b\x49\xf4\xb0\x0e\x54\x58\x9d\x8c\x20\x0f\x53\xff\x3e\xce\x44\x10\x59\xdd\xe6\x48\x12\xc7\x85\x9d\x4a\x22\x3e\xbe\x39\x95\x14\xb0\x13\x7d\xcc\x53\x49\x94\xe5\xfc\xae\x3c\x70\x73\x2a\x69\x73\x2a\x09\xb0\xda\xa0\x8d\x25\x88\x49\xf8\x94\x21\xf1\x4c\x9c\x82\xd3\x4e\xc7\x69\x9c\x9f\xc6\xa9\x24\x5e\xa2\xf0\xfa\x4e\x9b\x60\xe2\x81\xa1\xbd\xc8\x9f\x4a\x52\x8d\xf7\x0e\xa7\x92\x3a\x6a\xd0\xad\x35\x15\x17\x1c\x2a\xd5\x32\x4a\x04\xaa\xda\x57\x9b\xc0\x4b\xb7\xd5\x78\x48\x3e\xa0\x03\x28\x73\x01\x49\x44\x5a\xb0\xa7\x06\x44\xcc\x6e\xa9\x49\x91\x1b\x47\xb6\xb0\x36\xd4\xc4\x4c\x29\x1d\x04\xbc\x9d\x26\x34\xb3\xcd\x6e\x9a\xf5\x96\x0d\xc0\x70\x21\x41\xe1\x01\x6d\x5b\x81\x4c\x36\xd4\x4d\x20\x30\x9f\x20\x6f\xb3\xdc\x02\x12\x62\x09\x57\x3d\xa6\x0a\x01\xc6\xa0\x50\x4f\x25\x11\xee\x02\xea\x0e\xad\xfe\x81\x0a\x1f\x4f\x0c\xa8\xe6\x21\xc1\x9c\x18\x18\x98\xa6\x4e\x0c\xa8\x18\xbf\xdf\x13\x03\x1d\xb7\x9f\xe8\x89\x81\x51\xba\x90\x1c\x03\x18\x9d\x40\x31\x69\x78\x9a\x7c\xce\xc4\x7f\x52\x35\x59\xdd\xf4\x15\xda\x12\x4f\x42\xc5\x65\x73\xc5\xa7\xf1\x1f\x5a\xbe\xfe\x26\x4b\x51\x69\x9a\x85\x1b\x9f\x87\x77\xce\x48\x8d\xa8\x88\x3e\x27\x77\x61\x11\x2d\x0f\x8b\x15\x9c\x76\x22\xbf\x7d\x1f\x41\x0e\x61\x8d\x04\x40\xf9\xe6\xd1\xbc\x36\x39\xe7\x4d\xce\xf9\xfe\x92\xa6\x9c\xd9\xfe\x4e\x92\xdd\xbc\xd4\x33\x14\xe7\xf0\x24\x82\x18\x26\xc5\x4c\x89\xc7\x4a\x0e\x16\x3e\x60\x7e\x52\x29\x53\x91\x54\x61\xf6\xa5\x76\xf2\x23\x6a\x60\xd2\xab\x7c\x0a\x55\x37\x84\x39\xa4\x51\x29\xaa\xd0\x54\xaa\x8e\x1b\x01\x4a\x55\x66\x45\x06\xae\x4a\xab\x0a\xdb\x48\x53\xab\x22\x68\x3e\xfa\x02\xb9\x60\xa3\xa0\x94\x0d\x41\x9a\xd5\x80\x00\x9b\x6a\x55\x12\x81\xa7\x5b\x45\x68\x42\xf3\x2e\x50\xda\x55\x00\x6f\xe2\x5b\x9b\xf4\xab\xa7\xf4\x2b\xd0\xa0\xa1\xc1\xe4\xa1\xa7\x61\x61\xea\x08\xd7\xe5\x0c\xd3\xb1\x30\x14\x90\x94\xac\x14\x53\xd8\xaa\xb2\x51\x8e\x41\x9c\x0a\x35\x45\x3b\x72\x18\x58\xf7\x80\xfa\xc3\xa0\x03\xe6\xbd\x8d\x87\xa5\x33\xdb\x7d\x35\x23\x99\xc9\xad\x3c\xba\x59\x9c\x9f\x9b\x79\x44\x0c\x78\x92\xf6\x3f\xdf\x1c\xfe\x4d\x22\x6c\xf7\xd0\xe6\xc1\xe9\xa3\xe8\xf4\xf0\xed\xe1\xcb\xb3\x28\xaf\xea\x27\xfd\xcb\x1e\x07\xa7\x23\x5b\x5d\x7a\x66\xf8\xba\xd7\x7e\x25\xcd\xc7\x4f\x59\x4a\xda\xe4\x78\x8a\x5d\x5f\x65\xab\xf1\xdb\xf8\xfa\x04\xfb\x5b\xaf\x89\x83\xb7\x87\xa7\x2f\x0f\x17\x79\xd2\xdc\x3c\xc1\x87\xc4\x71\x5c\xdc\x19\x5e\xd2\xd8\x6e\x51\x8f\x1f\xda\x56\xaf\x4f\x8e\x7f\x24\x07\x69\xe9\x43\xc7\xf1\x44\xbc\xbc\xaa\x31\x91\xe8\x7f\x1d\xbf\x39\x62\x34\xd1\x01\xd4\xd1\xf1\x51\xb4\x58\x60\x01\xf6\x69\x21\x5b\x5d\x8a\x5b\xe2\x4b\x2b\x72\xd2\x2e\x1f\xdb\xb5\x3f\xab\x1b\x91\x41\x3b\x6f\x48\xd3\x45\xde\x74\x8d\xc7\x8f\x7d\x15\x54\xf3\xe4\x12\x7f\xda\x42\xc9\xf3\x8b\xdd\xe7\xdf\x7c\xfd\xf8\xf9\x57\x5f\x3f\x7d\xfc\x6c\x37\x7d\xfe\xf8\x7c\xef\x79\xfa\xf8\xe9\x37\xcf\x76\xbf\xde\x3b\x7f\xfe\xfc\xab\xaf\xbe\xd8\x7a\xf1\xa2\x0d\x79\xdb\x03\xf1\xb7\x87\xaf\xcf\x24\x1c\xb0\xd3\xe3\x6e\xfa\xd1\x2a\xbe\xe7\xa9\xed\x03\x6e\x96\xb1\x1f\xd5\xa4\xa8\x9e\x09\xd6\xc3\xd5\xab\xcd\x0d\xd1\x48\x2b\xc8\xc0\xc5\xf1\xc9\xab\xc3\x93\x36\xc4\x50\x1a\xdd\xe1\x8c\x01\xea\x96\xe3\xc6\x90\xce\x1f\x83\xd9\x1c\x62\x98\xf7\x15\x50\x66\xdf\x24\xea\x5e\xec\xbd\xef\x2d\x86\xfe\xe1\xe0\xcb\xaa\x5c\xaf\x24\x30\x75\x93\x34\xeb\x9a\xab\x23\x4a\x96\xef\x93\x4b\x4a\xb9\x7d\xf7\x76\x97\x2d\xb1\x29\x62\x3c\xa5\x65\x7f\x3b\x4f\x2a\xfc\xd2\x84\x78\x7d\x72\xcf\x7b\x1d\xbf\xae\x93\x3c\x6b\xee\xa6\x47\x53\xb6\x1e\xef\x6d\xbd\x78\xe1\xcd\xb4\x05\xc3\x13\xe9\x0e\xdf\xe5\xf0\x3d\x5e\xe0\x06\x05\x31\xcd\xcd\xf6\xc4\x66\x7b\xe2\x3e\xb7\x27\x28\x93\xfd\xdd\x6c\x4e\xd0\x32\xcf\xb2\x35\x41\x13\x08\x64\xc5\x34\x65\x49\xb6\x6a\xa2\x20\x01\x2b\x27\x1a\xef\xa7\xb2\x25\xc1\xcb\x14\x62\x1f\x02\xd2\x11\x3c\x38\xbc\x37\xa7\x5b\x11\xaa\xc1\xca\x69\x23\xa2\xa3\x08\xdf\x86\x50\x71\x32\x41\xa7\xde\x82\x10\x01\xab\x37\x20\x26\x2d\x14\xdb\x0f\x3c\xec\x74\xf3\x01\x40\x9f\xdf\x7a\x10\x32\x20\xdc\x78\x00\x22\xe7\xb7\x1d\xa4\x04\x4c\x36\x1d\x78\x24\x61\xf9\x0f\x70\xc3\x81\x83\x86\x7b\xcf\x66\xb3\xc1\xdb\x66\x03\xc0\x88\x61\x01\xe3\xe1\x6f\x34\xe8\x55\x11\xaa\x9b\x19\x6f\x32\xe8\x11\xc0\xb6\x18\x84\x78\x42\x56\x92\xb9\x5a\xc0\x51\x09\xe7\x8b\xaa\x3b\xd0\x80\xde\xc3\x4a\x2e\x95\x90\x2c\xb2\xa4\x0b\xac\xc9\xe2\x0a\xea\xf7\xcb\xf2\x06\x55\x49\xa5\xbd\x93\x06\xb4\x92\xed\x45\xbb\x41\x55\x9c\x54\x4d\xbc\xae\x72\x49\x28\x85\xb2\xb7\x2e\xde\x17\xe5\x6d\x61\xa4\x5a\xdf\xca\x13\xe4\xc3\xbb\xe4\xc6\x4d\x1b\xb9\xa8\x64\xf8\xaf\xfd\xa7\x2e\xfb\xf2\x64\x60\x85\xf9\x01\xf3\xc0\xfc\x92\x26\x77\xfd\xdf\x9d\xa4\x63\xbe\xba\x47\xce\x59\x19\x4b\xaa\xff\x1f\x0f\xc4\x31\xa0\x04\xa3\xd8\x52\xc2\x0d\xcc\xca\xa0\x28\x11\xfa\xff\x61\x51\x14\x3e\x33\xc0\xbe\x3b\x7a\x73\x7c\x14\x1d\xbc\x7d\x3b\xb6\xe6\xe4\xe7\x4c\x42\xad\x07\x1e\x58\xa3\x0f\x21\xb8\x42\x2f\x42\xf8\x89\x7e\x5a\xd3\x1c\x92\x6d\x20\xad\x70\x78\xb7\xa3\x5f\x61\xee\x12\xc0\xe6\xa6\x82\x39\x9c\x9a\xbd\xe7\x3c\xf1\x34\xb1\xf6\xf0\x0a\xd1\x05\x4b\x20\xac\xdb\xd9\xb2\xad\x04\xbb\x49\xce\x55\x99\x87\xdf\x64\x5e\x37\x99\xd7\x8f\x99\x79\xa5\xcc\xf7\x77\x96\x7f\xa5\x25\x9f\x31\x0b\x4b\x93\x09\x6a\xee\x3f\x65\x4c\x3d\xf1\xa7\xe0\xc1\xb3\x7e\x9a\xc6\xa7\x95\x9d\xe5\x25\x0b\xb7\x6f\xc1\x2b\x3b\xbe\x91\x69\x2f\x4b\xb2\xb6\xca\xe1\xce\x43\xee\xb6\xa3\x6e\x98\xc1\x55\x72\x25\x41\x0d\xc9\xe6\x8a\x9a\x40\x72\xba\x93\x76\xda\xcc\x2e\xdf\x42\x96\xdf\x05\x70\x24\xce\xf2\x0a\x59\x52\xe4\x7a\x81\x84\xc4\x19\x5f\x29\x31\xf3\xbc\x2f\x8f\x2a\x44\xcf\x34\xca\x01\x73\x6d\x4c\xfd\x72\x93\x0f\xf6\x9c\x0f\x06\x18\xba\x49\x10\xfa\x54\x72\xc3\x7a\xb5\x84\xed\x8a\x96\x79\x62\x3d\x1a\x93\x6c\xb1\x10\x5b\xf8\x6a\xb3\x55\x94\x61\x2c\x0b\x20\x87\x03\xd0\x4d\x80\xdd\x05\xee\x1f\xc3\x0e\x01\x15\xac\xd3\xe9\x4c\x6d\x5c\xba\xc8\xaa\xba\x89\xfb\x36\xb2\x75\xbd\x00\x4a\xb4\xc2\x17\x80\x4d\xd7\xfa\x2e\x05\xf5\xbc\x2e\x66\x2c\xaa\xa7\x49\xf5\x59\x80\x4f\xf5\xfe\x11\xb9\xb4\x21\x3a\x97\x80\x3f\x48\x24\xa4\x9b\x19\xba\x9d\xe0\x60\x85\x7e\x55\xe3\xeb\x70\x85\x98\x91\x8f\x64\xf9\x35\x5a\x96\x45\x6a\x64\xfb\x0f\x28\x9f\xc0\x4a\x17\xa2\xad\x0b\x39\x84\x58\x3b\xdb\xd0\xd0\xde\x39\xaa\xff\x55\x66\x26\x49\x06\xce\x66\x7c\xcc\x09\x24\x5d\x2c\xdd\x0e\xed\xc5\x98\x1e\x11\xba\xe4\xce\x08\x51\xf0\x5d\xb6\x7e\x04\x11\x1c\x14\xba\x54\x9d\x14\xba\x14\x1d\x15\xba\x9c\xef\xac\x90\x40\xd6\x96\x1e\xa6\x04\x3e\x30\x74\x69\x7b\x62\xe8\x72\xa6\x23\x43\xbb\x7b\xbb\x7b\x5f\x7f\xf1\xec\xab\xc7\xdf\xec\xa5\x5f\x3f\x7e\xf6\xfc\xfc\x9b\xc7\xe7\x17\x5f\x7f\xf3\xf8\xd9\xc5\xd7\x5f\x7f\x99\x7e\xf9\xec\xfc\xcb\xf3\x34\xf0\x23\x43\x97\xcc\x99\xa1\x4b\x93\x43\x43\x74\x9f\xd2\x07\x87\xf4\x6e\x17\xd0\xe1\x21\x4e\x08\x9f\x03\xa7\xe7\x43\x44\xca\x10\x1c\xd4\x78\x00\x08\xfd\xe0\x28\x8f\x9f\x7a\x77\xab\xbd\x25\x28\xd8\x11\x3d\x69\x92\xbc\xbc\x8c\x8b\xf5\xf5\x39\xaa\x94\xa7\x90\xe6\x79\x69\x96\x11\x2f\xac\xde\xa3\x59\x52\x0f\xdf\x18\x12\xdc\x93\x90\x05\x21\x5e\x87\x25\x69\x6a\xb8\xfb\x9c\x15\x17\xa5\xa2\x6c\xaa\xdb\x50\xb8\x4e\xfe\x59\x16\x71\x52\x67\x85\xf0\x99\x30\x16\xac\x6e\xca\x4a\xb8\x38\xda\xdd\xe6\xeb\xb5\x56\x15\xaa\x51\xb1\x44\x8c\xd4\x82\xcf\x43\xde\x33\x39\xaf\x51\xd1\x6c\xbd\x78\xa1\x69\x60\x9a\x7d\x1c\x4f\xe5\x7d\xc2\x0b\xbf\x41\xc8\xb0\xbc\x86\x67\x4b\xed\x39\x03\x34\xd8\x7b\xc6\x71\x49\xda\xab\x4d\xd6\xe4\xc0\x6e\x25\x35\x3f\x6a\x77\x31\xf6\x42\x1c\x29\xaf\xcb\x34\xbb\xc8\x4c\x5b\x96\xe5\xfb\xf5\x4a\x96\x06\x22\x30\x18\xf1\x9d\x1a\xa6\x2e\xd7\x15\x75\x2b\x2c\xf7\xf5\xa3\x9d\x39\x35\xb0\xe6\x76\x79\x1c\x94\x1d\x8f\x0c\xa9\x2d\xb8\x4a\x6e\x39\xdb\x95\xae\x70\x00\xd7\x1f\x50\xb5\x88\x0f\xe4\xf2\x03\x46\xb4\x87\x7e\xf5\xc1\xb3\x8b\xe4\xe9\x37\x17\xbb\x7b\x8f\xbf\x46\x7b\xcf\x1e\x3f\x43\x5f\x7c\xf3\xf8\x9b\xf3\xdd\xe4\x71\x92\x3e\xdd\x7b\x9a\x24\x4f\x2f\xbe\xde\x0b\x7d\x1d\x63\x7d\xf5\x41\xd7\x91\xe4\x90\xfb\x27\x3c\x6e\x12\x09\xc3\x0a\x36\x0c\x4f\xea\x78\x43\x40\xc1\xc3\xe5\x64\x3d\xfa\x50\x56\xa2\xc2\x35\x68\x00\xab\x4f\x11\xc7\xab\x3c\x5b\x26\xe4\xe9\xcd\xb2\x68\xaa\x52\xbb\x4e\x5b\xae\xab\xd6\x2d\xe2\x7a\x79\x85\xae\x93\xb6\xd3\xd7\x78\xa2\xab\x86\xa6\x09\xf1\x4d\xa8\xfe\xa0\xc1\xda\x9e\x91\x76\x0c\xc8\x69\x26\xb2\x85\xe2\x39\x32\xc6\x64\xee\x33\x81\xd7\xfa\x50\x77\x5f\x87\xa6\x2f\xb3\xba\x8c\xd9\xe9\xd4\xe2\x0b\x3e\x0a\xb6\x30\xfc\x12\x7b\xf1\x8c\x87\x82\x3f\xdc\x7c\x51\x91\xee\xbf\x53\xc4\x4f\x40\xef\x12\x01\xc3\xe8\x50\x86\x17\x71\x1f\x12\x10\x7d\xb7\xe1\xa9\x06\xac\xde\xbc\x9b\x96\x6c\x0a\xcd\x37\x85\xe6\x9b\x67\x1b\x1f\xfa\xb3\x8d\x02\xc5\x8e\x91\xe0\x77\x52\xba\xcf\x88\x3c\x43\xcd\x3e\x83\x3f\x8c\x81\x63\xca\x91\x64\xf8\xa0\x00\x8d\x06\x91\x4f\x64\x1f\x7d\x22\x52\x80\xfd\xa7\xdd\x31\x9f\x40\x83\x7b\x92\xaf\xbd\x57\x0e\xfd\x0e\x45\xf7\x3d\x3d\x68\xb5\xbd\x92\x0f\x1e\x99\x6a\x7b\x5e\x08\xab\x2a\xac\x9f\x36\x90\x56\xd4\x4f\x40\xf9\x68\x0a\x21\xce\x86\x34\x31\x75\x41\xf1\x3c\x14\x35\x5b\x35\x2f\x47\x0f\x2f\x97\x9f\xe0\x08\xca\x61\x40\x05\xf2\x3c\x30\xd8\x5d\x36\x25\xf1\x9e\x4a\xe2\x21\xe6\x0b\x0a\x11\x0f\xbd\x08\x1e\xa0\x88\x40\x1d\xcc\xb0\xec\x1d\xd0\x1e\x52\xef\x2e\x46\x13\xb0\x86\x8c\x75\x02\x0d\x46\xa1\xd6\xb4\x77\xec\x85\xd4\x25\xfa\x3e\x80\x2a\x7d\xd8\x83\xf8\x54\x2b\xa8\x45\x72\x06\xd5\x97\x22\xce\x94\xde\xc5\x34\x80\xf6\xf3\xb8\x43\xa0\x9c\x89\x06\xb3\x41\x30\xb2\x4d\xed\x0f\x28\x59\xbf\xdf\xed\x81\x9e\x5f\xaf\x5e\x44\xf6\xf8\x08\xd2\x69\x83\x2f\x27\x09\xe3\xe0\xbc\x2e\x38\x67\x83\xfa\x18\xc8\xb5\x46\x9f\xf2\xd0\xd7\x15\xba\xd0\x1e\x0c\x02\x28\xbe\x75\x98\x20\x14\x3e\x32\x22\x56\x74\x93\xe8\xab\x81\xb0\xf7\x77\xb5\x0f\x9d\xa6\x9b\xe4\x72\x4f\xee\xe3\x4f\x25\x9f\x6e\x51\x76\x79\xe5\x31\x7a\x4d\x56\xce\x34\xa7\xdc\xaa\x19\x73\xfc\x1d\xe6\x4e\x7f\xc6\xa3\xa9\x92\xe5\x7b\xcb\xeb\x8f\x74\x4f\x00\x5c\xa3\x34\x5b\x5f\x4b\x3e\x7e\xb6\x2a\xeb\xac\xe5\xfe\x33\x99\x25\x93\x8d\x2d\x41\xee\x6b\xd6\x17\x80\x3f\xd2\x3e\x4b\x56\xc7\x69\xd2\x24\x31\xd1\xbf\xe1\x76\x02\x6e\xe4\xfe\x74\x2f\x41\x33\xd3\xb3\xbd\x04\x79\xa8\x53\x6c\xc2\x5d\x18\x71\x8b\x62\x45\x12\xb9\x5a\x08\x7d\xec\xc2\x78\x00\x75\x8c\x9a\x8a\x0d\xaf\x55\x8e\xd2\x12\x04\x70\x1f\x05\x53\xc1\xc7\xb3\xa3\xe8\x2b\x41\xf5\xde\xb4\xbf\xd6\x95\xb6\x9e\x43\xe2\x2a\x6d\x4b\x41\x58\xfc\xf8\xf7\xbc\xad\xab\xdc\xfb\xed\x6e\x2d\xce\x50\xa3\x46\xcb\x5b\x10\xb6\x38\x32\x22\xb6\xc2\x75\xa5\x2f\x43\xc1\x4b\x10\x8d\x01\x2a\x97\x38\x8a\x81\x15\xe3\x96\x9c\x68\x6d\xbf\xc5\xb8\x0f\x4c\xac\xae\x5e\xaf\x50\x55\x23\xfd\xde\x3b\xa0\x1b\x31\x07\x41\xf4\x23\xc5\x89\xb8\x23\x5b\x00\x6d\x4f\xde\x96\x95\xed\x14\x0e\x34\x95\xfa\xc4\x6e\xa7\x6c\xd5\xe5\x3d\x6c\x61\xa4\xa0\xda\x20\xdc\x5b\x9b\xca\xa0\x4d\x65\xd0\xa6\x32\xe8\x93\xab\x0c\x1a\xa3\xc0\xef\xa4\x2e\x88\x12\x78\x86\xaa\x20\x0a\x7b\x10\xc3\xf5\x84\x1f\xf1\xa0\x3d\x82\x81\x86\xee\x4f\xaa\x1a\x88\x13\x28\xb8\x7e\xd3\xe6\x63\x39\x58\x60\x0f\xf2\x55\x40\x8a\x41\xde\xa1\x06\x88\xd0\x82\x56\x00\x29\x78\x60\x11\xa9\xf6\xfd\x05\x90\xaa\xda\x1f\x1e\x5c\x5a\xf9\xc3\x01\xf2\xd1\x52\x4f\x96\x0d\x58\x22\xba\x82\x9a\x1f\x18\x5a\xb6\xe2\x47\x86\x1a\x5e\xef\xc3\x61\x08\xc8\x25\x40\xb5\x3e\x2c\x28\xd0\x21\x36\x75\x3e\x9e\xea\x7c\xf4\x26\x0b\x08\x03\x0f\xbd\xc6\x47\xab\x84\x20\x9d\xca\xb0\xbe\x47\xdb\x1a\x52\xdd\x23\x42\x12\xac\x6e\x0c\xb5\x01\x0b\x3d\xa1\x26\x0f\x31\x73\xe1\x74\x85\x4e\xf7\x30\x65\x0f\x2f\x52\x6b\xa7\x3d\xfc\xdb\xd5\x4e\x69\x20\xb3\xce\x11\x4f\x3e\x7a\x86\xe6\x49\x05\x81\xae\xe7\xb4\xbe\xfe\x72\x20\x31\xdb\xdd\x7f\x98\xc2\xe4\xb2\x43\x45\xff\xfa\xb9\xe3\x90\x23\xeb\x49\xbc\xc9\xd5\x09\x98\x8c\xe0\xde\x84\xfa\x96\xbe\x1b\xa1\x85\xea\x8c\x77\xf8\xb0\x3b\xb9\x34\xa1\xbe\x95\x5f\x9a\x50\xdf\x4e\x2f\x4d\xe8\x7e\xeb\x45\xf7\x77\x69\x42\x57\x49\x82\xfb\x28\xaf\x6f\x31\x7e\xe8\x8d\x09\xa3\x7c\x66\x37\x26\x74\xc2\x78\xbf\x31\xe1\x7c\x37\xfd\xe2\x8b\x67\xcf\x9f\x3f\x5e\xa6\x17\xbb\x8f\x9f\x25\xcb\xf3\xc7\xe7\x08\x3d\x7f\xbc\x7b\xf1\xfc\xcb\xe4\xab\xaf\x92\xe7\xe8\x62\x37\xec\x1b\x13\x46\x8d\xee\x70\x76\x00\x70\xbc\xb1\xd0\x47\xe1\x71\xc1\x94\xce\xf5\x2c\x7b\x08\x14\xb3\x97\xcd\x11\x5e\x3f\xd1\xd2\xd3\x41\xb8\x80\xe6\x19\xb0\x89\x9e\x74\x7a\x57\x37\x49\x93\xd5\x4d\xb6\xac\x9f\xe4\xe5\x65\x3c\xfc\xd9\x75\x9f\x2c\x21\xb6\x4c\x1a\x74\x59\x56\x77\xa2\x6f\x9f\x0d\x56\xff\x99\xe9\x9d\xa3\x5d\x87\x25\x4d\xc2\x22\x16\x59\x1d\xc5\x39\xcf\xb5\xa9\xd1\x09\x8e\xea\x33\xb9\x97\x29\x7f\x28\x5e\x96\x79\x8e\x96\xad\x93\xe3\xcb\x1a\x20\x77\xa8\xca\xf9\xee\x1e\x95\x5c\x0c\xe8\xc9\x7f\x44\x95\x23\x02\x85\xe3\x30\x29\xf8\x9d\x37\x7c\xf9\xb4\x80\x63\x84\xe4\xa6\xd9\x49\x01\x66\xe8\xfb\xfd\x68\xeb\xe9\xee\xee\xee\xe3\xdd\xbd\xc7\xbb\x7b\x5b\x2f\x5e\xb4\xbf\x4a\x73\x34\x42\x49\xef\xcd\x73\x14\xdc\x8c\x8e\x23\x02\x12\xfa\xcd\x6d\xf6\x3e\x4b\xcb\x65\xfd\xa4\xff\x47\x9c\x15\x29\xfa\xd0\x75\xe1\xaa\x9d\xf7\xca\x7c\xa7\x42\x37\x59\xad\x48\x4f\x12\xbe\x09\x99\xe3\xa3\xb7\x3f\x51\x57\xf5\x25\xd5\xf2\x2a\xbb\x41\x4f\xda\x7f\xe3\x20\x4b\x60\x5f\x1e\xbf\x7d\xf7\xe3\x51\x6b\xf6\xa7\x87\x67\xa3\x29\xa2\x0f\xcd\x4d\x92\x2f\xb6\xe4\xed\x3b\xf9\xb7\x5e\xbc\xa8\xd0\xe5\x32\x4f\xea\x5a\xc2\x02\x31\x0b\x7b\x16\xe4\xed\xc1\x2c\xd0\x31\x2d\xc9\x1b\x54\x15\x49\x93\xdd\xa0\xb8\xab\x82\x04\xb1\xa1\xc6\xe1\xcc\x4a\x5f\xf9\xe5\xc4\x0b\x7b\x0b\xa9\x33\x33\x06\x9d\x04\xc1\xe4\xcc\x16\x29\xc8\x74\xe2\x87\xae\xe8\x33\x64\x64\xdc\x1b\x30\xe7\x60\x68\x6b\x47\x7a\x35\xdc\x64\x63\x41\x7b\x6c\x6c\x47\xbc\x42\x89\x05\xd5\x0a\x25\xd6\xe4\xba\x62\x0d\x3b\xa2\xf4\x1e\xa0\x2d\x69\x5b\xc3\x67\x11\x38\x30\x31\xec\x23\x59\xf2\xc0\x6d\x1e\xb8\xb0\xe0\xa4\x0b\x51\x4e\xd1\x99\x19\x2e\xc7\xee\x85\x35\x51\x2a\xd8\x82\x51\x07\x5d\x39\x68\x08\x97\xfa\x5a\x50\xc5\x55\xf8\xf6\x24\xed\xdd\x74\x6c\xed\x4c\xde\x5a\xe5\x1c\x0a\x27\x46\x5c\xdc\x95\xc5\xe0\x85\x0d\x47\x9d\xf8\x70\x5a\x11\x26\x67\xb7\xd5\x22\x75\x61\xb6\x3b\x8d\x62\xcb\x16\x69\xee\xc2\x80\x53\xa7\xd9\x77\xd5\xba\x29\x49\xda\x27\x46\xed\xa2\xd4\x6e\x8e\x31\x45\xe2\x8b\x99\x18\xe7\x95\xbc\x70\x44\x55\xf4\x9a\xb1\xb5\x4c\x9b\x72\x69\xce\x03\x6e\x66\x4f\x10\xa7\xec\x2c\x89\x8e\x47\x21\xcc\x08\xdb\xb9\x80\xb5\xe5\xe3\x86\x85\x55\x07\x0f\x4d\xad\x09\x97\x95\x1d\xd5\xb2\x72\x20\xd9\xe7\x7e\xac\xdc\x6c\x82\xc2\x0f\x23\x96\x91\x47\x8c\xc7\x85\xa5\x32\x59\x37\x57\x71\x53\xbe\x47\xf6\xca\xa1\x70\xb8\xb0\xb2\xaa\xd0\x05\xaa\xf0\xf1\x28\x5b\x4e\x46\x14\x2e\x8c\xd4\xeb\xf3\x7a\x59\x65\xe7\x28\xb6\x9d\xe7\x49\x10\xf9\x61\xca\x83\x39\x8b\x90\xf9\x61\xce\xd1\xcb\x79\x44\x7e\x98\x22\x4f\xb2\xb8\xf3\x44\xbf\x55\xe2\xc8\x52\xbf\xdb\xea\xcc\x13\x73\x23\x89\x21\x53\x38\x8b\x6d\xce\x02\x4e\x0e\x5a\x13\xb4\x5d\xc2\x50\x8d\x5d\x89\xdb\x46\x5f\x0e\x83\x0b\x1b\xf6\xcb\x17\x0e\x81\x0f\x26\xdc\xf4\xe1\xbe\x76\x11\x22\x72\x5c\xba\xe8\x71\x3a\xb0\xea\xa2\x2f\x6b\x2d\x5d\xa2\x22\x45\x16\xa1\x95\xb4\xb3\x25\x59\x59\x08\x8a\x9b\xd9\x13\xb4\x8d\x10\x54\x63\x2b\xe2\x59\x51\x37\xd5\xfa\xda\x2a\x28\x8e\x6d\x1d\x49\xdb\x0a\xcf\x63\xf0\xc2\x86\xa5\xa1\x0b\xd1\x38\x33\x64\x1f\x32\x45\x58\xbc\xb1\xe3\x41\x47\xee\x11\x54\x8e\xcd\x31\x8c\x02\x11\xbb\x32\xed\xac\x43\x7b\xcd\xd5\x95\x45\x9e\xa1\x6d\x65\x49\xee\xd6\x8a\xdc\xad\x1d\xb9\x3c\x26\x99\x77\xab\x0d\x25\xaa\xb1\x1b\x71\xbb\x45\x14\xd3\xdc\x85\x01\xcb\x79\x2e\xdd\xda\x85\xbc\xcb\xb0\x32\x41\xe1\xc2\x88\xe5\x32\x88\x6e\xed\x42\x7e\x95\x27\x36\x4b\x7b\xba\xb5\x0b\xf9\xf1\x7a\x26\x5b\x16\x06\x0c\x6e\x6c\x58\x16\x1d\xb0\xed\x3d\xb0\xd0\x3d\x35\xeb\xca\x08\x79\xcc\xd6\x81\x1d\xdb\xa5\x30\xd3\xdc\x85\x81\x75\x65\xef\x15\xe3\xd5\x23\x56\xa4\x71\x61\xa8\x2d\x6d\xea\xe0\x82\x39\x71\xb2\xb1\xe3\x10\x9a\x29\x04\x6e\x4c\x38\x84\xe7\xb1\xbd\x1b\x0b\xae\x21\x9a\x43\xe2\xc6\x8c\x43\x98\x1e\xdb\xbb\xb1\xe0\x10\xaa\xc7\xf6\x6e\x2c\x38\x86\x6b\x16\x87\x2b\x2b\x0e\x21\x9b\xc6\xe0\x85\x0d\x97\xb0\x3d\xc5\xe3\xc6\x92\x4b\xe8\xa6\x10\xb8\x31\x61\x1d\xbe\xfb\xd6\x6e\xe4\xed\x43\xf8\xd0\xdc\x92\x01\x12\xf8\xac\xc3\x27\xd5\xdc\x89\x01\xb7\xe0\xc9\xe3\x70\x62\xc5\x3a\x74\x52\xcd\x9d\x18\xb0\x0e\x9c\x54\x73\x27\x06\x9c\xc2\x26\x87\xc2\x91\x11\xeb\xa0\xc9\x20\xf0\xc1\x84\x7d\xc8\x14\xa0\x71\x62\xc8\x3e\x60\xd2\xed\x9d\x58\xb0\x0c\x97\x43\x63\x27\xe2\xb6\xc1\x72\x6c\x6d\x49\x9e\x0e\x30\x4e\xf1\x4a\x88\xc8\x9d\x29\xeb\xc8\xc5\xe3\x70\x67\xc5\x3a\x86\xf1\x38\xdc\x59\x71\x8a\x66\x22\x3c\x3e\x58\xb2\x8e\x6b\x53\x2c\xde\xd8\xb1\x8f\x70\x32\x5c\xee\xac\xd9\xc7\xba\x09\x12\x77\x66\x2c\xa3\x1e\x8b\xc1\x9d\x0d\xdb\xf8\xc7\xa1\xb0\x64\x84\x04\x08\xeb\x50\x43\x35\x77\x62\xc0\x3a\xc0\x50\xcd\x9d\x18\x70\x0a\x2b\x1c\x0a\x47\x46\xac\x83\x09\x83\xc0\x07\x13\xf6\x21\x44\x80\xc6\x89\x21\xfb\xc0\x41\xb7\x77\x62\xc1\x32\x5c\x0c\x8d\x9d\x88\xdb\x06\x89\xb1\xb5\x25\x79\xe2\x59\xd6\xee\x49\x35\x77\x62\xc0\xc9\x3d\x39\x14\x8e\x8c\x58\xbb\x27\x83\xc0\x07\x13\xf6\xee\x29\x40\xe3\xc4\x90\xbd\x7b\xd2\xed\x9d\x58\xb0\x74\xcf\xa1\xb1\x13\x71\x5b\xf7\x1c\x5b\x5b\x92\x1f\x8d\xda\xc9\x43\x04\x68\x3c\x30\x64\xed\x29\x13\x24\xbe\x98\xb1\xf7\x18\x09\x2a\x67\xc6\xec\x3d\x87\xc7\xe1\xcc\x8a\xa5\x07\x31\x08\x9c\x99\xb0\xf5\x24\x16\x83\x35\x1b\x74\xd7\x7a\xb0\x19\x29\x3a\x2f\x0c\xba\xd8\xce\x14\x8f\x17\x96\xac\x6d\x88\x43\xe2\x85\x19\x7b\x5b\xe2\xb1\x38\xb2\xe3\x14\x0a\x19\x14\x7e\x18\x71\x37\x69\x9f\xc6\xec\x6e\xc6\x5e\x0c\xd8\xd1\x74\xdd\x8d\xd6\xd5\x5c\x1d\x0c\xb5\x53\xa0\x7d\x4f\x30\x08\xdc\x98\xb0\xec\x87\xb1\xb5\x1b\x79\xdb\x5e\xa0\x9a\x5b\x32\xd0\xb2\x6e\x29\x7c\xd7\xd4\x81\xb0\xad\xd8\x7d\x5b\x4b\xd2\xb8\xa9\x2d\xed\xa1\xb1\x1d\x71\xcb\x9c\x97\x7d\xae\x0b\xb7\xb4\xac\xe5\xa6\x1a\xbb\x12\xb7\xac\xac\xe5\x31\xb8\xb0\x61\x5f\xb7\xcd\x21\xf0\xc1\x84\x9b\x3e\xdc\x0b\xb5\x85\x88\x1c\x6b\xb4\xf5\x38\x1d\x58\x75\xd1\x97\x83\x96\xba\xdb\x71\x2d\x08\x77\xd7\xd8\x5a\x91\xcd\x0a\x9b\xd8\x94\x15\x96\x41\x89\xbb\x8a\xd3\x4e\xd3\x53\x24\xf6\xcc\x38\xb0\x60\x4d\xd8\xf6\x42\x30\x87\x4b\xc0\xba\xa6\x0e\x91\x89\xc7\xe0\x85\x0d\x4b\xf5\x0b\xd1\xf8\x63\xc8\x35\x3a\x01\x90\xba\x30\x6b\x79\x51\x04\xdd\xda\x85\xfc\x45\x59\x5d\x27\x16\x85\x04\x4c\x73\x2b\x06\x2c\xd3\xf4\xf6\xe9\x79\xd2\xd2\x72\x4a\x43\x35\x76\x25\x6e\xe9\x26\x3c\x06\x17\x36\xec\x03\x07\x87\xc0\x07\x13\x6e\xfa\x70\x8f\x1a\x42\x44\x8e\x41\x43\x8f\xd3\x81\x55\x17\x7d\x59\x6b\xc9\x21\x65\xef\x96\xa6\x1f\x5b\x5b\xba\x2e\x87\xc0\x07\x13\x96\x5d\x20\xc2\xe2\xca\x8e\xbd\x2b\x0b\x90\xf8\x62\xc6\x5d\x3f\xee\x6e\x2d\x45\xe6\xe8\xda\x30\xbc\x96\x2c\x5b\xa6\x5e\x5d\x12\xae\x7d\x5b\x6b\xef\xa2\x9a\xbb\x33\x60\x6d\x39\x3c\x0e\x37\x56\x5c\xbc\x8a\x43\xe1\x87\x11\x57\xbd\xf8\xf0\x27\x21\x2a\x67\x6f\xd2\x63\x75\x62\xd7\x72\xf7\xc0\x7d\xcf\x80\xc5\xe0\xe8\x5d\x14\x12\x5f\xcc\x38\x5a\x14\x8f\xc9\x07\x5b\xee\x5e\xc7\x21\xf2\xc9\x94\x1f\x7d\xf9\xf3\x43\x21\x42\x4f\xde\xa8\xc7\xed\x81\xf5\xfe\x89\x5e\x1f\x7a\xa5\x71\x79\x60\xad\x46\xcb\xb2\x48\x7d\x31\xc7\x62\x73\x62\xcf\x72\x1f\x80\x69\xee\xc4\xc0\x2a\x59\xbe\x4f\x2e\x2d\x97\x01\x1c\x0a\x27\x46\xac\x6e\xba\xa4\x1a\x3b\x11\xaf\x9b\xa4\x59\x3b\x84\x73\xd2\xde\x92\x85\xf1\x6e\xf6\x65\x59\x34\x55\x69\x65\x0f\x13\x24\x56\xcc\x90\x07\x3f\xcc\xe9\x93\x76\x76\x24\x2d\xb7\x7d\x1d\x76\x7b\xbb\xa6\x96\x43\x38\xdd\xda\x99\xbc\x65\x3c\x9a\xa0\x70\x62\xc4\x7e\x98\xe6\x31\x78\x61\xc3\x51\x27\xee\x23\xb2\x18\x93\xe3\x50\x0c\x40\xea\xc2\xec\xf0\x12\x9a\x93\xf2\x18\x2c\x2e\xec\x38\x71\x61\x4d\xbc\x49\x2c\x86\xb1\x26\xb1\x1b\xb8\x2c\x5f\x22\xb1\x7f\x7d\x84\xb4\xb4\x1a\x27\x87\xa6\x56\x84\xad\xea\x42\x6c\x2b\x42\xec\xee\xfa\xb6\xbe\xd7\xdb\xae\xfc\xc3\xba\xee\x63\x7c\xbe\xde\x92\xa8\xfd\xb0\xc3\xbd\x9c\xef\x44\xdf\xda\x43\xb9\x27\xf2\x2d\x79\x70\x1a\x6e\x44\x0f\x6e\xbb\xf2\xe1\x49\x1f\x5e\x06\x1a\xe8\x2b\xd7\x16\x8c\x3a\xe8\xca\x48\x43\xc2\xb7\xf9\x40\x74\x15\xaf\xb4\xe9\xc9\xca\x1f\x3a\x23\xef\xcd\xbd\x7a\x45\x3f\x7a\x37\xbc\x40\xb6\x7a\x8f\xee\xa2\xbf\x9e\xbc\xf9\xf1\xe0\xe4\xa7\xe8\x7f\x1f\xfe\x14\x2d\xb2\x14\x4c\x61\xf8\x45\x44\x62\x04\xf7\x43\x43\x2a\x0b\x0b\x21\xa4\xb6\x13\x75\x5d\x08\x26\x9b\x5d\x27\x97\x48\x4a\x72\xfc\x2a\x20\x77\x9d\x5d\x93\x6f\x60\x62\xec\x0a\x5d\xa9\x55\x09\xa8\x80\x0d\x06\x52\xc2\x4a\x5a\x2e\xf1\x39\x58\xbc\xf8\x62\xae\x28\x44\x1f\x92\xeb\x55\x2e\x14\x5e\x00\x66\xd2\xc5\x12\x9a\xe4\xf6\x29\x00\x55\x1a\xd0\x99\x6e\x77\x6d\x8b\x96\x2c\x03\xe7\x4c\x95\x3a\x80\xac\x27\x3d\x05\x76\xa6\x4f\x32\x2f\x7a\xd2\x0c\x9c\x33\x55\xb2\xe7\xab\xa7\xca\xc0\x39\x53\x1d\x77\xc8\xf4\x94\x27\xb0\x1e\xa8\x13\x27\x84\xd0\x66\x20\xbd\x51\x26\x81\x02\x4e\x9f\x81\x77\xe6\xa2\x5b\x14\xe9\xc9\xb3\x80\xce\x74\xdb\x09\xbc\x9e\x28\x05\xe5\x4c\x11\x4f\x14\xf4\x24\x69\x30\x37\x9a\xf4\x95\x81\x1a\xba\x02\x50\x0f\xb4\x41\xb1\x73\x0a\xe9\x81\x32\x3c\x7e\x4a\xc0\x3d\xf0\x00\x8a\xa1\x53\x48\x0f\x94\x41\x71\x74\x0a\xe9\x81\x32\x38\x96\x8a\xa1\xbd\x70\x00\x8a\xa7\x22\x58\x8f\xd4\x41\x31\x55\xde\xc2\x03\x27\xb0\xb8\x2a\x00\xf5\x40\x1b\x10\x5b\x79\x38\x0f\x54\x21\xf1\x75\x02\xe8\x42\x97\xbe\xd1\x4f\x49\x57\x00\xe8\x4e\x17\x1a\xe2\x64\xd0\xee\x1c\x00\x02\x9c\x00\xd0\x9d\x2e\x20\xbc\x09\x00\xdd\xe9\x02\x83\x9b\x04\xd8\x07\x7d\x40\x68\x13\x82\xfa\xa3\x0d\x08\x6c\x8a\x06\xee\x7c\x40\xc2\x9a\x08\xd2\x9d\xb2\x36\xa8\x4d\xc0\xdc\x69\xea\x43\xda\x14\xce\x85\xaa\xf8\xb6\x3d\x25\x03\xca\x26\x9e\x78\x01\x04\x1a\x19\xb4\x27\x0e\x00\x21\x47\x06\xed\x89\x03\x60\xf0\x51\xb5\xf0\xc6\x09\x20\x0c\xc9\xe1\x3d\x73\x01\x08\x48\xba\x56\x9e\x38\x82\x84\x26\x29\xb8\x27\x1e\xb4\x41\x4a\x0c\xeb\x89\xba\x3e\x5c\x49\x80\x5d\xe8\xd3\xd7\xe4\x29\x69\x0b\x00\xdd\xe9\x02\x02\x83\x00\xd0\x9d\x2e\x30\x1c\x48\x80\x7d\xd0\x07\x04\x01\x21\xa8\x3f\xda\x00\xd7\x57\x34\x70\xe7\x03\xe2\xf0\x22\x48\x77\xca\x5a\x37\x9f\x80\xb9\xd3\xd4\x3b\xf7\x14\xce\x85\x2a\x7d\xbd\x9d\x92\xac\x00\xd0\x9d\x2e\xd0\xbf\x24\xc0\x3e\xe8\x03\xfc\x4b\x08\xea\x8f\x36\xc0\xbf\x14\x0d\xdc\xf9\x80\xf8\x97\x08\xd2\x9d\xb2\xd6\xbf\x26\x60\xee\x34\xf5\xfe\x35\x85\x73\xa1\x2a\xba\x97\x4e\x49\x5e\xd1\xc0\x17\x1f\x00\x9b\x97\x82\xfb\xe5\x01\x60\xfb\x9a\x46\x7e\xf8\x81\xf8\x80\x0c\xda\x0f\x07\x5a\x5f\x10\x82\xfa\xa1\xad\xf7\x09\x31\xac\x1b\x75\xf9\xcd\x72\x1a\x56\xb4\x0d\xfd\xf1\x05\xb3\x0b\x79\x0b\x7f\x9c\x00\xec\x43\x02\xee\x8f\x07\x88\x9d\xc8\xe0\x7d\x70\x01\x8c\x5c\x42\x60\x9f\xf4\x0d\xac\x74\x36\xfb\x34\xb1\x4c\xff\x36\x09\xb6\x46\xcf\x76\x08\xb7\x40\x5f\xb6\xc7\x5e\x0b\xa7\x24\x2d\x04\xf5\x40\x5b\xab\xec\x29\x9c\x07\xaa\x7a\x55\x0b\x00\x5d\xe8\xf6\x37\xc0\x29\x69\x72\x40\xae\xf4\xf4\x42\xf2\x50\x2e\x14\xc7\xdb\xde\x94\x24\x27\x60\x0e\x34\x87\x4b\x8c\x98\xdf\x85\x74\xc5\xa0\x26\xb4\xbb\xd7\xe9\x67\x2c\x7d\x9c\x52\x18\x7e\x11\x91\x18\xc1\xfd\xd0\x90\xca\xc2\x42\x58\x95\x3e\xd2\xc5\xae\x49\xde\xa0\xaa\x48\x9a\xec\x06\xc5\xe4\x4e\x1d\xa1\x02\x27\x50\x26\x62\xaa\xe9\xc5\xb8\xd8\x1f\x48\x95\x1c\x0c\x98\xd2\x9e\x82\xee\x44\x18\xd6\x90\xa5\x2e\xa6\xeb\xb8\x19\x8e\x12\xba\x2b\xa1\x47\x25\xb5\x5d\x09\xac\x0f\xda\x20\xcd\xcb\x54\x0e\xa3\x56\x14\xa5\x3c\x10\x8c\x5f\xad\xf1\xaf\x86\x83\x84\x42\x02\xe3\x67\x6b\x0a\xb8\x04\x35\xcf\x92\x5a\x1c\x59\xfa\xaf\xee\xf8\x15\xe1\x8b\x01\x71\xa3\xa4\xee\x10\x16\x44\xe4\x68\x15\x4a\x76\xa8\x8e\x03\xd3\xed\x0b\xfc\xe5\x64\x87\x23\x00\x4e\xf2\x29\x0e\x12\x00\x48\x0b\x4e\x1f\xf8\xe3\x06\x4a\xdf\x85\xe2\x65\x96\xc6\x15\x4a\xb3\x0a\x2d\x85\xe3\xd4\x04\x48\x40\xed\x12\x4c\x4e\x4a\xc1\x45\x84\x26\xb9\x94\xe2\x6d\x92\x4b\xa9\x51\x36\xc9\xa5\x01\x89\xb8\x4a\x6e\x95\x64\xaa\xe4\x56\x4a\x0a\xa5\x59\x53\x56\x66\x24\x55\x06\xe0\xd6\xed\xa4\xd6\x53\x1e\xa2\xc6\xef\x3e\x68\xa8\x66\x59\x2c\x90\x2b\x35\x4d\xa8\xe2\x80\x84\x9d\xd5\xc2\x18\x87\x2b\x82\x58\x1d\xb0\x58\x18\x57\x49\x8d\x83\x96\xb6\x99\x57\x8e\xe0\x3c\xb8\x51\x5d\xb6\x41\xa9\x89\x8b\xe4\x5a\x45\x92\x82\x92\xf6\x79\x07\xb4\x13\x7d\xb6\x2a\xeb\xac\xed\xf9\xcf\x4c\xd9\xd0\x73\xe0\x26\xac\x3e\x52\x4f\xc0\x1c\x62\x35\xa9\x8a\x5e\x65\x0a\x4a\xd9\x2a\x53\xf8\x50\xb6\xca\x0c\x08\xd5\x85\x92\x52\x5d\x28\x49\xd5\x85\x01\xad\x6b\xd4\x48\xc6\xa1\xe1\xb3\x5b\x4f\x29\x90\xbb\x59\x40\x95\x34\x78\xb3\x41\x36\x10\x71\x40\x0a\x8d\x91\xf1\x08\x4e\x59\x3a\xc4\xf6\x5f\x15\xb4\xa0\x63\xde\x80\x4a\x2d\x9f\x6a\xa8\xa5\x85\x33\x25\xac\x0e\x5b\x4e\xc1\x6a\xdd\x94\x84\xa7\x18\xe5\x68\x29\x1d\xa0\xa6\x60\x1e\x29\xc6\x37\xa5\x64\x68\x92\xc0\xda\xd2\xc6\x37\xfc\x0a\xcf\x60\xe2\xab\x7f\x5d\xb0\xca\x2c\x63\xf8\x68\x8d\xbd\x5c\x17\x4d\x75\x17\xcb\xa6\xa8\xf4\x77\xc9\x1c\x0f\x40\x25\x45\x39\x6a\x50\x1a\xa3\xa2\xc9\x9a\x3b\x11\x1d\x16\xc2\x3a\x7a\xe3\xc1\x46\x26\xcb\xf0\x51\x80\x9e\x0c\x81\x40\x71\x3a\x44\xb2\x88\x47\x7d\x56\x50\x6a\x3f\x43\x69\xa5\x89\x38\x70\x0f\x1f\x25\x74\xa0\xf8\x71\xa6\x4c\x4a\xa0\x2b\x28\x97\x48\x82\xbf\x42\x09\x8d\x35\x70\x52\x6a\x74\x2d\xa9\x84\xe4\x08\x02\xa5\x8b\xcb\x73\xa4\x24\xbb\x2a\x35\x09\x35\xfc\x15\x4a\xa8\x90\xc4\x9a\xe1\xa3\xad\xa3\x8e\x08\x2a\xb4\xcc\x56\x99\x4a\x85\x2c\x98\x80\xe2\xf0\x6d\x67\x6c\x00\xe4\x41\x4a\xd4\x45\x2e\x5c\xdd\x21\xc7\x4c\x8a\x9c\x24\xdd\x83\xbf\x42\x09\x0d\x5b\xe6\x52\x62\xe3\xa6\xba\x8c\xe0\x00\x01\x27\x4a\x6d\x37\x2a\x08\x33\xb7\xe4\x49\x89\xeb\xcf\xad\xcb\x18\xd0\x92\xd6\x10\x85\x92\x23\x5b\x50\x52\x6a\xdd\x0e\x95\x8c\x18\xf9\x0c\xa5\xb5\xae\xe4\x6e\xbd\xae\xe4\x4e\xbd\xae\xc0\x2e\x7d\x5b\x56\xc2\xcc\xef\xf0\x51\x4a\xa4\xfd\x08\xa4\x52\x56\xf1\xb2\xcc\xfb\x59\x88\x6a\x04\x9b\x42\x0a\xe8\x8f\x10\x46\x83\x1a\x8f\x5b\x35\xc2\x89\x60\xb5\x9c\x18\x0c\x7a\x2c\xfe\xf6\x9f\xc9\x79\x59\x25\x4d\x59\xc1\x38\xa2\x5b\x68\xf8\x02\xaf\x09\xa6\x54\xf4\x33\x1b\x5d\x1b\x0d\x6f\xf0\x79\x0f\x4b\x44\x39\xa4\x0b\x40\x75\x2a\x32\x18\xe5\x59\xec\xfa\x21\x5f\x06\xaf\x61\xc9\x78\x16\xc0\xd2\x51\x4e\x09\x04\xa0\x1a\x6e\x4c\x66\x09\x0c\x76\x18\x0b\x0e\xa3\x2b\x87\x48\x35\xd4\x0a\x40\x35\x62\x9b\x8c\xbe\x2c\x76\xed\x50\x2c\x01\xd7\x30\x64\x3a\x3a\xf3\x54\x00\x43\xb5\xa2\x89\x96\x39\xf3\xd1\x5b\x48\xcd\x8c\x35\x10\x53\x56\xec\xa8\x87\x7a\x11\xac\x86\x19\xa3\xd1\x9f\xc5\x2f\x2d\x03\x10\x42\xfa\xf3\x28\xd5\xfc\x60\x0a\xa9\x91\xdf\x6c\xca\xd0\x3f\x41\xa6\x20\x3f\xbc\x52\x26\x9e\xa4\x94\x55\x1b\xba\x08\x08\x9c\x6e\x99\xac\x9b\xab\xb8\x29\xdf\x23\x55\xf8\xa2\xa0\xdc\xb4\xad\xa0\xe1\xd6\x8b\xab\x0a\x5d\xa0\x0a\x15\xca\x88\x38\x02\x39\x52\xab\xd7\xe7\xf5\xb2\xca\xce\x51\x3f\x5b\xea\x26\x02\x0a\xda\xb2\x26\x8a\xde\x34\x9a\x30\xf0\xf8\x4d\x58\xf1\xa5\x0c\xd0\x50\x28\x02\xf7\xc6\x81\xd6\xca\x78\x50\x6f\x94\xc9\xcc\xc2\xc4\x0e\x98\x16\xfe\xcd\x40\x37\x2b\xe2\x19\xf1\xa5\x88\x6e\x70\x30\xd1\x04\xdb\xc4\xbf\x2a\xb4\x63\xdb\x84\x15\x37\x65\xdc\x26\xcd\xf2\x4a\xef\x87\x34\x98\x8f\x7d\x0e\x06\xed\x18\xeb\x54\x82\x4f\x60\xa5\xca\x37\xa5\xcf\x4e\xa6\x34\x23\xba\xac\x89\xc2\x14\xa6\xc0\xb6\x1c\x92\x7b\xe1\xc1\xdc\x75\xd7\xc8\xeb\x39\x23\x80\x10\xae\x48\x75\xa3\xac\x96\x81\xfa\x6c\x6d\x97\x14\x0a\x4d\x8d\xa5\x73\x21\x43\x87\x47\x59\xc7\xc0\xc3\x88\xb4\x79\x83\x93\x9a\x46\x55\x0c\x1d\x5a\x55\x11\x03\x07\xe2\x28\xa4\x61\x09\x83\xbe\x95\x4f\x7e\xc0\x1c\x38\xd1\xd4\xed\xe8\x4f\xa1\xec\xb7\x84\x30\x2a\xd9\xd6\xf7\xf8\xd5\x49\x20\x39\x6a\x27\x35\xa9\xb7\xbd\x79\x18\xb9\x3f\xc0\x83\x31\x46\x29\xd9\xf3\x1e\x3e\xca\x09\xc1\x36\x9e\x47\x44\x4a\xc9\xe4\xfb\xdd\x8c\x58\x86\x54\x95\x36\xee\x62\xd9\x97\xa8\x48\x91\x70\x16\x49\xbe\x38\xe0\xad\x90\x3c\xd0\x53\x9f\x9d\x28\xc8\x71\x5b\x62\xa5\x12\x79\x52\xe6\x79\x18\x5f\xb4\xe4\x77\x13\x8b\x00\x7d\x50\x55\x8e\x5c\x42\x40\x11\xd5\x01\xce\x70\x0c\xa3\x09\xa8\x06\x32\x11\x9c\x0f\xe9\x0d\x87\x34\x60\x53\xef\x9c\x99\xf1\xe2\x4e\x5d\x37\xcc\x49\x40\xad\xc7\x3a\x75\xae\x5d\x99\x59\x37\x15\x4d\x32\x46\xb0\x10\x1a\x13\x87\xc5\x6d\x0e\xa5\x64\xc8\x98\x42\x69\x88\x9b\x0d\x1e\x34\x7a\xbd\x21\x39\x99\x4f\x5d\xc6\xcf\xf6\x9e\x3f\x8f\xf7\x84\x44\x86\xaf\xc2\x44\x62\x0a\x59\xcf\x0c\x38\x9e\x2a\x29\x3c\xf5\x40\xe1\x99\x92\xc2\x33\x17\x0a\x95\xb0\xd4\xaa\xfd\xdd\x5e\xf3\xb7\x12\x9c\xb7\xd6\x38\xa9\xeb\xe0\xc5\xe7\x0a\xc7\xdb\xe2\x5d\x29\xc8\xf2\x06\xec\xdd\xf0\x6e\x54\xa4\x93\x5d\xe6\x26\x78\x37\x1a\xea\x20\x36\xbd\xf7\xdd\x8d\x9a\x34\xe1\xc5\xdc\xf2\xee\x46\x43\xba\xe7\xc6\xdc\xe9\xee\x46\x43\xb9\x9d\x36\xb9\xc1\xdd\x95\x96\x66\x97\x4c\x78\x6b\xba\x17\x9a\x00\x6a\x6e\x74\xe4\x59\x3f\xf6\x2e\x76\x37\x2a\x92\xb2\x15\xea\xe6\x75\x37\xfc\xb2\x3d\x29\xfa\x9e\x75\x07\x0a\xd4\x95\xe9\x32\x2a\xf4\xad\xea\xae\x94\x94\x51\x87\xba\x43\xdd\x95\x8e\x3e\xf2\xf0\x37\xa6\xbb\x52\x54\x46\x1f\xea\x7e\x74\x57\x3a\xca\x08\x44\xdd\x86\xee\x4a\x47\x1b\x85\xb8\xbb\xcf\xdd\xe9\x01\x22\x91\xe0\xae\x71\x4f\x74\x41\x14\x5d\x69\xa9\x23\x12\x7d\x8b\xb9\x2b\x25\x45\x54\x1a\xee\x2c\x77\xa5\xa1\x8a\x4c\xe3\x0d\xe5\xd6\x54\xa8\xeb\xc6\xc5\x54\xe8\xfb\xc8\x1d\xa9\xe8\xc2\xc5\xe4\xf6\x71\x47\x7a\x8a\x60\x41\xdf\x35\xee\x48\x45\x11\x2a\xe8\x9b\xc5\x1d\xa9\x68\x02\x05\x7f\x8f\xb8\x33\x35\x6d\x98\x10\xdd\xdc\xed\x87\x2a\x84\x9e\x23\x25\x55\x88\x60\x6e\x04\x77\xa4\x23\x0d\x10\xe3\xfd\xdf\x8e\x14\xe4\xe1\x81\xba\xed\xdb\x9a\x86\xf0\xc2\x6e\x31\x39\xf1\xdd\xde\x3e\x28\x2b\xdc\x78\x72\x93\xb7\x0f\x7a\x0a\x87\x9e\xdc\xdb\xed\x83\x9e\xc6\xb5\x85\xb7\x74\xfb\xa1\xab\x75\x72\xe9\x6d\xd8\x1e\xe9\x83\x29\xfb\xa0\xa9\x72\xfc\xe9\x7d\xdb\x3e\x28\x4a\x43\x00\x77\xbb\xb6\x0f\x5a\xf2\x60\xc0\xdf\xa5\x6d\x4d\x8d\xba\x18\x5b\x4c\x89\xbe\x39\xdb\x91\x8a\xc2\x11\xe9\x7b\xb2\x1d\xa9\x68\xdc\x8f\xbf\x15\xdb\x99\x9a\xd6\xe9\x44\xf7\x50\xfb\xa1\x0a\xa1\xe7\x48\x49\xe5\x60\xcc\xfd\xd6\x8e\x74\xa4\x6e\x35\xde\x66\xed\x48\x41\xee\x4c\xd4\xdd\xd5\xd6\x34\xa8\x8b\xa8\xc5\x44\xe8\x9b\xaa\x1d\xa9\x68\x2c\x9c\xbf\x97\xda\x99\x9a\xd6\xc2\x45\x37\x41\xfb\xa1\x0a\xa1\xe7\x48\x49\x65\xe1\xcc\x0d\xd3\x8e\x74\xa4\x16\x3e\xde\x27\xed\x48\x41\x6e\xe1\xd4\xed\xd1\xd6\x34\x04\x57\x40\x8b\x89\x89\xee\x8a\xf6\x42\x55\x6b\x87\xb2\x5b\x99\xfd\x51\x87\xd2\xf5\x40\x51\x65\x97\x93\x5b\x9f\x3d\xd0\x93\xda\x27\x7b\xc7\xb3\x07\x4a\x72\x3b\xe5\x6e\x74\x76\xa0\x25\xbd\x8e\x59\x46\x58\x7e\x7f\xb3\x27\x2e\xd4\xfd\x29\xb8\xad\xd9\x13\x5d\x45\xbf\xf2\x77\x33\x7b\xa2\xa8\xea\xdf\xc9\x4d\xcc\xce\x34\x0d\x7a\xd7\x73\xbf\x6a\x62\x02\x4b\xd3\x99\x1a\xc4\x7e\x7c\x59\x8e\xd6\x66\xbc\x58\x8b\xde\x4e\xdc\x2c\x84\xb9\xfa\x58\x4c\x88\xbd\x1d\xd9\x95\x92\x54\x6d\xd4\x5d\xc8\xae\x34\xe4\x4a\xa3\x6f\x3e\xb6\xa6\xd2\x5d\x65\x2c\xa6\xd0\xdf\x73\xec\x84\x5d\x2e\xc0\x70\xab\xb1\x35\xfe\xe1\x92\x62\x31\x81\xf1\x0e\x63\x5b\x0a\x78\xa5\x20\xad\xe6\xa3\x3e\x7b\xa0\x20\x2d\xb4\xe1\x61\x1c\x69\x29\x2b\xf7\x78\x18\x01\x2d\x0c\x62\x58\xaf\xd7\xa1\x55\x95\xea\x71\x20\x8e\x42\x1a\x16\xe8\xe9\x5b\xf9\xe4\x07\xcc\x81\x13\x4d\x5d\x31\xde\x14\xca\xba\x0e\x8f\xa0\x92\xdc\x21\x37\x7c\x94\xdb\x12\xec\x06\xb9\x0e\x91\xe4\x02\xb9\xf1\xab\x82\x0e\xec\xfa\x38\x82\x4a\x56\x42\x3f\x7e\x75\xea\x1f\x39\x6a\xa7\x5e\x57\x97\xd0\xf3\x30\x72\x55\x81\x4b\xe8\x09\x4a\x49\x79\xe4\xf0\x51\x4e\x08\x56\x8f\x38\x22\x52\x4a\x26\x2f\x85\x64\xc4\x32\xa4\xaa\x74\x59\x37\x47\x95\x9f\x67\x56\x1c\x64\x86\xe1\xce\x8a\xf7\x54\x3c\x91\xdf\x5c\x29\x04\x14\x69\x30\x2b\xde\xef\x44\x6c\x84\x32\xe6\x03\xc0\xc0\x4c\x94\xe3\x06\x7d\x68\x14\x23\x80\x0c\x78\x3e\x7e\x64\x66\x35\x05\x73\xb2\x01\xd2\xa1\xc9\x79\x8e\xa0\xc4\xa5\x2d\x04\x7c\x58\x69\x41\x4a\xd9\x45\x4e\xdc\x65\x40\x09\x05\xb0\xbe\x64\xeb\xe6\x0e\x40\x3e\xa6\xb0\x12\x7b\xc3\xdf\x6c\x8d\x4e\xcf\x83\xa5\xe2\xbb\xb7\x17\x94\xee\xcd\xc3\xf8\xa2\xc5\x4e\xd2\x2e\xca\xea\x3a\x11\xc6\x37\x48\x3b\x01\x4f\x5d\x33\xf2\x7d\x47\x8c\xc5\x99\x6b\x12\x61\x5c\x65\x10\x61\xb1\x94\x88\xc5\xe9\x47\x3e\x5b\x81\x7c\xda\x09\x9c\x07\x37\xaa\xd2\x3b\x51\xe9\xef\x8e\x34\xb4\x66\x22\xb5\x00\x13\x2a\x59\x91\xa2\x0f\x0a\x22\xf8\xbb\xd4\xca\xc0\x74\x14\x14\x2c\xf9\x2f\xab\x14\x55\x78\xf0\x52\xc6\x3e\x01\x98\x2a\xf4\x02\x08\x93\x5d\x17\x69\xb6\x80\xfa\x6c\x2b\x1a\x8d\x42\x26\x17\x0f\xe3\x48\x4b\x99\x2d\xe0\x61\x04\xb4\x30\x88\x61\xb6\xa0\x43\xab\x1a\x54\x38\x10\x47\x21\x0d\x83\x97\xbe\x95\x4f\x7e\xc0\x1c\x38\xd1\xd4\x65\x0b\xa6\x50\xd6\xd9\x02\xe9\xae\xb9\xd3\x6e\x39\x69\x2c\x59\x87\x0e\x1f\xe5\x16\x0a\x5b\x11\x8e\x88\x24\xeb\x50\x06\x40\x4e\xcd\x6c\x1d\xda\x21\x55\x1a\x83\x8b\x09\x8c\xfb\x70\xd2\xe8\xc5\x81\x78\xa2\x24\x95\x49\x04\xe7\x81\xa6\x32\x9a\x89\xe0\x04\x34\x07\x30\xc3\xa8\x46\xa1\x57\x45\x36\x01\x98\x07\xc1\x0d\x23\x1c\xac\xa5\x6f\xbe\x8c\x38\x71\xa6\xad\x8b\x78\x62\x48\xeb\xa8\x37\xa2\x93\x25\x16\x59\x08\x67\x01\xd5\x24\x9c\xd5\xa7\x4e\x34\x8a\xe0\xd4\xbe\x04\x4e\x38\x8e\xa8\x25\xc1\x9e\x01\x50\x13\x85\x85\x5f\x16\xa1\x56\x62\x79\xf0\x9f\x88\x0b\xe7\x80\x6c\x90\x2a\xc2\x33\x05\x60\xdf\xb9\x34\x12\xb9\x47\xf2\x50\xce\xf4\x34\x61\x99\x87\x12\x6a\x16\x03\x19\x87\xe4\x0e\xb5\x3a\x20\x73\x40\xce\xe2\x1a\x07\x63\x7d\x3b\xbf\x3c\x19\x70\xe1\x48\xb7\x7b\x6f\x43\x45\xb0\x7f\x92\x43\xd1\xe9\x1d\x88\x11\xdd\x1b\x54\x49\x1e\x6c\xe5\x61\x5c\x65\xd4\x0f\x34\x53\x38\x87\x61\x86\xae\x79\xd1\xc6\x0b\x0a\xcc\x59\x4c\x0a\x95\xce\x88\x78\x58\x4f\xb4\x41\x71\x84\x87\x95\x1b\x16\x01\xb5\x8c\x29\x1d\x19\x48\x64\xe1\x40\x3d\x29\xc3\x32\xca\xe8\x5b\xcf\xc1\x9f\x31\x47\x5e\x78\x00\x7b\xe6\x04\xda\x93\x7f\xca\xa7\x82\x3c\x94\x17\x81\xf5\xa4\xbc\xa8\x75\x55\x65\xd7\x49\x75\x07\xec\x58\x1a\xda\x0f\x7d\xdd\xd4\x54\x0c\xab\x8f\x03\x06\x53\x54\xb6\xbe\x73\x59\x16\xe9\x20\xe2\x7f\x95\x19\x20\x3e\x09\x1a\xe9\x19\x64\x1b\x39\x32\x6a\xcc\xa3\x97\xbe\x93\xce\xe9\x39\x20\xbd\x32\xcc\x66\xd6\x23\x62\x90\xd9\xa8\xe6\xf8\x02\x9b\x31\xe5\x46\x5a\x21\xc2\x00\xb8\x6a\x5c\x17\x7d\x7c\xc4\x9d\x55\xb2\x7c\x9f\x5c\x4a\x97\xa3\x1c\x90\x23\x35\x25\x0d\x37\x39\x34\x56\xe1\xf0\x24\x9d\xfe\x86\x5d\xed\xa5\xba\x46\x74\x34\x1e\xa6\xf4\x2d\x53\x3b\x06\xf8\x93\xd6\x93\x6c\x7d\x68\x5d\xbc\x2f\xca\xdb\x02\xb2\xc4\xe0\x40\xe5\xbc\x80\xc8\x8f\x0f\xcb\x2f\xcb\xa2\xa9\x4a\x89\x23\x4f\xc0\x6c\x3b\xb6\x5e\x56\xd9\x4a\x38\x7f\x21\x5f\xac\xf1\x92\x02\x63\xe9\x1a\x82\xfe\xee\x83\x86\x74\xd4\x99\x00\xb9\x52\x53\xae\x13\x26\x40\x02\x6a\x04\xc6\x70\x65\xd0\x23\x56\x2d\x09\x78\x18\x57\x49\x0d\x17\x01\x80\x66\x5e\x39\x82\xf3\xe0\x46\x55\x37\xd1\x17\x80\x59\xcf\xf0\x3b\x5c\x78\x7f\x19\xa7\x03\xd5\x72\x32\x70\x6e\x62\x2a\xa8\xb8\xa9\x4f\x32\x64\x8c\x5f\x15\x2e\x02\x0b\xda\x14\x2a\xc9\x78\xc1\x42\x28\x08\x9a\x8d\x16\x3d\x5a\x75\x27\xb9\xf4\x8d\x44\x79\x92\xeb\x42\x81\x18\xe3\x0a\xe5\xd2\x08\x46\x7f\x17\xd0\x68\x92\xcb\x3d\xac\x9e\xa7\x10\x5a\x55\xb2\x7c\xaf\xf5\x9f\x29\x94\xb5\xfb\x60\x54\x72\x1a\xb6\x2a\xc3\x8d\x25\xa6\x35\x7c\xb4\xc5\xbe\xae\xf4\xe5\xf6\x3c\x8c\xb5\x82\x24\xc7\x75\x1c\x0e\xea\xc8\x1e\x8c\x76\x79\x1c\x1a\x1f\xbb\x91\x4e\x1d\xc6\xaf\xee\xf8\xa5\xbe\xcb\x81\xb8\x51\x52\x4e\x19\x38\x10\x01\xa5\x16\xc2\x70\xba\x40\x90\xaa\x26\x0b\x2c\x84\x9b\x7c\x86\x13\x05\x6d\x23\x8f\xdc\x40\xe9\xbb\x50\xd4\x79\xef\x04\xc8\xda\x7d\x31\x26\x55\xe1\x3f\x03\x20\x35\x25\x83\x47\xcc\x30\x42\xd9\x5a\x7f\xf8\xe8\xa2\x3e\x29\x62\x97\x2e\x51\x67\xf1\x38\x10\xa9\x9e\xc0\x39\x3b\x8c\x50\x32\x56\xf7\xdf\xa4\x54\x60\x73\x8d\x01\x8d\x4a\x26\xf9\x04\x87\x16\xc8\x8c\xa4\xca\x89\x4c\x5d\xa7\x6e\x03\x58\xdd\x64\xcb\xfa\x49\x5e\x5e\xc6\xc3\x9f\xc2\xfa\x72\x1a\x40\x40\xa4\x48\xae\xd1\x4e\xb4\x4c\x1a\x74\x59\x56\x77\x3b\xd1\x67\x4d\x76\x8d\xea\x26\xb9\x5e\x7d\xa6\xa7\x3e\x22\x96\x5e\x7f\xc8\x81\x08\x38\x48\x13\xe9\x4b\xe0\x22\x52\x6a\x22\x06\x5a\xbc\xcd\xde\x67\x69\xb9\xac\x9f\xf4\xff\x90\xd7\xdb\xb2\x10\xa2\x92\xb2\x36\x4e\xb4\xaa\x24\xb4\x5e\x9e\x1c\x1e\x9c\x1d\x46\xef\x8e\xde\xfc\x9f\x77\x87\xd1\x9b\xa3\x57\x87\x7f\x8f\x92\xaa\xbb\xa4\x3d\x4b\x3f\xb4\x61\x2c\x3a\x3e\x8a\xf0\xf6\x66\xdc\x7e\x49\xaa\xe5\x55\x76\x83\x9e\xf4\x50\xd1\xbb\xd3\x37\x47\x3f\x44\xe7\x4d\x85\x10\x15\xd6\x3a\xc4\x04\xe3\xd8\xba\x45\xd9\xa5\x47\xc4\x68\x87\x5f\x58\xbc\x4c\x4a\x05\xca\x74\xf7\x34\x8f\x25\xd3\x63\xeb\x16\x25\xfe\x4b\x8c\x74\xf8\x85\xc5\x4a\xbd\x0a\xcc\xe0\x4d\xf2\x06\x55\x45\xd2\x64\x37\x28\xee\xcb\xa3\xd3\x0f\x31\xfd\x33\xa5\x21\xda\x3f\xa7\x2d\x59\x8a\x02\x14\x1a\xfa\x7d\x4e\x0b\x33\x40\xae\x18\x25\xe7\x79\x54\xa4\x7b\xee\x58\xda\x74\x6b\x45\x37\x49\x88\x77\x3d\x66\x44\x52\xdc\x6d\x32\x0a\xc3\x20\x49\xf2\x5c\xc6\xd4\xfa\xf6\x3b\x5d\xa2\xcc\x80\x74\xeb\x6e\xc6\xf4\x26\x3e\xaa\xa6\x01\x30\x19\x21\x19\x98\x9d\x90\x45\x8f\x91\x95\xe0\x26\x50\x1b\x51\x51\xd4\x69\x4f\x40\x48\x13\xdf\x56\x63\x36\xb5\x25\x40\x9e\x62\x15\x18\xe0\x08\xc7\xa2\xef\x1b\x88\x44\xe0\x71\xdf\x16\xa8\x82\x23\x6e\xa1\x45\x58\xf1\x1b\x05\x78\x71\x42\xfa\x00\x25\x13\x9c\x03\x08\xaf\xf3\xfe\x81\x7b\x71\xd4\x64\x10\x77\xbb\xab\x26\xb8\x77\xa2\xbc\x5c\x26\x39\xda\x8e\xfe\xf6\x97\xc3\x93\xc3\x68\xb1\xe8\xb7\x68\x2f\xca\x2a\x26\xdf\xa2\xfd\xa8\xa9\xd6\x68\x3b\x3a\x38\x7a\x15\x2d\xba\xdf\xde\x9c\x46\x47\xc7\x67\xd1\xd1\xbb\xb7\x6f\xb7\x41\x0c\xf2\xd1\x5d\xcc\x21\x38\xbc\x93\x26\xc3\xa2\x40\xad\xd7\x1e\xcc\x5c\xb7\xaa\x55\x8f\x52\x18\x45\x43\x0b\xf1\x24\xd4\x85\xbe\x65\x46\x5e\x00\x6e\xa6\x13\xb8\x16\xc0\x72\x33\x6b\x2f\x2c\x27\xba\x15\x39\x38\x0f\xcb\x45\x11\xdc\x48\x23\x8c\x82\x7b\x30\xbb\xaa\x9e\x80\x0d\x0a\x2d\x96\x76\x2d\xd0\x62\x6a\x92\x4b\xa1\xa0\xed\xef\x0c\xb2\x61\x51\x20\xc6\xd5\xae\x2b\x94\x5e\xd1\x01\x69\x7c\x42\x82\x95\xac\x4c\xe0\x78\xe9\xa5\x99\x1a\xb3\x42\xfe\x29\x5a\x5e\x07\xd3\x0e\xd6\xda\x28\xdc\x32\xc9\xb3\x0f\x54\x18\x6f\x7f\x98\xe2\x1c\xc1\x44\xc3\xa7\x72\x0a\xcc\x12\x90\x86\x73\x35\x85\xd9\x43\x3a\xc5\xa6\x5a\xbb\x1c\xa0\x89\x96\xb9\xc0\xae\xd2\xb4\x2c\xb8\xc3\xb4\x6d\x15\xe0\xb5\x4d\xad\x44\x35\x0a\xf2\x86\x2c\x08\x1b\x98\x6a\xc7\x44\x1f\x06\x1a\xe8\x6e\xa1\x68\xe5\xd4\x77\x37\x05\xac\xed\x70\x86\x0a\x78\x50\x99\x40\x6b\x87\x15\x86\x8e\x3c\xe6\x62\xa9\x60\xd1\x76\xc0\x74\x8e\x2e\xb3\x02\x8c\x6f\x84\x56\x63\x45\x45\x0a\xc6\xd9\xc3\x6a\x8d\x45\x69\x1d\x06\xe6\x80\x57\x7b\xe5\x2d\xaa\x14\x96\xcf\xad\xed\x5a\xe8\x05\x19\x5d\x5f\xbc\x68\xd0\x87\x66\x5b\x8d\x1e\x8a\x58\x9b\x68\x19\x10\xae\xf3\x3c\x5e\x96\xd7\xd7\x5d\x96\x01\x84\xb8\x8f\xcf\x7d\xbb\x36\xfe\xb6\xb1\x57\xc9\x7a\x5d\x56\x0d\x98\xff\x01\x18\x24\xc4\xba\xc8\x7e\x25\x5e\x68\x28\xc9\x4e\xd4\x35\x10\x8a\xd4\x0f\x29\x52\xb1\xa8\xcc\xae\xd6\xfd\x47\x58\x33\xef\xe7\x68\xc8\xa6\x2f\x4a\x1a\x8a\x39\x0c\x6e\xa7\x9c\xc1\xf5\x10\xb0\x39\xdc\x80\x0f\xc4\xb0\xe9\x8c\x6b\x82\x5d\xc9\xb1\xf1\xac\x8b\x34\xd4\x0f\x18\xfa\x61\x82\x41\x4c\xae\x53\x68\x51\xa6\x59\xbd\x9c\x62\xc5\xdf\x59\x7c\x04\x50\x94\xd1\x1c\x70\x5d\x54\x08\xa5\xe7\x82\x81\x40\x80\x6e\x80\x95\x62\x6c\x15\xaa\xe0\x6e\xaa\x4a\x01\x87\x02\xa1\x5b\xb4\x2d\x37\x30\x9c\x24\xf7\x82\xeb\xdb\x76\xa2\x1c\x25\x69\xb9\x6e\xe2\xf2\xe2\xa2\x46\xcd\x4e\xb7\xff\x4d\xfe\x52\x8a\x41\x03\x1a\x11\x96\xe2\xc6\x03\x77\xbf\x62\xe2\x51\x0e\x1f\x41\x23\x64\x07\xdd\x87\x2e\x09\x32\xd1\x80\x2b\x09\x13\x1c\xc2\xae\xfc\x12\x8c\x97\x80\xcb\xf0\xa6\x49\x43\x56\x89\xc3\x0d\x13\x42\xc4\x2d\x58\x87\xf6\x32\x2b\x5a\x66\xab\xe4\x2e\xae\xd0\x75\x79\x83\x16\x07\x27\x27\x07\x3f\xfd\xbc\x58\x60\xa0\xff\xe7\xfb\xef\xa3\xad\x7f\x8d\x57\x05\x65\xe9\xbf\xb7\xc8\xc0\xf7\xf3\x2f\xdb\xdb\x2f\x5e\x64\x45\x83\x2e\x51\xb5\x13\x4d\xe0\x77\x4c\x1b\x95\x79\x6a\xdc\xa6\x40\xb7\xc6\x6d\xfa\x1a\x96\xfa\x2a\x5b\xe9\x1b\xff\xb2\x83\xc7\x94\xe1\x6f\xd1\x78\x8f\x75\xda\xbd\x53\x25\x31\x11\xb2\x2b\x00\x4a\xfb\x63\xf8\xb6\x0f\x97\x79\x59\xa3\xb8\xc9\xa6\x23\x30\x4e\xa9\x76\xc8\xaa\xb6\x03\x47\x50\x15\x42\x12\xa8\xe3\x2c\x8d\x53\x54\x4f\x7c\x9c\x46\x4a\x07\xf6\x9d\x28\x4b\xa3\x57\x87\xa7\x2f\x01\xa8\xcb\x15\x2a\xb4\x1c\xb3\xc8\x87\x26\x4a\xf4\x1f\x56\x59\x05\xd4\x05\x05\xab\x42\x09\x62\x15\x23\x04\x71\xd8\x55\x51\x4f\xc3\xf1\x54\x72\x02\xda\xaa\x75\x98\xc5\x74\x41\xe0\xbb\xef\xa3\xa7\x2a\x22\xdd\x50\xa7\x27\x41\xae\xf5\x12\xef\x56\x61\x64\xd4\xbb\x4c\x12\x8b\x1d\x41\x58\xd4\xe3\xef\x32\xe4\xdd\x9d\x99\x12\xbc\xf8\x2b\xbf\x63\x72\x8e\x72\x2d\x36\x55\xa4\x14\x20\x55\x07\xca\xa2\xec\x56\xfc\xa2\xdd\x89\x01\x62\x6a\xb1\x20\x7c\xd3\xe9\x93\x02\xa3\x70\xe6\xc4\xe2\x5c\x95\xed\x1c\x46\x62\xaa\x0c\x5a\x6c\xaf\x03\x38\x1c\xb1\x89\x1a\x46\x6e\xda\xa0\x80\xa3\xe3\x69\xf4\xf6\xe0\xf4\x8c\x54\x11\x28\x43\x05\xa6\x5c\xa1\x65\xb6\xca\x3a\xdb\x1b\xff\x92\x92\xa7\x40\xb8\x1d\xa9\xee\x67\x19\x35\x72\x85\x89\xcc\x10\xf1\x57\x4e\xb4\xf6\x27\x19\xb6\xf1\x28\xbe\x0c\xe3\x00\x31\xe1\x93\xfc\x2c\xc7\x4c\x1f\x02\x92\x63\xa7\xa0\x84\x7b\x73\xe4\x93\x8e\x8a\x06\x3f\x74\xd7\x0f\xb7\xe9\x0a\x59\x65\x28\xc9\x67\xce\x2f\xf1\x6f\x32\x84\xeb\x4a\x1e\x39\xd6\x15\xe7\xe2\xeb\x4a\x1a\x35\x70\x11\x8a\x0c\x51\xfb\x91\xc5\xd4\xfe\x22\x41\x55\x56\xf1\xb2\xcc\x73\xb4\x1c\xb6\xea\xe4\x2e\xce\xc0\x6a\x5d\x9d\x99\x7e\x8b\x49\x09\x96\x34\x1a\x3a\x92\x12\x05\x21\x76\xd1\xe2\x5e\x83\x5e\xbd\xc4\x9f\x92\x51\x2d\xce\xc4\xd0\x06\xeb\xb4\x61\x3e\xa3\x12\xc5\x26\x81\xd3\x21\x1e\x2a\x01\xe8\xb2\x00\x89\x14\xc3\x67\x61\x2d\x80\x56\x06\xb2\x53\xdc\x94\xef\x11\xe9\x9a\x64\xb9\x44\x75\x4d\x7e\x90\x50\xa4\x9a\x70\x4b\x0f\xaa\xad\x5c\x38\x9e\xa2\xd2\xaa\xa5\xb4\x80\x66\xcd\x13\xab\xd0\x45\x85\xea\x2b\x3b\xf9\x98\xc6\x5a\xd2\xab\x0a\x5d\xa0\x0a\x15\x4b\x7a\x7c\x56\x99\xfe\xd8\x40\x3c\x61\x95\x6c\xad\x75\xad\xeb\xf5\x79\xbd\xac\xb2\x73\x14\x33\x59\x5a\x51\xa2\x49\xd2\xc2\x6c\x77\x41\x45\x76\x5d\x64\xbf\x5a\x11\xed\x45\x55\x2e\x62\x19\x2c\x5c\x64\xa1\xc2\x87\x8e\xbe\x2c\xd2\x8c\xbf\x1b\x08\xcf\xb1\x01\x52\x80\x3a\x62\xef\x44\x56\x8c\x50\xa1\x09\xc4\x84\x28\x56\xf5\x0c\x0c\x50\x69\xac\x9c\x30\x32\x08\xc7\x69\x33\x99\x1b\xeb\x18\x00\x4d\xcb\xd5\x32\x8f\x24\x41\x22\x0b\x28\xf6\x12\xab\x16\x04\x0c\x8e\x71\xfe\xd1\xcf\x35\x74\x54\x81\x53\x12\xb5\xa4\x14\x59\x90\xa8\x22\xa2\x43\xef\x4a\xe7\x43\xa4\x5a\x70\xd8\xa4\x1d\x0a\x0b\x19\x52\x23\x90\x2e\xbb\xc0\x8a\xc4\x21\x97\xec\x00\xab\xd1\xcf\xbc\xff\x4b\xf3\xa8\x9c\x47\x70\x70\x06\x35\x9b\xcc\xe6\xaf\x4a\xc1\xe2\xad\x5f\x98\x92\x2d\x36\x7e\xf5\x2d\x6d\x84\x34\xd8\xf6\x35\x65\x40\x04\x6f\xa8\x18\x03\x55\xc0\x85\x07\xee\xc5\x4e\x81\xcd\x2a\x7c\x86\xe4\x9f\x54\x02\x38\xcb\xca\x2e\x01\x55\xf9\x74\xaf\xa2\x43\x36\xa2\x78\x50\x68\x7e\x42\x48\x41\xee\x3f\x52\x02\xb2\x1c\x28\x6e\xa5\xd8\xe6\x1a\x00\x20\xbb\x5c\x23\x36\x88\x32\xcc\xf6\xb8\xa6\xb8\x55\xdc\x1a\xee\x70\x75\xed\xb4\xde\x61\xb8\x6e\xba\x44\x45\x8a\x2a\x19\x4a\xf2\x15\x62\xaf\x97\xa8\xa8\x10\x35\x88\xe0\xbf\x05\xe8\x7a\x20\x0e\x67\xfb\x41\xc9\x24\x83\x5c\x32\x42\xa9\xd1\xcf\x3c\x42\x11\xe2\x72\x3d\x56\x86\xbd\x52\xc9\x03\xb2\x00\x1b\x6c\x2d\x4b\xa5\x7a\x47\x65\x52\xc9\x5d\x8e\x0e\x0f\x0e\x4a\xfe\x32\x72\x08\x09\x4a\x7a\x0f\x4a\x6d\xe6\x7e\x9c\xb0\xac\x72\x39\x21\x30\xc4\x5d\xe8\x86\xcc\xc4\x03\xd6\x1b\xe2\x29\x88\x69\x8f\x58\x4c\x46\x80\xcd\xad\x55\x60\x30\x2d\xb1\x62\x45\xda\xc8\x46\x63\xa6\x3a\x32\xd4\x0a\x70\xbe\x22\x69\x61\x36\x69\x61\x37\x81\xd4\x52\x19\x8a\xa1\xef\x3f\xd0\x44\x86\xc2\xa8\x98\x0b\xb0\x50\x90\x09\x01\x87\x57\x3d\x2b\x98\x02\x03\xa7\x06\x12\x2a\x20\x6f\x17\x52\x52\x6e\xbd\x49\xa8\x69\xf5\x65\x38\x25\xa1\x1b\xc3\xfc\x01\xec\x05\x75\x19\x3f\xdb\x7b\xfe\x3c\xde\x93\x56\x36\x8e\x20\xa0\xda\x8d\x01\xfc\xa9\x1e\xe3\x53\x33\x8c\xcf\xf4\x18\x9f\x01\x31\x56\xa4\x3c\xa8\xfd\xc7\x14\x57\xc5\x15\x06\xb5\xbf\x28\x3a\x87\x46\x46\x6d\x47\x81\xd0\xee\x44\xaa\x8d\xaa\x01\xb5\x01\x56\x31\x3e\x8e\xe3\xdb\x9e\xe3\x5b\x81\xf8\xb7\x13\x3e\x6f\x97\x3b\x91\x64\xb7\x66\xc0\x85\xf7\x77\xb4\xb8\x24\x58\x72\x52\x38\x34\x9c\xb7\x40\x45\x93\x35\x77\x7b\x3c\x3e\x0a\x8c\xaf\x62\xc5\xf0\x72\x91\x79\x02\xa2\x0c\x92\x06\xfb\xee\x4e\xd4\x91\xd9\x89\xc8\xb3\x71\xb8\x8e\x05\x5f\xfa\xa3\x12\x89\x2a\xcb\x55\x0b\x25\x2a\xce\x85\x8a\xa5\x4c\x3f\x6b\x29\xd8\x89\x36\xae\x9c\xd5\x92\x89\xca\x70\x80\x82\x8d\x24\x14\x72\x49\xf1\xdb\x89\xc5\x8d\xa8\x6a\xd9\x64\x63\x2b\x54\x40\x8e\x98\x42\x4a\x35\x25\x3b\x51\xc7\xe4\xb1\x5a\x4a\x51\xd6\x18\x28\xa0\x3a\x3f\xad\xc3\x6f\x27\xd6\x50\x82\xa0\x11\x4b\x50\x8c\x00\x15\x6b\x24\xa1\x10\x4b\x8a\xdf\x4e\x2c\xa6\x16\x42\x23\x9a\xa4\x2a\x02\x2a\x1e\x4b\x4a\x21\xa2\x92\x8e\xad\x98\x5c\x61\x86\x56\x54\x69\x89\x06\x5c\x5c\x9e\xa4\x52\x64\x0d\x3d\x37\xb1\xc1\x02\xbb\x89\x0a\x13\xd2\x9f\x78\xd4\xee\x8d\x5a\x3a\xe1\xb6\x0d\x50\x38\xcd\x16\x91\x96\x82\x9d\x68\x5d\xf9\x8c\x46\xae\x49\x21\x0d\x54\xa8\x1e\xbd\x42\x22\x09\x6e\x3b\x71\xfa\x2a\x1e\x8d\x3c\xd3\x7a\x1e\xa8\x40\x03\x01\x85\x44\x32\xec\x16\x22\x91\xd3\x6b\xa0\x69\x18\x05\x6a\x21\x1a\x4f\x48\x26\x9e\x86\x8a\xad\x88\xa0\xe9\xd8\x08\x69\x2b\xa0\x7e\x4a\xa6\xa6\x61\x2b\x1e\x7c\x5a\xc6\x81\xdb\x0a\x0a\x9c\x9a\x01\xa8\xd9\x8a\x0c\x9a\x9e\x8d\x90\xb6\x82\xea\xa7\x68\x6a\x1a\xb6\xe2\x81\xa6\x69\x23\xa4\xad\x78\xfa\xa9\x9a\x9a\x86\xad\x78\xe0\xe9\x1a\x0b\x6d\x2b\x26\x6c\xca\xa6\xa7\x65\x2f\xae\xc1\xb4\x6d\xda\xc2\x5e\x6c\xe8\xd4\x0d\x46\xd3\x55\x7c\x03\xc1\x5d\x45\x86\x0a\xeb\x53\x4c\xd8\x34\x8e\x02\xb5\x15\x12\x30\x95\xd3\x50\xb1\x15\x11\x30\x9d\xeb\xe1\x6c\x85\xd3\x4d\xe9\x54\xf8\x6d\xc5\x82\x4c\xeb\x06\x40\x5b\xc1\xb4\x53\x3b\x25\x05\x63\xd1\xc8\x5c\x04\x30\xf1\xa1\x00\x8d\x45\xe3\x89\x88\x45\xd3\x50\xb0\x14\x0d\x3a\xe9\xe1\xa1\x2d\x85\x04\x4d\x79\x20\xb4\x2c\xc5\x05\x4c\x78\x28\x40\x4b\x21\x75\xd3\x1d\x0d\x05\x4b\xd1\x00\x93\x1d\x0a\xd0\x52\x34\xdd\x54\x47\x43\xc1\x52\x34\xe0\x44\x87\x03\xb6\x14\x11\x32\xcd\x01\x50\xb2\x16\x15\x3c\xc9\x11\x34\xb0\x16\x19\x36\xc5\x01\x52\x74\x14\x1d\x2e\xb4\xa3\xb8\x40\x41\x3d\x8a\x08\x99\xdc\xd0\x90\x96\x02\x6a\xa7\x36\x3a\x1a\x96\xe2\x69\x27\x36\x03\x98\xa5\x60\xea\x69\x8d\x12\xbb\xa5\x48\xfa\x49\xcd\x08\x67\x29\x94\x66\x4a\xa3\xc6\x6f\x2c\x16\x3d\x02\x03\xc7\x7e\x61\x13\x63\x61\xe5\x84\xc5\x72\x83\xa9\xba\xa8\x00\x30\x1f\xe0\xa1\x5d\x04\xd7\xcd\x0c\x20\xb4\x5c\xc4\x05\xcc\x11\x78\x68\x17\x71\x75\xb3\x05\x08\x2d\x17\x71\x81\xf3\x06\x51\x0b\x17\xb1\x21\x33\x08\x28\x4d\x37\xf1\xc1\x73\x09\x59\x2b\x37\x35\xc0\x66\x15\x26\xb4\x7d\xa8\xc3\x50\x11\x3e\x54\x60\x22\xbc\x6f\xb1\x21\x73\x8e\x09\xb8\x8b\xd0\xda\xd9\x07\x88\x9a\x8b\xc8\xda\x79\x08\x0b\xeb\x22\xac\x7a\x46\xa2\xa7\xe3\x22\xa6\x7e\x6e\xc2\x01\xbb\x08\xaa\x99\xa5\x00\x28\x19\x8b\x4a\x46\x4b\xc0\x10\x4d\x01\x1a\x8b\xc8\x13\x11\x8b\xa7\xa1\x60\x29\x1a\x60\x38\xa6\x00\x2d\x45\xd3\x0d\xc2\x1a\x0a\x96\xa2\x01\x87\x5e\x0e\xd8\x52\x44\xc8\x80\x0b\xa0\x64\x2d\x2a\x78\x98\x15\x34\xb0\x16\x19\x36\xb8\x02\x29\x3a\x8a\x0e\x17\xda\x51\x5c\xa0\xa0\x1e\x45\x84\x0c\x9f\x34\xa4\xa5\x80\xda\x41\x53\x47\xc3\x52\x3c\xed\x50\x39\x80\x59\x0a\xa6\x1e\x20\x95\xd8\x2d\x45\xd2\x0f\x8b\x23\x9c\xa5\x50\x9a\xc1\x50\x8d\xdf\x58\x2c\x12\xc1\x01\x83\x05\x05\x68\x2c\x18\x4f\x44\x2c\x99\x86\x82\xa5\x68\xc0\xc1\x82\x03\xb6\x14\x11\x32\x58\x00\x28\x59\x8b\x0a\x1e\x2c\x04\x0d\xac\x45\x86\x0d\x16\x40\x8a\x8e\xa2\xc3\x85\x76\x14\x17\x28\xa8\x47\x11\x21\x83\x05\x0d\x69\x29\xa0\x76\xb0\xd0\xd1\xb0\x14\x4f\x3b\x58\x0c\x60\x96\x82\xa9\x07\x0b\x25\x76\x4b\x91\xf4\x83\xc5\x08\x67\x29\x94\x66\xb0\x50\xe3\x37\x16\x6b\x0c\x6f\xc0\xa8\x2a\x68\x60\x2c\xa8\x8c\xa8\x58\x62\x20\x45\x27\xd1\xc1\x51\x56\xd2\xc8\x49\x05\xb0\x68\x6b\x40\xd9\x83\x2a\xcc\x94\xe0\x41\x7c\x03\xc1\x3d\x8b\x0c\x89\xc2\x3c\xb4\x83\xc0\xda\x68\x0c\xa1\xe5\x20\xae\x36\x2a\x33\xa0\x0e\x82\xaa\xa3\xb3\x96\x8a\x83\x88\xfa\x28\xcd\xc2\x3a\x08\xa9\x89\xd6\x7a\x3a\x16\x62\xd2\xf1\xc2\x28\x74\x49\x1b\x5a\x28\x40\xcd\x84\x4c\x1b\x46\x1c\x38\xaa\x06\xe6\xd7\xd3\x16\x8e\xca\x00\xf8\x37\x8c\xa6\xa3\xf8\x00\x3f\xe7\xc0\x1d\x05\xd7\xf9\x3b\x80\x9a\xa3\xc8\x10\xbf\xe7\xe1\x1d\x85\xd6\xfa\x3f\x84\x9e\xb5\xd8\x16\xde\xef\xc7\xef\x4d\x3d\x7e\x3e\x5f\x07\x4e\x58\x18\x60\x67\xb1\xc1\x02\x7b\x15\xd5\x24\xa0\x39\x86\x32\x70\x10\x9b\x21\x7c\x81\x03\x97\x4b\xc8\x82\x05\x2b\xdf\x61\x0a\x1e\xa0\x9c\x42\x13\x30\x28\x79\x0b\x47\x9d\xb5\x40\xec\x93\x01\x35\x16\x70\x4a\x48\x2c\xa1\x96\x8a\xad\x88\x5a\xdb\x1c\xe1\x6c\x85\x53\x5b\xa6\x1a\xbf\xad\x58\x7a\xbb\xa4\x00\x6d\x05\xd3\x58\xa5\x86\x82\xb1\x68\xad\x1e\xb5\xdd\xd5\x01\x19\x8b\x44\x23\x17\x8b\xa3\xc0\x6c\x25\x8a\xbe\x8b\x7a\x28\x2b\x61\x34\x9d\xa3\xc2\x6d\x2c\x0e\xa6\xa5\x97\x67\x00\x33\x16\x88\x25\x20\x96\x48\x89\xdd\x54\x24\xbc\x81\x33\xde\x73\x27\xbc\x6c\x99\x02\x62\x49\x6a\x6e\x58\xe6\x91\x4b\x2e\xd1\x53\xa3\x9f\xf9\xea\x3c\x9a\x47\xd5\x85\x50\x3c\x1c\xcb\xa9\xf8\x46\xa8\xae\x0d\x73\x57\x9e\x4a\xc1\xe2\x1b\xf2\x60\x4a\xb6\xb8\x17\x4f\xdf\xd2\x46\x48\x83\xdb\xf0\x4c\x19\x10\xc1\x1b\x2a\xc6\x40\x15\x70\xe1\x81\x97\xde\x4d\x81\x59\x12\xd2\xf7\x52\xc7\x9a\x1d\xd1\x85\x5d\x82\x5a\x1d\xf5\x2b\xa4\x23\x3a\x99\x26\xe0\xa2\x67\xca\x27\x48\x45\x97\xb2\x83\x2e\xfd\x1c\x91\x03\xd1\xaa\xdf\xf5\xa0\xd0\x29\x9e\x1f\x95\xa1\x85\xbc\x3e\x2a\x21\xa7\x7d\x28\x54\x42\xd3\xf6\x9d\xd0\xae\x36\x04\x72\x73\x32\x0f\xca\x0d\x26\xb2\x4b\x01\x85\x14\x14\x41\x4d\x46\x40\x76\x45\x3e\x69\xa5\xb8\x2d\x71\x00\x60\xf1\x89\x2f\x4a\x1c\xb1\x41\x94\x61\x76\x3d\xe2\x14\xb7\x8a\x5b\xc3\x6b\x0a\xbb\x76\xda\x90\x65\x78\x73\x32\xf3\x22\x4c\x56\x97\xf1\xb2\x4c\x51\x3c\x9d\xc1\x08\x1f\x83\x19\xe1\x4d\x09\x3c\x3d\x37\xa4\xf0\xf4\xdc\x98\x84\xc0\xb5\xd4\x24\x14\x17\xce\x8a\x49\x3c\x33\xa4\xf0\x4c\x41\xa0\x9d\x93\x01\x87\xa6\x29\x28\x28\x3c\xb7\xcd\xb2\xfe\xcd\xbd\xb6\xbd\x08\x31\xe7\x93\xc3\x63\x92\xd1\x48\x10\xbf\x8e\xaa\x91\x44\xcb\x3f\x94\xeb\x6b\x94\x66\xeb\x6b\x6e\xca\x44\x7e\xe4\xf1\xf2\xa0\x2c\x7a\xf2\x55\xce\xf6\x84\x10\x7c\xda\x04\x68\x6a\x25\xaa\xc1\xc4\xc9\x98\x05\x61\x03\x53\xed\x98\xe8\xc3\x40\x03\xe3\x0b\xc3\xe4\x21\x61\x31\x7a\xc1\x23\xc3\xf8\x27\x08\x5e\xa5\x01\x09\x10\x03\x8d\x67\x24\x20\x5a\xa7\xe9\xd0\xef\x44\x53\xfe\x45\x54\x2e\xca\xea\x3a\x91\xde\x70\xcc\x00\x19\x28\x3d\xc3\x37\xa1\x92\x64\xda\xaa\xac\x33\xd1\x53\x47\x9d\xda\x18\xa4\x5d\x9b\x9d\xe8\xb3\xbe\xd5\x67\x6a\x22\xd4\x13\xcb\x10\xfc\x14\xb8\x02\x6f\x91\xa2\x0f\xb1\xe0\x75\x3c\xfa\x7b\x87\xf7\x32\xab\x9b\x68\xa1\x54\x74\x99\xa7\xf4\xb3\x5a\x52\xaf\xe3\xe0\x6c\xe6\xb5\xa4\x04\x86\x5a\x11\xe3\xfa\x42\x8e\x0e\x05\xc4\xd2\x98\x3e\xef\xc8\xc8\x31\x41\x2e\x5e\x6e\xab\xd1\xcf\xbc\xdc\xa6\x79\x54\x05\x15\x1e\x0e\x62\xda\x5d\x1b\x66\xec\x50\x29\x58\x3c\x72\xc0\x94\x6c\x31\x6e\xe8\x5b\xda\x08\x69\x30\x6a\x98\x32\x20\x82\x37\x54\x8c\x81\x2a\xe0\xc2\x03\x97\xdb\x53\x60\xe0\x72\x7b\x2c\x0d\x16\x2d\xb7\x05\x25\xc1\x92\xab\xb1\x47\x3c\x97\xa8\x54\xa1\x21\x31\x6a\xb5\x3e\xcf\xb3\xe5\x93\x3c\x8f\x9b\x32\x46\x49\xd5\x5c\x2d\x96\x25\x2e\xc1\x48\x1a\x54\xff\xbc\xfb\x4b\xbb\x28\x1c\xff\xde\xfb\x65\x9b\x5a\x1c\x0e\xbf\x4b\x17\x88\x82\xce\x52\xf5\x0e\xbc\x3b\x94\xe6\xa6\x59\xa5\xd3\x78\x14\x6b\xbe\x01\x00\xb2\xe6\x1b\xb1\xa9\xd7\x7c\x0c\x1c\x70\xcd\x37\xc5\xad\xe2\xd6\x70\xcd\xd7\xb5\xd3\xfa\x8d\xe1\x9a\x6f\x2c\xe4\xd1\x0e\x0d\x1c\x28\x3f\xf4\x77\x1f\xfd\x0f\x11\x72\x46\xa5\xb7\xb5\xc3\x58\x85\x6b\x45\xa5\x76\x11\x2c\xc4\x3f\xa8\x76\xcc\xa0\x04\x11\x4a\x3c\x38\x99\x09\x66\x31\x48\xc1\x5a\xdb\x0a\x6f\x30\x58\xd9\x30\x22\x6b\x63\xa1\x2c\x43\xf5\x98\x29\x04\x38\x80\x89\x1b\x00\x07\x31\xb6\x3c\xb9\xbb\x2d\x6c\x59\x89\xde\x49\x97\xd4\x26\x33\x6d\x20\x2a\xd4\xe9\xcc\x4c\x49\x5a\xc3\x00\x0d\x2d\x54\x05\x2e\x24\x29\x2a\x02\x07\x0e\x0d\x63\x53\xc5\x40\xc6\x00\x41\x06\x33\x16\x2b\x94\x79\xb3\x41\x4d\x4c\x43\xc7\xbd\x7e\x70\x53\x62\x6f\xd7\x79\x86\xf8\x41\xc1\x8f\x2c\x69\x01\x23\x1d\x05\x28\x59\xe2\xce\x35\xca\xf1\x2c\xf6\x05\x57\xc6\x2c\xaa\xb0\x73\x03\x8e\x9a\x82\x6c\xb0\x99\x52\x11\x6b\xdb\x6a\xa0\xd1\xb7\x85\x05\x0c\x20\x0f\xe2\x58\x62\xca\x84\xb8\x85\xb1\x8a\x8c\x94\x62\xa2\x06\x9c\x39\xa9\xee\x48\x3a\x8d\xfc\x5b\x46\xa4\xff\xcc\xe6\xd4\xc8\x8f\x0a\x0a\xe0\xc1\x6b\x0a\xae\x1d\xba\x84\xaa\x23\x85\x9a\x60\x8f\xa6\xc0\xc5\x5d\x87\x01\xe6\xf6\x6e\x9e\x69\xb6\x8a\xd4\x89\x75\x33\x8d\x41\xcc\x8d\x87\x37\x31\xb8\xae\xad\x28\xdc\x80\x84\x55\x86\x1e\x43\x81\x1d\xc2\x90\x1e\x83\x8b\x52\x2c\x42\x92\x29\x43\xaa\x76\x96\x0a\xb4\x50\x99\xb9\x92\x4c\xc3\xc9\xa4\x11\x78\x3e\xcc\x97\x7d\x6b\xe6\xc4\xd2\xaa\x6f\xf0\xbc\x98\x27\xa8\xd3\xa5\xb9\xf2\x40\x46\x64\x52\x28\xc1\xa2\xef\x43\x22\xdc\x1a\xe8\x16\xe6\xe2\x00\xa7\xe8\xe2\x26\xe0\xc9\xae\x82\xa2\x41\xe0\x92\x11\xb6\x8a\x5c\x35\x5a\x96\x45\x6a\xa6\x6a\xb6\x8d\xb9\xb2\x95\xab\x14\x0e\x10\x36\xcf\xe7\xb1\x9b\xf4\xa3\xe9\x8a\x45\x46\x0b\x22\x0d\x7c\xe5\x32\x1e\x99\x80\x04\x0b\xa7\x30\xa1\xee\x73\x93\xee\xd5\x04\x05\xe0\xb2\x79\xc4\x05\x71\x0a\xa8\x0f\xb0\xd8\xa9\x12\x32\x51\x01\x11\x03\x06\xab\x1e\x9a\x62\xd6\x2c\x7d\x04\xb8\xc1\xcb\x9e\x55\xb2\x7c\x9f\x5c\x2a\x33\x1f\x1c\xa0\x49\x37\x8e\xb5\x55\x75\x13\x5f\x97\x69\x76\x91\x21\x29\x05\x41\x79\x15\xd5\x0a\x42\xa5\x2c\xdf\xaf\x57\xe2\x7d\x5a\x39\x11\xaa\x11\x80\x06\x66\xe7\xce\x90\x06\xdd\x48\xdf\x25\x75\x93\x34\xeb\x5a\xd7\x1f\x04\xca\xa4\x33\x00\xc1\xd2\x30\x4c\xc2\x03\xa4\x5d\x68\x04\x06\x45\xf3\x5d\x8a\x7a\x59\x65\xab\x86\xa9\x8c\xe2\x91\x13\x10\x71\x55\x94\x02\x31\x39\x45\xa1\x5d\xe8\xd1\x70\x2c\x0d\xf2\x65\xae\xa5\xdd\x84\xbf\xee\x8c\x8e\x21\x7b\x40\x05\xa8\x26\x02\x13\x40\x88\x29\xf7\x8d\x98\x95\x9a\x5a\x04\xf1\xda\x0c\x2a\x86\xc5\x6a\x0c\xd0\xd4\x4a\x54\x83\xf5\x97\x31\x0b\xc2\x06\xa6\xda\x31\xd1\x87\x81\x06\x80\x8b\x2b\x01\xb4\x59\xaa\x86\x3a\xd5\x26\x95\xc2\x80\x6d\x75\xdf\x98\xac\x67\x3a\x84\xf8\xf4\x0b\xce\x2b\xeb\x95\xcd\xc0\x1a\x30\xad\x18\x22\x46\x08\xc8\x08\x41\xe1\x53\x0f\x10\x2c\x20\x70\x7c\x10\x60\x57\x06\x01\x21\x76\x41\x08\x90\x62\x57\xea\xc3\x74\xec\xe9\x1a\xea\x7b\xd1\x70\x8f\xbc\xef\x3c\x91\xd5\x4d\xba\x4d\x32\x67\x26\xa5\x6b\x40\x97\x9b\x02\x03\xf3\x18\xa4\xa1\x76\x49\x42\xf6\x57\x6c\x16\x24\x23\x01\x81\x76\x05\x68\xc5\x4e\x31\x62\xe9\x6a\xf2\x64\x45\x86\x02\x8c\x7d\x7d\xa4\xb2\xc4\x70\x24\x20\xec\xb3\x29\x56\x65\xaf\x29\x77\xe5\x05\xc8\x54\x2f\xcd\x13\x8c\x5c\x4a\x41\xdc\xff\xb2\xec\x81\x00\xeb\xba\x02\x9f\x33\xe2\x41\xcd\x62\x79\x7f\x36\x54\xd0\xfb\x93\x43\xa1\x4a\x9f\x1a\x0e\x99\x56\x93\x95\xdd\x04\xd1\xba\x12\xad\xe6\x6e\xca\x6e\x9a\x22\xb2\xf0\xf6\xe3\x34\xe6\xe9\xb0\x94\x95\x01\xb2\xb2\xda\x89\x30\xd2\x7e\x26\x59\xaf\x57\xa8\xaa\x51\x8a\xd2\x68\x3f\xba\x48\x72\x61\x4f\xf1\xf4\xf0\xdf\x4d\x36\x35\x52\x39\xd1\xa1\x89\x0a\x3d\x08\x6f\x95\x15\xd1\x42\x8c\x8e\xe9\x2c\x7c\xa6\x54\x3b\xf5\x1e\xa1\x58\xbe\xdb\xdf\xe7\x9a\x76\x73\x9c\xe1\x73\xaf\x06\x6c\x81\x44\x56\x0d\x28\x1c\x18\x24\xf8\x91\x26\xcc\x44\x5b\xce\xb6\x78\x92\x0d\x61\xdd\x62\x82\xad\x6d\x68\x21\x9e\xc1\xe4\xda\x90\xbc\x00\xdc\x4c\x27\x70\x2d\x80\xe5\x06\xc6\xe0\x09\xac\x59\x10\x1e\x4e\x80\x4b\xb8\x07\xb3\xab\xea\x09\xd0\xf8\x88\xb1\x28\xe6\xb6\xfd\x77\xc8\xcc\x76\xc0\xa5\x98\x1b\xd2\x30\x06\x33\x43\xd2\x4c\xd7\xe7\xe0\x29\x7d\x93\xb4\x73\xa5\x6c\x39\xe8\x6e\xf8\xa5\x7e\x32\xfc\xd3\x68\x21\xc2\x60\x8c\xd3\x04\x9f\xac\xca\x73\xb4\x6c\x48\x56\x0f\x46\x60\x27\x62\x5b\x12\x82\xff\x7f\x00\x00\x00\xff\xff\x3d\x0f\x75\xf5\x0b\x71\x05\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xfd\x7b\x77\xdc\x36\x92\x30\x8c\xff\xaf\x4f\x81\xcd\x2f\xfb\x53\x6b\x46\xf6\x2b\xd9\x89\x93\xd8\x71\x9e\xa3\x75\xe4\x8c\x9e\xc7\x91\xe6\x91\x94\x9d\xc9\x9b\xc9\xf6\xa1\x9a\x90\xc4\x75\x8b\xec\x90\x6c\xc9\x9a\xcb\x7e\xf6\xf7\x00\xe0\x05\x00\x71\x29\x5c\x68\x51\x4e\xcf\x39\x9b\xb5\x1a\x85\xba\xa1\xaa\x00\x14\x0a\xe0\xd6\xd6\xd9\xe1\x39\xaa\xea\xa4\xc6\x37\x38\xaf\xe7\x75\x76\x83\x8b\x75\x8d\x5e\xa3\xbd\x57\xb4\x69\x59\x2c\xde\x0f\x7f\xcd\xd2\x25\x9e\x67\xf9\xbc\x2e\x93\xbc\x4a\x16\x75\x56\xe4\xf3\x0a\x57\x15\xf9\xff\x03\xe0\xc5\x32\x23\xa8\x71\xbe\x28\xd2\x2c\xbf\x42\xaf\xd1\xf6\x4f\xe7\x6f\xbf\xde\x7e\xd5\xd2\xce\xd3\xa4\x4c\xe7\x8b\x22\xbf\x2c\xca\x9b\x2c\xbf\x9a\x57\x75\x99\xe5\x57\x15\x7a\x8d\x8a\xbc\xc1\x71\x8d\x17\xef\xe7\x97\xeb\x9c\xd1\xba\x28\xd2\x0c\x93\xf6\xcb\x64\x59\x61\x06\xf2\xe1\x66\x59\xac\x48\x2b\x7a\x8d\x16\x45\x5e\xe3\xbc\x16\xe8\xdf\x64\xf9\xfc\x06\x57\x55\x72\x45\x7b\xde\x25\x65\x9e\xe5\x57\x0c\xa4\x2c\xee\xe6\x15\x5e\xac\xcb\xac\xbe\x27\x54\x2f\x2f\x5f\x6d\x6d\x6d\xbd\x39\x3d\x3c\x38\x3f\x44\x67\x6f\xfe\x74\xf8\xe3\x01\x5a\x14\xb7\xb8\x9c\x27\x65\x3d\x4f\xca\xc5\x75\x76\x8b\x5f\x6d\x0d\x60\xd2\x62\xb1\x26\x7a\x4c\x08\x1f\x8a\x76\x7c\x4b\x38\x31\xe3\xb8\x59\x57\xd9\xe2\xa2\x4c\xb2\xfc\xef\x8a\x56\x32\x54\x59\x55\x67\x8b\x4a\xd1\x78\x97\xbd\xcf\xd2\x42\x6c\x3a\xfc\xeb\xf9\xe1\xf1\xd9\xd1\xc9\x31\x3a\x7a\x8b\x8e\x4f\xce\xd1\xe1\x5f\x8f\xce\xce\xcf\xd0\x62\x7d\x81\xd1\x5f\x8e\xce\xff\xd4\x76\x5e\xad\x2f\x96\xd9\x82\x76\xb5\xf7\xc6\x49\x59\x5f\xa7\x19\x19\xbc\x05\x00\xcd\xf9\xcf\x7f\x3e\xe4\x05\x7b\xda\x2b\x73\x55\xe2\x0a\x13\x24\x07\x67\xe8\xf0\xf8\xa7\x1f\xd1\x6c\x0b\x21\x84\xb6\x93\x8b\x0a\xe7\xf5\xf6\x2e\xfb\x8b\x41\x75\x7f\xa6\x49\xf9\x1e\xe7\x38\xdd\xde\xda\x79\x65\x22\xd3\xeb\x7b\x54\x32\x97\xcb\x35\xce\x17\xf7\x32\xee\x8b\xa4\xca\x16\x2d\xae\x2c\xaf\x71\x79\x83\xd3\x2c\xa9\x71\xfb\x5b\x92\xde\x12\xfd\xa5\xed\xdf\x79\x52\x67\xb7\x58\xa2\xf6\xf6\xa7\xe3\x37\xe7\x64\x04\x78\x8a\x73\x8a\x29\x9f\x25\xf9\x7d\x52\x96\xc9\xfd\x0e\x3a\x3d\x3c\xff\xe9\xf4\xf8\x0c\x25\xf9\x3d\x5e\x52\x67\xa6\x38\xdf\x1d\x1c\xff\xf0\xd3\xc1\x0f\x87\xa8\xfa\x6d\x89\x8e\x7e\xfc\xf1\xa7\xf3\x83\xff\x78\x77\x48\x9b\x0e\xce\xd0\xe7\xf3\xcf\xb7\x10\x1b\xbe\xdf\xc8\xdf\x8c\x71\x84\xce\x0e\xdf\x1d\xbe\x39\x47\xb7\xc9\xb2\xf9\xe1\xed\xe9\xc9\x8f\x68\x9d\xe7\xb8\xaa\x67\x9f\xef\xef\x70\x2d\x7f\xf9\xd3\xe1\xe9\x21\xfa\xcf\x83\x77\xe8\xe8\x8c\x5a\xc8\xf1\x4f\xef\xde\x35\x6d\x27\xa7\xdf\x1f\x9e\xa2\xff\xf8\xb9\x01\xdf\xd9\x92\x30\x53\xac\xbf\x6d\x21\xf4\xee\xe8\xc7\xa3\x73\xb4\xbf\x85\xd0\x93\x27\xe8\x6c\x7d\x51\x97\x24\xa8\xe4\x57\x68\x96\xa3\x3f\xa2\xfd\x1d\xf4\xef\xe8\x19\x5a\x94\x38\xa9\x71\x85\x12\xb4\xc4\x97\x35\xba\xc8\x92\x6a\x0b\xa1\x93\xb7\x6f\x89\x07\x5f\xb1\xc6\x7a\xb6\xb7\x8b\x2e\x97\x45\x51\xce\x66\x15\x5e\xe2\x45\x8d\x16\xc5\x3a\xaf\x67\x7f\xd8\x69\xa8\xed\xa0\xff\x07\x3d\x7b\xba\xb7\x83\x9e\xa0\x21\xc8\x1f\xd1\x7e\x07\xf6\xef\xe8\xd9\xce\xce\xab\xad\xcf\xe7\x9f\x5b\x47\x23\x99\xa7\x78\x39\x4f\x96\x35\x2e\xd9\x10\xd2\xe1\x59\xdf\x90\xf8\xb8\x78\x3f\xeb\x07\xa7\x2e\xb3\xab\x2b\x5c\x8a\x23\xb3\x5a\xae\xae\xaa\xdf\x96\xdd\x98\x7c\xbe\xf5\x1f\x87\x3f\x1c\x1d\xd3\xbf\xff\x7c\x78\xfa\xf6\xe4\xf4\x47\x94\xe2\xc5\xbc\xc4\x97\x73\xc6\xe9\x36\x4f\x8a\xd2\xd8\xde\x45\x27\xef\xbe\x7f\x3a\xf8\x7d\x17\xed\xef\xbc\xa2\x98\x18\x0b\x74\x74\x5e\x6d\x1d\x1e\x7f\xff\x6a\xeb\x73\x1f\xc1\x4a\xbc\xc4\x49\x85\xe7\x45\x19\x57\xb8\x7c\xbd\x5c\x26\x17\x4b\x4c\x9c\x35\xab\xea\xf9\xa2\xc4\x69\x56\xcf\xa8\x50\xfc\x2f\xe1\xd2\x64\x79\x55\x97\x34\x4a\x87\xf0\xfe\xfd\xe1\xbb\xc3\xf3\x43\x66\x2c\xcb\x2c\x7f\x3f\x4f\xea\xba\xcc\x2e\xd6\x35\x9e\xd7\xf7\x2b\xdc\xb8\xc5\x55\x96\xa2\xd7\x74\x64\xae\xb2\x94\x71\xde\xc4\xd1\xb7\x27\x3f\x1d\x7f\x8f\xce\xff\x74\xc8\xd0\x91\xff\x9d\x1e\x1c\x9d\x91\x88\xfb\xe6\xf0\xcf\x94\xf5\xed\xbc\x50\xa2\xbe\x2c\xd6\x79\x8a\x2e\x8b\x12\xf5\xa2\xa0\x7f\xdf\xde\x45\xc7\x87\x7f\xe9\xe9\x1c\xbe\x3b\x3b\xec\x51\x37\xea\x3a\xfc\x4b\xd3\x78\xfc\x3d\x3a\x7a\xeb\xaa\xb9\x12\x2f\x8a\x92\x4c\xe1\x63\x58\x34\x3f\xc8\xad\x35\xf3\xbf\x45\xb1\xe4\xc6\x7a\x43\xf8\x7f\xf2\x84\xb0\x5e\xd2\x08\x8b\x3a\x01\x50\x71\x89\xea\x6b\x8c\xf2\xe4\x06\x47\x12\x53\x22\xc4\xdc\x4e\x20\xb6\x4a\x4a\xae\x0d\x5d\x95\xc5\x7a\x45\xbb\xfe\xf4\xe7\xef\x89\x22\xda\x4e\xb4\x61\x7e\x83\xeb\x04\xd1\xc5\x8e\x80\xeb\xb5\xf4\xf7\x13\xb4\xdf\x18\x6f\x67\xbb\x02\x9e\x58\x63\x30\xa7\x73\xb3\xff\x48\xb4\x0a\xae\x70\x3d\x17\x05\xbd\xcc\xca\xaa\xff\x2d\x4d\x6a\x3c\x13\x00\x76\xda\x99\xa7\xf9\x75\xab\x9d\xc4\x9a\xbf\x9f\xca\x82\x13\x91\x23\x08\x4c\x89\x3f\x5a\xd7\x19\x6d\x2a\xf3\xf4\x08\xca\x0f\x43\x82\xb2\x9c\xf7\x07\x36\xf7\xf2\x8e\xc0\x7e\xa1\xc6\xcf\x77\x7b\x2d\xfc\xa5\x30\x7c\xd6\xef\x95\xc0\xfe\x4d\x52\xe3\x32\x4b\x96\x59\x85\xfb\x80\x38\x5f\xe2\xfc\xaa\xbe\x9e\x31\xa3\x69\x7e\x0c\xd3\x79\x96\x57\xa3\xae\x28\xb2\xdc\xba\xa2\x20\xf3\x49\xfc\x15\x85\x2c\xd8\x18\x2b\x8a\x2c\xd7\xae\x28\xa8\x50\xf1\x56\x14\x54\x1a\x8a\x2e\x70\x52\x49\xd2\x14\x25\x28\xc7\x77\x08\xe7\x75\x79\x8f\xea\x82\xda\x74\xc3\x2a\x0d\xde\x35\x11\x87\xc2\x1f\x1d\x9f\x1d\x9e\x9e\xa3\xa3\xe3\xf3\x13\x01\x62\x96\xa5\x3b\x64\x2d\xfe\xd3\xe1\x19\xa2\x92\x66\x69\xb8\x78\x44\x49\xf3\xbc\xa8\x83\xa6\x4d\x9e\xe3\x0e\x21\x71\xa0\x6c\x45\x76\xe7\x68\xd6\xfd\x73\xb7\x6f\xdf\xe9\x36\x24\xdd\xc6\x81\xb4\x3d\x25\xff\x29\x4a\x66\xa0\x59\xda\x81\x34\x61\x9d\xb4\xf6\xbf\x31\x97\xa6\xdd\xa8\x5f\x93\x3e\x22\x04\x42\x07\xc7\xdf\xf3\x88\xd1\xbf\xf5\x60\x05\x93\x31\x8e\x16\x8b\x72\x1c\x23\x61\xb8\xe7\x77\x49\xbd\xb8\x26\x3b\xdd\x4b\x5c\xd2\xbd\xae\xda\x60\x34\xd0\x15\x9a\xb1\x16\x85\x05\xb5\xf4\x2f\xee\x51\x8a\x2f\x93\xf5\xb2\x46\xb4\x3b\x5d\x85\x12\x76\x8a\xcb\xcb\x6c\x91\x25\x4b\x94\x2c\x2f\xd6\x37\x95\x99\xa6\x38\x5d\xd3\x35\xed\xac\x1d\xd2\x61\xdb\x4e\x37\x52\x22\x5b\xbb\xe8\xd9\xce\x2b\x18\x21\x92\x34\x59\x57\x43\x22\xec\x77\x3d\x81\xfd\x56\xf4\x90\x71\xbf\x0d\xdc\x69\x18\x86\xfd\x16\xe7\x8d\xe3\x6b\x46\xba\x07\x18\x25\x32\xc4\xd9\x4a\xd1\xf4\x43\x96\x57\xb8\xac\x71\x3a\x2f\x8b\xbb\x8a\x71\xcb\xe5\x23\x44\xb1\x54\x9b\xa2\x19\x9b\xfc\x77\x51\x59\x14\xf5\x2e\x5a\x5c\x67\xcb\x74\x5e\x94\x29\x2e\x77\xc9\x2e\x6c\x97\xae\xcc\x77\x51\x8a\xab\x45\x99\xd1\x34\xe1\x70\xd8\xf7\xbf\xd8\x45\xe4\xff\xf6\xba\xad\x14\xfb\x07\xeb\x4a\xfe\xa5\xec\xce\x04\x3f\x3a\xfe\x01\x35\xb1\x68\x67\xc8\x2d\x9b\x6a\xd8\x74\x24\x31\x2e\xfe\xbd\xd3\x06\xba\x2c\x65\xd1\x4c\x50\xcc\xab\x2d\x79\x37\xe7\x36\x60\xcb\xe4\x02\x2f\x63\x85\x71\x8a\x6c\x44\xeb\x8a\xba\xdd\x94\x97\x3b\xd2\xaa\x73\xb0\x2a\xe8\x97\x39\xbc\xc8\xfd\x82\x6f\x4c\xb1\x63\xec\x51\xb3\x1c\xbe\x47\xf5\xd7\x8d\x44\xe8\x63\xed\x51\xff\x28\x2e\xd5\x09\x83\x8a\x3d\x6a\x13\x38\x49\xd8\x6c\x5b\x09\x7e\xc5\xa0\xf6\x8d\x86\x21\x55\x75\xa1\x69\xec\xa4\xac\x47\xb5\x84\xa9\xef\x94\x39\xf5\xbf\xda\x8a\x22\x70\xb4\x9d\x72\x2c\xaf\x1f\xd8\xe8\x28\xe3\x3d\xda\xde\xce\xd3\xa1\xa3\x6f\xb1\x15\x7e\xeb\xbc\xc5\x66\xd6\x16\x6d\x8b\x7d\x57\x94\xef\x63\x4d\x88\x04\xd7\x48\xe6\xb1\x5e\xa5\xa3\x64\x03\x8e\xde\x32\x6b\x90\x77\xf9\xe8\xe8\x0c\x7d\x7f\x74\x76\x7e\x74\xfc\xe6\x9c\x85\x00\xe5\xf1\x82\x98\xc0\x8e\x97\x5a\x88\x7b\xf4\xd1\x66\xbb\x23\xea\x3f\x66\xd2\xe2\xe8\xed\xd0\x23\x35\xfa\x17\x40\xb4\xba\x77\xcc\x7c\x04\x1e\xc5\x44\xd2\x2e\xa5\x11\xae\xc5\x66\xab\xf7\x6f\x2c\x81\xd7\xfc\xc5\x6b\xaa\x89\x57\x84\x5c\x23\x20\x6a\x4b\x20\xd6\x55\x13\x94\x9a\x3f\xfa\xd4\x41\xf3\x7b\x7b\xb6\x22\xa1\xa1\x0b\x61\xe4\x8e\x26\x92\xe6\xe2\xec\xc2\x1a\x81\x54\x7b\x2b\x22\x18\x59\x34\x36\xec\x0f\x76\x52\xcd\xef\xfc\x2f\xfc\xf1\x97\x70\x2c\xf5\x48\x8f\xbf\x88\x9e\xa3\xec\x47\x94\xce\xde\x58\x2b\xc0\xbd\xfd\x93\xe7\x71\xd6\x07\x91\x4c\x36\xc2\x1e\xe7\x13\x52\x64\x23\x8a\xb0\xcc\x6c\x45\x11\x7f\x14\x44\x79\xf2\x44\xdc\x56\xa1\xac\x42\x8b\xeb\x24\xbf\xc2\xe9\xae\xf6\xe8\xb0\x59\xc7\x15\x65\x76\x95\xe5\xc9\x12\x9d\xfe\xb0\xcb\xad\xf8\x9a\x56\x9a\xd1\xcb\x71\x47\x69\xfc\xf3\xc4\x8f\xb3\x23\x44\xc8\x71\x37\x34\xe0\x76\xc7\x13\xd1\x80\xa1\x51\xfc\x69\xda\x3b\xc5\xa3\x63\xc4\xeb\x61\x97\xb7\x85\x9d\x57\x5b\x51\x14\x10\xbc\x73\xdc\xc4\x67\xa2\xce\x71\xd6\xb4\x8f\x3a\x3a\x37\x1b\xdc\x7f\xe3\x4f\x86\x07\xf1\xb8\xf9\x59\x1d\x88\x15\xdb\xe9\x2e\x0c\xb3\x8e\xf0\x50\x1c\xe3\x44\x3b\xf2\xee\x5d\xd2\x99\x70\x14\x8e\xbe\xfd\x0e\x09\x1b\x77\x5e\x73\xde\x27\xeb\x3c\xc5\x8f\x9b\x3b\xb8\xe8\x0f\x46\x3b\x82\x7f\x6f\xcf\x93\x42\xfc\x86\xdb\x36\xbc\x46\xb3\xe6\x28\xa0\xf9\xa1\x3b\xdc\x94\x47\x41\x71\x8a\xed\x72\x34\x70\x41\x5d\x7e\x99\x54\x35\xf9\x47\x42\xce\x1a\xe8\x49\x45\xa8\x1c\x3c\x46\xc2\xe9\xc9\x5f\x66\xc1\x5c\x46\x58\x83\x37\xb6\xc9\x4c\x62\xb8\xcf\xa6\x9c\xd3\xb6\xad\xf6\x68\xb8\xa9\x79\x6e\x87\xe3\xfc\xf4\xa7\xa6\xf0\x8f\x7a\x47\x37\xd1\x35\x9c\x75\x9b\xbc\x9d\x0e\x41\x8f\x73\x48\x8f\x15\xf2\xb2\x88\xcb\xdb\x29\xeb\xdf\xb9\x09\x87\xe2\xb5\xa9\xcf\xab\xad\xde\x2f\xb6\x94\xe3\x90\x17\x77\x33\x61\xba\x75\x19\x05\x56\x87\xdf\x1c\xb2\x92\x8d\x60\xe0\x24\xd1\xea\xb4\x29\xbb\x2d\x96\x29\x8f\xbc\x51\xed\xb2\xb8\xc3\xe5\x8c\xfc\xb0\x83\x5e\x37\x7f\xb5\x1b\xd1\x1d\xa6\x65\xf3\x4e\xf2\xa0\xae\xf1\xcd\xaa\x46\x75\x81\xd6\x15\x46\x09\x5a\x95\xf8\x36\x2b\xd6\xd5\xf2\xfe\xc9\xba\xc2\x69\x73\x6a\x4c\x37\xb9\x4f\xb7\xf5\x33\xa9\xbb\xa6\xae\x93\x8a\xae\x96\xaa\x50\x3d\x11\x79\x2f\xf0\x55\x96\x53\x74\xf3\x7b\x9c\x94\x7c\xc1\x35\x3a\x39\x6d\xc5\x97\x20\x6f\x8a\xbc\xbe\x86\x81\xa6\xc9\xbd\x01\x10\xe7\x29\x88\x76\x07\x67\xa3\xdc\x01\x5a\xe9\x52\xbb\x25\x6e\xd7\x1d\xba\x52\xaf\x3a\x39\xef\xec\xa7\xd3\x33\x57\x91\xcb\xd5\xe1\x76\xa1\xb2\x6b\x10\x9c\xcb\x64\x30\xe4\x08\x89\x61\x26\x7f\xa0\x12\x2f\xe9\xe5\x8e\xea\x3a\x5b\x21\x4a\xa1\xbe\x4e\x6a\x94\x16\xb8\x42\x79\x51\xa3\x6a\xbd\x5a\x15\x65\xcd\xba\x30\x5b\xea\x2d\xc9\xcb\x8e\x8a\xbc\x2e\x8b\xe5\x12\xa7\xf3\x4b\x52\xd8\x70\x9d\xd5\xb8\x5a\x25\x0b\x3c\xab\xf1\x87\xba\xb7\xaa\x8b\xa2\x58\xe2\x24\xb7\x15\xfd\xd3\x24\x15\x26\xb7\x4f\xe6\xab\xa4\xbe\x46\xe7\x27\x68\x9b\x23\xb7\xbd\x8b\xb6\xd9\x0d\x8e\x6d\xf1\x8e\x40\xa3\x67\xa2\xf3\x55\x92\xa6\x38\x9d\x5f\xdc\xf3\xcc\x90\x4b\x01\x64\x50\xfa\x9f\xe6\x8b\x62\xb9\x4c\x56\x15\x4e\x49\x23\xac\x90\x9e\x95\xf8\xcf\x2f\x48\x8a\x87\xcc\xdb\xe4\x92\xca\x2c\x5d\x97\x4c\xe5\x88\xdc\xa1\xb8\xc2\xe5\x2f\xbf\xee\xa2\xcb\xf5\xdf\xff\x7e\xdf\xfe\xd0\x6b\x81\xf1\xfe\x94\xf4\x53\xfa\x97\xe2\x0a\xc4\xe7\x5b\xdf\x1f\xbe\x79\x77\x70\xca\x7e\x59\x15\x59\x5e\x23\x84\xe8\xfd\x18\xe6\xfd\x55\x5d\xb6\x75\x02\xa7\x6f\xfe\x74\x70\xca\x7e\xcd\xfa\xca\x84\xf3\xc3\x1f\x0e\x9b\x5f\x53\x5c\xd5\xc3\x5f\xd9\xfa\x69\x00\x9b\xdd\x20\xfa\xbf\x37\x27\xc7\x67\xe7\x07\xc7\xe7\x6d\x33\x7a\x8d\x5e\x34\xa4\xe9\xfd\x04\x9c\xb6\x2d\xbf\xfc\xda\xfc\xbe\x48\x96\xb8\xac\x10\xff\x3b\x8b\x17\x1c\xbd\xd7\x88\x5e\x0d\x99\xaf\x57\x2b\x5c\xf6\x5a\xe4\xce\xb8\xde\x36\x90\xdf\x52\x5e\x84\x18\xfa\xf6\xe4\x14\x65\xe8\xe8\x18\xed\x3f\x7d\x4a\x1a\xdf\x9d\x9c\xfc\xb9\x6b\xe4\x59\xfb\x25\xfb\x95\x5d\xf5\x12\x1a\x19\x7f\x83\x36\xe2\x0a\x04\xd3\x2b\x25\x1d\xc6\x8c\x85\x52\x27\xc7\x2f\xd9\xaf\x06\xa2\xfb\x3a\xa2\x42\x62\x70\x42\x52\x52\xcb\x79\x8d\x66\x84\x8d\x3f\xa0\x59\xf6\x64\x9f\xdc\x54\xa1\xc0\xf4\x5e\xca\x2b\x35\x5f\xa4\x1b\x21\x2f\xfd\xf0\x47\x80\x9e\xba\xae\xc2\xdf\x7f\x34\xa8\xae\x5f\x57\x30\xaf\x78\x8d\xb6\x67\xcd\x74\x69\xd4\xe4\xd1\x5b\x94\xa1\xef\xd0\xbe\x68\x62\x3d\x16\xf2\xdf\x7f\xfe\x13\x6d\xef\x6e\x8b\xa4\x8f\xde\xf6\x7f\x0b\x90\x8b\xa4\xaa\x67\x33\x7e\x70\x9e\xa0\x19\x0b\x09\x7f\xe0\x86\x65\x67\x07\x25\x15\xa2\x71\xb2\x97\xa0\x97\x47\x24\xbe\xb3\x3b\x15\x59\xfe\x18\x2e\xcb\xb6\x58\xb8\x56\xd5\xe5\xcb\x97\x2c\xa2\x81\xe7\x1d\x16\x8a\x49\xa7\xf9\x65\x59\xdc\xcc\x3b\x83\x52\x05\xe4\xe9\x44\x60\x4d\xac\x55\xc6\x65\xcf\x08\xec\x1e\x69\x3b\x8b\xda\x53\x58\x94\x32\xc6\xf4\xa3\xeb\x14\xaa\x03\x42\x28\x30\x4c\x3e\x68\xf8\x9a\x74\x3c\xe2\x95\xfc\x51\x5d\xd5\x70\x68\x4b\x2e\x36\x67\xe9\x70\x9d\x74\x5b\x64\xa9\xd2\x3d\x75\x1b\x90\x06\x11\xbf\x4c\xf7\xc9\xdc\x31\x34\xa6\x7c\xa5\x9b\xd8\x3d\xa9\xfa\x62\x49\x92\x6e\xe4\xe2\x28\x2e\xd1\x6d\x52\xde\x67\xf9\xd5\x2e\x12\x15\xb0\x4b\x2e\x9e\xfa\x69\x83\x0f\x51\x1d\xd5\x16\xd5\x2b\xfb\x05\x31\x72\x60\x49\x53\x7e\xcb\x94\xd5\xa2\xb6\xc0\x45\x89\x52\xbc\xc4\x35\x46\x59\x8d\xb2\x4b\xae\xcb\x5d\xb1\x5e\xa6\x28\x2d\x8b\x15\xaa\x0b\xb4\xc7\x14\xf6\xd7\xc3\x37\x3f\x9d\x1f\xa2\xed\x66\x41\xde\x43\xd3\x8d\xcf\x36\xb1\x29\xa2\x0a\x62\x5a\xfc\xe6\x87\x36\x34\xca\xa0\x6d\xc4\x41\x58\xf6\x6f\xbb\xad\x72\x6a\x30\x75\x8b\xc3\xee\x17\xf4\xed\x6b\xaa\x38\x61\xc4\x3b\x4e\xf8\xab\x90\x10\xfa\x8a\x00\xd3\xe1\x62\x1c\x49\x68\xd8\xf1\xcf\x25\x77\xf4\x73\xd9\x65\x38\x29\xe4\x6d\xd2\x40\x1a\x09\x06\x99\xda\x92\x6e\x67\x70\xf3\xd4\xc1\xfa\x62\x5e\xe4\x73\x36\x6c\xee\x3b\x7b\x84\x06\xc7\xee\x4d\xd2\xa3\x5a\x5f\x90\x33\xf4\x0b\xcc\x51\x43\xd5\xfa\x62\x0b\xb5\x7b\xb7\xe4\x36\xc9\xa8\xa3\xa3\xd7\xe8\xed\xc1\xbb\xb3\xc3\x5d\x44\xf3\x3b\x15\xc6\xf9\xbc\x39\xa4\x27\xe9\xad\xae\xc8\xb3\x4f\x1b\x16\x25\x8f\x95\xfc\x73\x8b\xbb\x21\x51\xad\x2f\x9e\x72\xcd\x0c\x4b\x96\xd2\x4d\xdd\xa0\x8d\xfc\x41\xcb\x18\x38\x6d\x92\x0e\xcd\x76\x37\x40\xa7\xab\x32\xbb\x4d\x42\x95\xda\xa4\xec\xd9\x52\xa4\x55\x14\x95\x84\x08\xd5\xfd\x4c\x13\x79\x9c\x4d\xbb\x8c\x85\xef\x68\x00\xc6\x23\x64\x44\xf8\xfc\x08\x81\xe9\x2f\xb4\x50\x00\xf6\xa7\x62\xf6\x14\x2b\x24\x1c\x86\x10\xd7\xa4\x08\x6a\x75\x9d\xe4\x98\xcb\xce\x56\x61\xe3\xd7\x4e\x26\x64\x80\xb6\x3a\x9d\x75\xd8\x51\xb1\xae\x71\x39\x2f\xb7\x7a\x5d\x71\x67\xcb\x0d\xd0\x96\x78\xb5\xa7\x9a\xaf\x70\xde\xa4\x67\xf7\xb6\xc4\x24\x52\x9b\xdf\x95\xef\x1a\x75\xe4\x85\x61\x43\xff\xfb\xe4\xe8\x98\xfe\xab\x97\x17\xe1\x12\x9d\x1c\x73\xb7\x8c\x70\x29\x5e\x32\x62\x5c\xe2\xf2\x29\x9f\x26\x6e\xc4\x10\xaf\x30\x11\xa6\x68\x5e\x89\x1c\x97\x7e\xb5\xbf\x8b\x9e\xed\x7d\xb5\x8b\x9e\xed\x7f\xbd\xc3\x01\xf5\xef\x25\x90\xff\xed\x80\x24\x19\xa6\xac\xe9\xbf\x35\x1c\x05\x90\x58\xce\x93\x12\x27\x9c\x72\x1a\xe1\xf3\x3a\xab\xef\xf7\x75\x72\xff\x74\x4c\xac\xeb\xe0\xdd\x3b\x33\x62\xba\x9c\x18\x03\x35\xbb\x2b\x33\x06\xe6\xbe\x4c\x69\x14\xf4\xec\x1a\xc6\x18\x98\x57\x4b\x92\xc6\x1b\x03\x73\x87\x13\x84\x1d\x9d\x9c\x36\xbf\xef\x45\xa3\xca\xea\x66\x78\x9a\x91\x71\x37\x15\x3b\x63\x50\xa8\x70\x99\xe1\x6a\x14\xd4\xa4\x84\xd9\x17\xb1\x19\xf3\xba\x5c\xda\x11\xef\xf4\xdb\xdd\x41\x85\xe0\x93\x27\xe8\x14\xdf\x14\xb7\x18\x71\x57\x16\x49\x66\x82\x5d\x7e\xab\xda\x44\x7c\xbe\x5d\x37\xa7\xef\xe8\xee\x1a\xd7\xd7\xb8\x44\xe4\x68\xa7\xa8\x51\x7d\x9d\x55\x48\x9e\x20\x48\xc5\x7b\x85\xda\x29\xec\x69\xf3\x2b\xbf\xa6\xcd\xaa\x72\xa1\x38\xe4\x13\x66\x9b\x57\x8a\x7e\xbd\xec\xc9\x32\x4b\xaa\x40\x14\x79\x5e\xb0\x67\xa5\xc2\xf0\x5c\x65\x64\x9a\x4e\xb3\x12\x2f\xda\x73\xe4\x1c\xd3\x5d\x81\x13\x1a\xb2\x39\xa7\xff\xef\x2e\x8c\x9d\x3a\xb9\x0a\x46\x00\xe3\xc2\x84\x45\xbb\x88\x30\xac\x94\x68\xbd\x80\xf3\x52\x89\xe9\xad\x9a\xe1\xbc\x66\x87\x47\xf8\x43\xbd\x8b\xb2\x54\x99\x4a\xa3\x59\xb2\xe6\xd2\x6a\xbf\x83\x65\x28\x50\x75\x93\x2c\x97\x59\x5e\xef\x98\x17\x56\x73\x71\xe7\x4a\x5d\x85\x2e\x4c\xcf\x0f\xff\x7a\xce\x6f\x5b\xfb\x96\xd7\xa8\xe5\x8e\xec\xa9\xb8\xa1\xde\x16\x4e\x43\xff\xef\x4f\x87\xa7\x3f\x6f\xd9\x37\x83\x1d\x5e\x6e\x87\x46\x5b\x78\x2a\xe8\x35\x79\xb8\x8a\x1c\x14\x09\xe9\x18\xf1\x7a\x65\x77\x7d\x97\x72\xb4\xdd\x43\xfe\x74\xc6\x6e\x5f\x56\xaa\xdd\xdd\x1c\x3c\x38\xeb\x9c\x1c\x06\x13\x93\x9a\x11\xb3\xf2\xcd\x9b\xf0\x2b\x59\x5e\x1d\x75\x22\x18\x1a\x23\x41\x6d\xa8\x3d\x78\x6c\x17\xb4\xc7\x24\x5b\x80\xb3\xab\x7c\xfe\x1e\xdf\xcf\x6f\xb3\x82\x9d\x39\xd2\x68\xd8\x49\xe7\x6c\x7b\x8d\x78\xeb\x72\x39\xd3\x18\x9c\x5b\x1e\x64\xb1\xc4\x49\x39\x5f\xaf\x86\xa9\xd1\x6e\x2a\x38\x38\x3d\x3d\xf8\x79\xb6\xc5\x4d\x0e\xed\xb5\x56\x32\x1d\xac\xcb\x25\xb9\xd5\xca\x2b\x85\x18\x41\x96\x36\x57\xb2\x99\x5e\xf8\xde\xa4\x57\xb7\x10\x27\x92\xf4\x8b\x71\x74\x72\xcc\x2e\x78\x37\x0b\xf0\x06\x80\x15\xc7\xf0\xaf\x00\x74\xe5\x35\xfb\x1a\x22\xed\x12\x84\x5f\xcc\xae\xcb\x25\x14\x9a\xae\x50\xc1\xf0\x6c\xd9\x09\x06\xe7\xd6\x92\xe0\x3e\x6c\x81\x08\x06\x67\xab\x3e\x30\xb8\x30\xc3\x43\xbb\xb0\x15\x92\x73\x07\x56\x8b\x0a\xee\xc6\xd6\x49\x70\x78\x62\x8f\x36\xe0\x3d\x6f\x60\xb2\xb4\xda\x62\x97\xc3\xcf\x4f\x3a\xe7\xa1\xb3\x0a\x1f\x25\x08\xa8\x69\x92\x26\x1e\xd2\x76\xde\x79\x35\xec\x2c\x7b\x13\x07\x0b\x8f\x17\xf9\xfd\x3c\xc5\xab\x12\x2f\x68\x35\x18\x29\x9a\xa8\x82\x0a\xae\x66\xe7\x3f\xcc\x4f\xfe\x4c\xb2\x72\xec\xbe\xdc\x36\x3a\x39\xe5\x7e\x6c\xf3\x90\x6d\x86\xa6\x2f\xdf\x68\xea\x06\xbb\x1f\x76\xfa\xa2\xaa\xb6\xf4\x23\xab\x38\x5e\xa3\x97\x7f\xb0\x63\x30\x54\x94\xed\x9a\x52\x2a\x01\xc9\x72\x5a\x16\xc2\x71\x30\xa8\x10\x89\x50\x04\x42\x47\xa4\x5a\x61\xf2\xc4\xc6\x7c\xb5\x2e\x57\x05\xa9\xc2\x26\x61\x3d\x2b\x72\xff\x91\xd1\x8b\x8e\x53\x22\x3c\xa5\x80\x51\x82\x1a\xd2\xa8\x21\x4d\xf2\xaa\xdb\x70\xee\x49\xa1\x0f\x8d\xa2\xd9\xcd\x6a\x49\x3c\x92\x96\xf4\x44\x28\x2f\x6e\x11\x0f\x9e\x9e\x14\x12\xd5\x72\x11\x51\x9c\xfa\xae\xae\x7a\x69\x0c\xa1\x3e\x4a\x9d\xd5\xc7\xd3\x54\xb5\x2e\x31\xb3\x00\xf1\x2e\xd7\x3c\x59\x2e\x8b\xbb\x6a\x4e\x56\xc1\x51\xaa\xcd\x45\x0a\xf8\x43\x3d\x90\xd7\x98\xf7\xd3\xe5\xfe\xb8\x0c\xa0\x42\x0a\x11\x8c\x45\x1c\x05\x58\x7f\x59\xde\x8a\x83\xb1\x78\x59\x62\x26\x04\x6d\x84\xd4\x39\x9e\x93\x0d\x6e\x87\x98\x06\x1f\xb4\x48\x72\xba\xf9\x25\x75\x64\x49\x96\x53\xac\x74\xdb\xb1\x3d\xc2\x45\xb9\x6e\xa8\xe9\x22\x68\xe4\xc1\x96\x69\x8c\x33\xdc\x0a\x49\xd4\x03\xae\x00\xe4\x87\xdc\x8a\xe7\xb1\x0f\x7a\xf3\xd6\xf2\x98\x63\x3e\x20\x31\xc6\x90\xab\xe4\x50\x8d\xb8\x0a\xae\x1f\x70\x3b\x96\xc7\x3e\xde\xdc\x56\x64\xd4\x41\x57\xd3\x19\x63\xe4\xb5\x12\xa9\x86\x5f\x0b\xdc\xdb\x00\x10\xdf\x63\x37\x04\xb6\xbf\x1c\xd5\x06\x06\x24\xc6\x18\x7e\x95\x1c\xaa\x91\x57\xc1\xf5\x83\x6e\xc7\xf2\xd8\xc7\xbb\x79\x7b\x64\xd4\x01\x1f\xd2\x18\x63\xc4\x95\x92\xa8\x86\x5c\x09\xf8\x54\xba\x06\xf7\x49\x0f\x3a\xcb\x0a\x8d\x3a\xe6\x03\x12\x63\x0c\xb9\x4a\x0e\xd5\x88\xab\xe0\xfa\x01\xb7\x63\x79\xec\xe3\xcd\x9d\x3c\x8d\x39\xe6\x4a\x32\x63\x8c\xbb\x4e\x1e\xd5\xd8\xeb\x60\xf9\xe7\xce\x20\xd8\x1e\xbf\x0d\xb0\xb4\xeb\xc8\x16\x30\x20\x32\xce\xf8\xab\x64\x51\x8f\xbe\x0a\x72\xf0\xd4\xdd\xef\x62\xe4\x59\xc2\x3d\xe6\xf8\x2b\xca\xf4\xb4\xc4\x7c\xec\x60\x50\x83\x60\x12\x65\x4b\x3d\xf2\x2a\xd0\xa7\xea\x77\x45\x74\x08\x3f\xee\xf0\x6b\x87\x1d\x21\xc7\x81\x6f\x8e\x4c\x46\xf5\xf8\x21\x8d\x31\x1c\x5e\x29\x89\xca\xdf\x95\x80\xfd\x70\x03\xf0\x3c\x76\x6f\xa7\xef\x16\x8e\x3a\xe4\x32\x85\xd0\x01\x67\x43\xac\xe0\x5b\x35\xc0\x0a\xb0\x7e\x78\xad\x38\x1e\xed\xe0\xd2\x4b\x5b\xd7\xf8\xc3\xac\x46\xe2\x4d\xe1\xe6\xe0\xdf\x72\x3b\x0b\x9d\x9d\x9f\x1e\xbd\x39\xd7\xdd\x80\x40\xa7\x87\x6f\x4e\x4e\xbf\xe7\x2b\x48\xc8\x8d\x82\x12\x1d\x1d\x0f\xee\x28\x7c\xd8\xde\xde\xfe\xe7\x3f\xeb\x7f\xfe\x73\x7b\x7b\xfb\xe5\xcb\x86\x3c\x41\x7a\x8d\x3f\x6c\x8b\x37\x73\x1a\xa1\xcb\xa7\xd7\xf8\x83\x7c\x77\xe6\xf0\xf8\x7b\x88\xdc\x57\x38\xc7\x25\x39\xee\x58\xaf\xb3\x74\x7e\xfb\x9c\x3e\x1c\x40\x6f\x24\xab\xee\x87\x90\xc6\xe1\xef\xbd\xb2\x08\x92\x20\x4d\xdd\x26\xcb\x35\x26\x78\x09\x91\xd9\xf3\x17\xcd\xc5\x97\x8b\xfb\x1a\x57\xed\xcf\xbc\x16\x59\xc3\x6b\x74\x93\x7e\x39\x4b\xf1\xa2\x48\x71\x2f\xc0\x2e\xda\x26\x2a\xdb\x21\x25\x33\x5c\xdb\x2e\xda\xc6\xd5\x22\x59\xe1\xed\x9d\x9d\x57\x1c\xd1\xd7\xa4\x04\xbc\xaa\xcb\x19\xc5\xb9\x8b\xf6\xff\xb8\xb7\x8b\xbe\x96\x40\xd8\xff\x27\x35\x38\x4f\xb6\x35\x2d\x32\x96\x67\x7f\xf8\x62\x17\x7d\xe1\x8e\x67\xb9\x4a\xd2\x59\x5d\x50\xa3\x9c\x75\xe6\x39\xc4\xfe\x82\x3c\xed\xbf\x83\xfe\xff\x68\xff\xcb\x1d\xf4\x4f\xf4\xc5\xd7\x3b\xbb\xe8\xd9\x2e\xda\xde\xdb\xde\x01\x73\xf8\x55\xff\x79\x80\x11\x38\xfc\xba\xe5\xf0\xc5\x73\xc2\xe1\xfe\x33\x1f\x16\xbf\xf1\x62\x71\x88\x67\x7f\x6f\x17\xed\xb7\x98\x4a\x5c\xaf\xcb\x9c\x81\xbf\x7c\x49\xac\x17\x1e\x31\x24\xcf\xf9\x62\x06\x70\x03\xa3\xd5\x37\xd7\x35\x99\xd5\xf7\x26\xde\xca\xd4\xfd\x8f\x57\xfb\x02\x67\xcb\x59\x99\xe4\x69\x71\x33\xdb\x41\x7f\x40\xcf\xbe\xfc\x72\x87\x06\x0d\xab\x86\x3f\x3d\x2c\x30\x43\x9d\x38\x97\x9d\x3f\x69\x29\x74\x9e\xfe\xe2\x8b\x9d\x47\xc4\x73\xef\xfb\x1b\x73\xd8\x60\x91\xb1\x34\x2b\x19\xaf\x40\x6c\x78\xc4\xf9\xa3\x5f\xe8\xb5\x3c\xa2\x17\xf3\x42\xaf\x48\x6a\xec\x0b\xbd\xd6\xcf\x65\xb4\x37\x76\xc9\x13\x7c\xdd\x05\xbe\x31\xee\xdf\xbe\x12\x31\x3b\xde\x81\xfd\xa3\xc3\x1d\x58\xf7\x11\xa9\x70\x49\xcb\xbf\xd9\x1d\xba\x12\xff\xb6\xce\x4a\x5c\xd1\x8f\x75\x67\xe4\x73\xc6\x73\x7c\x93\x64\xcb\x79\x92\xa6\x25\xae\xc2\x8a\x04\xe9\x7b\x4e\x42\x11\x25\x45\xdd\x90\xa2\x25\x4c\x82\xb9\xd2\xab\x7d\x43\x90\x6f\x9b\x27\xce\xfa\x8b\x83\xdc\x77\xbc\xfa\x3a\xe0\xa2\x14\xbf\x07\x46\x01\x76\x0c\x45\x81\x87\x14\x88\xc8\x85\x53\xae\x32\x90\xf4\x45\x77\x59\x7d\x5d\xac\x6b\x94\xa0\x4e\x31\x8c\x35\xd4\x28\x86\x8e\x71\xb7\xbd\x1b\xdc\xb6\x74\xda\xd8\x19\x9f\x38\xec\x7f\x08\x8d\x12\xdd\x73\xbc\x0d\x42\x6a\x82\xd2\x13\x74\x5b\xad\x8a\xdb\x62\x4c\xd5\xcb\x74\x3c\x4b\x3b\x0d\xc4\x0e\xbb\x7c\xb8\x35\x48\xb9\xb3\x41\xe1\xbb\x74\x05\x9f\x3d\x8c\xee\x2d\x3d\x8a\xf3\x29\xa3\xe0\xa0\x4f\x0b\xcf\x43\x35\x2a\x77\xd0\xd2\xad\x8b\xce\x8a\x19\xfa\x19\xe3\x8d\xa1\xdf\x31\x3f\x1f\xf8\xf9\x3e\xec\xda\x82\xf2\x21\x2e\xaf\x57\xc1\x64\x9e\x2f\xea\x32\xbb\xa1\x6f\x7a\x7d\xfb\x1d\x9c\x9d\x92\x15\xbd\x64\xf9\x6d\xb2\xcc\xd2\x3e\xab\x12\xfa\xf8\x9d\xdb\x1d\xdd\x19\xff\xdd\x0d\x52\xf0\xdb\xbc\xf4\x2a\x7e\x66\x6b\x07\x2d\x13\x34\x63\xcd\x52\x53\x87\x8e\xde\x2d\x20\x20\x68\x89\x4e\x8e\xd1\x92\xd9\xe6\x32\xa1\x58\x87\x50\xb4\x37\x5a\xd6\x0c\xb6\xff\xe5\x35\x5a\xd6\xfc\xdd\xb9\xbe\x83\x48\x18\x2d\x13\xd6\x37\xa9\x3b\x4a\x9a\xcc\x94\x48\x73\x80\xa7\x6e\x10\xd5\x49\x2d\x61\xa0\x58\xeb\xa7\xe4\x23\x69\xd4\xa7\x28\x88\x9a\xd5\x1d\xfb\xa3\x8a\x7c\x6e\xeb\xdf\x51\x56\xa1\x66\xe8\xe9\xd4\x49\xb0\x76\xef\xf2\x8b\x5c\xec\x76\xc5\xd8\x71\x2a\x4c\x4b\x7a\x2f\xb0\xbd\xe0\xe2\x55\xae\x2e\xdd\x93\x2a\xc8\xbd\xc1\x79\x52\x56\x73\xfc\x21\xab\x6a\xf4\x1f\x27\x27\xef\x0e\x0f\x8e\xf9\x5d\xac\xbc\x12\xd0\xbe\x12\x4a\x67\xe1\xdf\xd6\x45\x8d\xe7\x59\x4a\xde\xe7\x3e\xff\x61\x4e\x5d\x6f\x7e\x7c\xf0\xe3\x21\x49\xe6\x08\x19\xc7\x76\x06\xa7\xfa\x23\xc1\x60\x87\xdd\x74\xa2\xd7\x06\x24\xbe\xb6\xfa\xdb\x4f\x6d\xdd\xfc\xab\x2d\xde\x6d\x24\x78\x61\x48\xf5\x9f\x30\x17\x39\xe8\x31\x5b\x3b\x36\xeb\x42\xff\xfe\x24\x7a\xcd\xd9\xc2\xdb\x0d\x87\x7c\x85\x6f\x68\x5c\xba\xd7\x7e\xe7\xae\xf6\x45\x2e\x50\x85\x05\x34\xc1\x00\xd0\xbb\xa3\xff\x73\x88\xb6\xd9\x25\x91\x7f\xdf\xe6\x87\xa8\x35\xb1\xe1\x05\x2e\x7a\xb1\xea\x17\x22\x68\x73\xd3\xe4\xd7\x1d\x85\xb4\x5a\x52\xff\x4e\x90\x78\x92\xda\x77\x21\xe5\x43\xa6\xfd\xec\x62\x96\x2a\x29\x79\x3d\xd8\x5c\x62\x56\xb2\x41\x1e\x98\x6d\x5e\x1a\x49\xd2\x74\xec\xa7\x5b\xd4\xcf\xb6\x90\xc8\xa0\x78\x27\x84\x08\xc4\x1e\x05\x42\xac\x91\x20\x9e\x57\x38\xaf\xbb\xca\x32\xfe\x47\xfe\xed\x16\xc6\x82\xb0\x98\xa5\xd1\x5d\x78\x21\x84\xb4\xf5\x3f\x18\x2e\x5a\x0b\x0a\x96\x8e\x25\xc2\x0f\x1a\xdd\xbf\x2d\x10\x61\xbf\x6b\xfe\xb4\xc4\x90\x01\x76\xf7\xe2\x35\x6b\x79\xda\xfd\xb2\x3b\xb8\x9c\x3a\xfc\x9f\x02\x19\xbb\xa0\x21\x60\xa3\x3f\x79\xa2\x23\xd7\x38\x04\x64\x69\x72\xbf\xc5\x2f\x80\xe4\x35\x52\xcf\x3f\xe2\x88\x23\xa9\xef\xa0\xb7\x73\xff\xc1\x27\x20\xda\xff\xbd\x3b\x7c\x7b\xce\xd6\x2c\xc2\x57\x3b\xca\x7b\x7a\x69\x53\xfa\xad\x3d\x60\xef\xbf\xf1\xd1\xbd\xa5\xad\x38\xac\x17\x8f\xe3\xfb\x3e\x9d\xe9\xc8\x4f\x16\x7c\x14\xf1\xd6\xf9\xfb\xbc\xb8\xcb\x95\x62\x4a\x6d\x63\x89\xbb\x83\xba\x97\x8a\xd0\xc9\xe9\xf7\x87\xa7\xe8\x3f\x7e\xee\x45\xa5\xde\x7d\x86\xde\x1d\x9c\x9d\xf3\x52\x0f\x7f\x26\xc6\xd6\xff\xd8\x61\x1c\xbc\x0e\x73\xc6\x0c\x52\x7e\x92\x47\x66\x0f\x1e\xb1\x2b\x7a\xaf\xea\xf2\x7e\xde\xbc\x56\x7c\x9d\xe5\x75\x15\xe1\x28\x9a\xac\x3f\x59\x06\xf7\x35\x99\xb1\x0e\x4e\x7f\xf8\xcf\x5f\xf6\x7e\x65\xbf\x0c\x2e\x43\x55\x45\x59\xb3\xf8\xfc\xb2\xff\xf6\xd5\xab\x2d\xa4\x7e\x3f\x9b\x2a\xf6\xe5\x6b\x2e\x72\x22\xdd\x7b\xdc\x36\x28\xa2\x76\x25\x8c\x78\x3b\xcc\x0c\x62\x20\x25\xdc\x09\xf3\x87\xc0\x29\x7a\xd9\xbc\x7c\x25\xb6\x2d\x0b\xf2\x70\xa9\xd8\x0f\xb0\xca\x27\xd1\xb8\xf9\xc0\x38\xfb\x06\x01\x3b\xb7\x7d\x8d\xb6\xb7\x5f\x89\xad\x24\xe3\x32\x2f\xb2\xb4\x22\xa1\x30\x59\x92\x4f\x8f\x76\xf6\xc4\x4e\x65\xe5\x2d\xfe\xfa\xa6\x89\x6d\xfd\x74\xd2\xe5\x92\x76\x9b\xb3\x33\xe6\x89\xea\xd6\xcf\x56\x45\x95\x91\xa9\xf3\x33\x0d\xc0\x65\x51\xde\x24\xdd\x93\x80\xec\x37\xf5\xf1\x32\xfa\xfe\xf0\xed\xc1\x4f\xef\xce\x11\x39\x0f\x1f\xb6\x8a\x68\xc5\xd7\xab\x5a\xd2\x2d\x86\x3d\x09\x5a\x78\xec\xbf\xce\x6e\x70\x55\x27\x37\x2b\x9a\xa0\xa2\x7f\xa2\xbf\x17\x39\xee\x7a\xd3\x44\x19\xeb\x28\x7e\x9d\xc4\x4c\x84\x3e\x97\x7a\x7a\x70\x74\x7c\xde\x56\x46\x0b\x4c\xce\xe9\xdb\xf7\xe8\xcd\x9f\x0e\xdf\xfc\x1f\x34\x9b\x09\x6d\xe8\xbb\xd7\x68\x6f\x67\x67\x6b\xc7\x1a\x00\x38\x86\xc8\x47\x36\x16\xd7\x24\xed\x98\xd6\xc5\x62\x36\x1c\xd9\xdd\xe1\x02\xc1\x35\xf3\xd1\x4d\x07\x9f\xef\x3f\x15\xbf\x81\xf2\xf9\x33\xf4\x47\xf4\xe6\xe4\xe0\xdd\xe1\xd9\x9b\xc3\x7e\x7a\x6c\x37\x7a\x14\x6c\xf6\x07\x3e\xa7\xb3\xa5\x2c\x1f\xa7\x9b\xb9\xf6\x01\xb7\x59\x6b\x4d\xe4\x3d\x32\x74\x72\x4a\xaf\x31\x27\x75\xc2\xd2\x50\xf4\xcb\x2b\xeb\x26\x15\xb1\xb3\x8b\xf6\x80\xef\xa7\xd7\xe5\xd5\x7c\xf8\x98\x45\x94\xb7\xd8\x86\x68\xf5\xdf\x35\x85\xaf\x06\x95\x0c\x93\x43\x80\xb1\x98\x26\x1b\x8c\x3a\xb9\x0a\xe3\x7a\x9d\x67\xbf\xad\x31\x79\xab\xf0\x26\x29\xef\x9b\x9b\xad\xe4\x8d\x9f\x18\x55\xf0\x0d\x52\xf2\xd8\x7f\x13\x45\x87\x4f\x14\xf6\x14\xe9\x02\x56\xd1\xa7\x89\xca\x5b\xc2\xc5\x95\xb6\x89\x8b\xd0\xc4\x16\xb3\x14\xfd\x1b\xfb\x91\x5b\x3f\x90\x06\x42\x86\xbb\xb3\x1a\x27\x59\x33\x50\x1e\xbb\x19\xf9\x71\xd5\xd7\xd3\x1c\x59\x81\x84\x90\x70\x07\x74\x14\x25\x36\xb7\x0d\x3f\xa6\x0e\x39\x92\xa3\xaa\x90\xd2\xe1\x2f\x55\x8e\xa2\xc0\x2b\x9c\x97\xf8\xe3\x2a\x90\x23\x39\xaa\x02\x29\x9d\x06\x9e\xfe\x7b\x14\x05\xf2\x77\x1d\x3f\xa6\x16\x65\xba\xa3\xaa\xb2\x27\x36\xb8\xe1\x39\x8a\x52\x9b\xbb\x84\x1f\x53\x9f\x1c\xc9\x51\x55\x49\xe9\xf0\x57\x26\x47\x51\x60\x73\x4f\xeb\x63\x2a\x90\x23\x39\xaa\x02\x29\x1d\xfe\x3a\xda\x28\x0a\x94\xde\x30\xfc\x58\x4a\x94\xc8\x8e\xaa\x48\xf9\xc3\x6d\x9a\xc7\xff\xa2\x29\xb4\xb9\x3d\xf4\x71\xd5\xc9\x11\x1d\x59\x99\x6d\x32\x8b\xbb\x2a\x33\xaa\x22\x9b\x6b\x38\x0f\xa1\x4e\x8e\xf4\xc7\x50\x6a\x97\xf3\xd3\x7c\xdd\x38\xb2\x82\xdb\x8b\x2f\x1f\x53\xb3\x3c\xcd\x51\x55\xca\x08\x09\x57\x7c\x46\x51\x22\xbb\x5e\xf2\x31\x55\xd8\x53\x1c\x55\x81\x84\x0c\x77\x85\x26\x8e\xf2\x94\x1f\x8f\x8b\x52\xd0\xf2\xf9\x3e\xfa\xb7\xff\x41\x87\xdb\x7f\xfb\x5b\xf5\x8f\x67\xbb\xff\xda\x1e\xa4\x53\x0e\x7e\xf8\xe1\xf4\xf0\x07\xf2\x2f\x9e\x21\xf6\x59\x9f\x64\xb1\x58\xdf\xcc\x92\xfc\x1e\x2f\x69\x8d\xe0\x4e\x5b\xa6\x46\x84\xe8\x3e\xfe\x93\xac\x48\x8a\x8b\x25\xc8\xce\xce\x7f\xfe\xf3\x21\x7b\x80\x8f\x36\xee\x36\x27\xf8\x47\xe7\x6f\x4e\x8e\xbf\x27\xf9\xcc\x7f\xfc\x6b\x7b\x6b\xc7\xce\x40\x53\x3c\x14\x9d\x36\xfb\xf1\xed\xd1\xf1\xc1\xbb\x06\x11\x4f\x75\xce\xc8\x8a\x0c\x52\xe5\xa2\x45\x71\x4b\x0b\x0a\xea\x39\xc9\xca\x67\xb7\x98\x6c\x6b\x59\xf1\x88\x25\xbb\x4a\x53\xa1\x64\x30\xa5\xdf\x57\x49\x89\x73\x29\x6d\xba\xb8\xce\x96\xe9\xbc\x28\x53\x5c\x5a\x93\x91\x29\x26\xe7\xae\x2b\x9a\x4b\x23\xe8\xd9\xaf\x57\x59\x4a\x2f\x2d\x74\xc0\xa2\x30\x67\x87\xff\xf7\xa7\xc3\xe3\x37\x26\x79\xe6\x59\x3a\xaf\xf0\x6f\xad\x39\xf1\x35\x5f\x67\xe7\x07\xa7\xe7\xe8\x2f\x47\xe7\x7f\x6a\x8e\x40\x8e\x8e\xdf\x9c\x1e\xfe\x78\x78\x7c\x4e\x0e\x59\xd8\x4f\xc7\x27\xe8\xc7\xa3\x63\x5a\x8b\xd4\xfd\x7d\xf0\xd7\xfe\xef\x37\x07\x6f\xfe\x74\x48\xbe\x8b\xb4\xb5\xb5\x75\xf0\xee\xfc\xf0\xd4\x81\x27\x74\xf2\x97\xe3\xc3\xef\x09\x2d\x3d\x28\xfb\x3c\x87\x75\xf4\xba\x5f\xfa\xe1\xbb\x20\xdf\xa4\xae\x9d\x72\xe3\x8b\xe2\x86\x7d\xe2\x9a\x0c\x2f\x9f\xdc\x56\x8c\x37\x2d\x10\x51\xa3\xa1\x03\xce\x67\xb9\xa5\x91\xa6\x77\x53\x56\xcb\x22\x49\xc1\xc9\xed\xa0\x64\xfa\x4d\x76\xd3\x94\x4d\x29\xe4\xb8\xcc\x96\xb8\xca\xfe\x8e\x45\xcb\xad\xaf\xd7\x37\x17\xf3\x67\x5f\xee\xcd\x4d\xed\x5f\xee\x99\xdb\xf7\x9f\x69\x01\xb8\xdc\x7b\x3f\x9a\xae\xe9\x77\x03\xaa\x76\x0c\x24\x2c\xed\xcf\xe8\xbb\x61\xfa\xde\x6a\x5b\x52\x7c\x50\x1a\x58\x63\xde\x83\xa1\x87\x91\xca\x6e\x92\x2b\xcc\x93\x31\x0e\x5d\xb5\xbe\xbc\xcc\x3e\x88\x2d\x30\x3a\xe2\xd2\x4f\x76\x1e\xa1\xd5\xf1\x90\x49\x45\x3f\x2d\x16\x34\xb9\x40\xdf\x13\x7d\xda\x3c\x45\x4c\xff\x83\x3f\x24\x37\xab\xa5\x35\xe6\xd2\x4f\xdd\x54\xd7\x38\x6d\xe7\x4d\x6b\x4c\x76\x60\x83\xe6\x50\x1f\x9e\x11\x96\x88\x7c\x78\x3e\xb8\x4c\xd4\xc3\x33\xc3\xd2\x38\x0f\xcf\x07\xcb\x86\x3c\x3c\x1f\x7d\x42\x61\x0a\xbc\xb0\x28\x31\x1d\x4e\x58\x34\x7b\x78\x7e\x9a\xed\xdf\xc3\x33\x42\x4a\x31\x1f\x9e\x0b\xba\x95\x7b\x60\x36\xd8\x41\xd9\x24\x62\x3d\xe3\x61\x0a\xd1\x9e\x72\x32\x9d\x78\x4f\xd9\x99\x44\xc4\xa7\x9c\x4c\x22\xe6\x8b\xdf\x09\x9b\x08\x37\x93\x88\xfb\x02\x2f\x93\x88\xfc\x94\xa3\x69\xc4\xfe\xf6\xe3\x1d\x93\xe0\xe3\xe1\xe3\x7f\x13\x6d\x1f\x3e\xe6\xb6\x37\xcb\xa6\x11\x72\x19\x37\x13\x88\xb8\x8c\x91\x09\x04\x5c\xe9\xeb\x89\xd3\x60\x66\x02\xe1\x56\x64\x65\x02\xd1\x96\x31\x34\x85\x60\xdb\x7d\xf9\x68\x0a\x6c\x3c\x7c\xa8\xe5\x62\xdb\x54\xc2\x1c\xc7\xc7\x04\x62\x1d\xc7\xcd\x04\x02\x9e\xea\xa3\xae\x13\xe2\x68\x02\xa1\x4f\xc1\xcf\x04\xe2\x1f\xc7\xd5\x14\x82\xa0\xf8\x3d\xb7\xc9\xf0\xf2\xf0\xe1\x90\x05\x9c\x09\x84\x1d\xc6\xc2\x04\x22\x8e\xf4\x9d\xe7\x69\x30\x33\x81\x38\x23\xb2\x32\x81\x10\xc3\x18\x9a\x42\x74\xe9\xbe\xfc\x38\x05\x36\x1e\x3e\xa6\x30\x27\x9e\x80\x2b\x4b\x1f\x56\x9f\x06\x33\x13\x70\x65\x91\x95\x09\xb8\x32\x63\x68\x0a\xae\xdc\x7d\x95\x75\x0a\x6c\x3c\xbc\x2b\xf3\x9f\xdb\x9f\x84\x17\x0d\xbf\xff\x3f\x31\x76\x26\xe0\x4d\x3d\x53\x53\xf0\x28\xe1\xc3\xc5\x53\x61\x65\x0a\x9e\xc5\xdb\xcb\xa4\xac\x87\x67\x65\x1a\x16\x24\x7d\x96\x7a\x4a\xec\x4c\xc7\x92\x26\x12\x10\x45\x66\x26\x64\xd0\x53\x32\xe5\xc9\x18\xf1\xc3\x9b\x6f\x33\x2c\x53\x18\x9d\xfe\x43\xf6\x93\xe0\xe3\xe1\xc7\xa6\xf9\xf8\xfe\xc3\x33\xf1\xf0\xaa\xa0\x1c\x3c\x38\x1b\xdd\x53\x94\xc2\xef\x36\x56\x44\x60\x55\xad\x38\x13\xa9\x6a\xde\x88\x48\x51\x75\x93\x2c\x97\x59\x5e\x2b\x4a\xb6\x55\x5c\x36\x97\xd4\x3f\x99\xdb\x0a\x7a\x79\x1e\xee\xb6\x82\x95\xa7\xfe\xb6\x82\x1e\x54\x79\x5b\x61\x08\xde\xfd\x62\x29\x26\xa7\x70\x9b\xbb\x0a\x13\xba\xab\xd0\x8f\x65\xf0\x5d\x85\x1e\x95\xf7\x5d\x05\x83\x65\xc5\xbe\xab\x20\x5c\x27\x5b\xd6\xb8\xcc\x93\x3a\xbb\x6d\xbf\xa2\x6b\x0b\x43\x0d\x94\xba\x91\x47\x67\xbe\x9f\xa3\x7e\x86\x6a\xa0\xd9\x21\x7f\xf4\xf1\x33\x49\xbd\xf4\x9b\x43\x3b\x8d\xbf\x7c\xfb\x5d\xe7\x3b\xb2\xaa\xbb\x08\x61\x56\xc1\xc3\x45\x2e\x10\x5f\x7d\xf4\x32\x83\x2b\x23\x98\x85\x02\x7b\xeb\x69\x36\x18\x4c\xe3\xa8\xb3\x4e\x76\x8b\x50\xc3\xb9\x98\x68\x6b\x53\x16\x1b\x1d\x4c\x5e\x90\x1b\x63\x26\x8b\x14\xbe\x31\x21\x85\x1c\xe2\x9c\x6a\x8c\xcb\x24\xbf\x5a\x27\x57\xba\x66\x36\xed\x5a\xe6\x84\x01\x43\x33\xf2\x7d\x0f\xa7\xe7\xda\x34\xee\xd4\xee\x6b\xc6\xf4\xa7\x96\xc6\xe4\x1c\x4a\x64\xcc\xee\x51\xfd\x43\x98\xce\xc6\xfa\x88\x17\x77\x10\xc9\xa6\x3b\xb6\xca\x05\x1f\xa4\x93\xd3\x28\xf3\x11\xd3\x3c\xbc\xee\xb1\xa5\xff\xa4\x8a\xc3\xfb\x87\x03\xe6\x64\xe7\xd6\x7a\x37\x7b\x06\x90\x36\xf3\x9f\x35\xd9\x41\x27\xa7\x68\x26\xb2\xc9\x37\xef\x38\x87\x05\xc6\xd6\xe4\x0c\x87\x67\xcb\x6e\x31\x14\xda\x6e\x2a\x79\x5e\xc0\xb6\x9e\xcd\x2b\xd9\xea\x46\x3a\x56\xbd\x03\x2f\xae\x93\xfc\x0a\x2f\x8b\x2b\xcd\xfc\xd0\x40\xd1\xef\xc1\x40\xd7\xfa\x90\x31\xec\x84\x99\xc8\xe0\xc9\xfc\x68\x46\xad\x03\xb3\x0f\xd7\x6a\xb5\xcc\x16\xa0\xf1\x2a\xee\x72\x5c\x3a\x86\xf3\x22\x59\xd7\xd7\x64\x9d\xae\x6d\xab\xf0\xa2\xc4\xb5\xbe\x9d\xb8\x5f\x89\x17\xa4\x38\x2b\xa3\x50\x90\x61\xeb\x85\x9a\xc8\xb8\x0d\x18\xd2\x0c\x5c\x0f\x67\x1f\xb9\x12\x27\x9e\xeb\x42\x75\x74\x56\xec\xb0\xc4\xe8\xfc\x91\x9e\xc1\x95\x9f\x73\x6e\x13\x4e\x83\x56\xf6\xb8\xb2\xb6\x99\xbc\x9a\x2c\x36\x8a\x2f\x37\x6b\xda\x54\x58\x85\x97\x98\x07\x4d\x5c\x1e\xaf\x15\x87\xbe\x87\xfc\x71\x56\xb8\x25\x4e\x06\x13\xde\x4c\x94\x74\x30\xb7\x49\xc2\xea\xdb\x89\xbc\xc2\xd4\xc7\xa6\x4c\x26\x74\xf3\x42\x2e\xeb\xa2\x20\x49\xd1\xb5\xf0\x32\x3d\x45\x63\x4b\xac\x99\x63\xd5\xa2\x86\x3d\x73\xac\x76\xa3\xe6\xb1\x1e\x8b\x33\x11\x48\xa7\xe5\x8e\xec\x10\xec\x4d\x9f\x7e\x52\xfb\x48\xfe\x34\x74\xe4\xfe\x4d\x75\x1b\xcf\x8f\xcb\x17\x15\x2f\x2d\x41\x1c\x13\xee\xc2\xb2\x31\x52\xbb\xf9\x3d\x79\x1f\x13\x38\x38\x7f\xd8\x0e\x94\xac\xb9\x66\xd0\x44\x0e\x15\xa3\x7a\x74\xc6\x1e\xd0\x6a\xa4\xe7\xba\xf5\x6b\x74\xc8\x3a\xa1\x17\x69\x1a\xcb\x04\x99\x1f\xcd\x2a\xa1\x03\x03\x2d\x12\x1a\x8c\x9f\xc8\xae\x5c\x14\x68\x72\xe3\x66\xdf\x83\x8b\xb0\xc0\x11\x94\x37\x55\x86\xb9\x88\x83\x75\xce\x3c\x52\x5a\xdd\x27\xc8\xfc\xe7\x43\x11\xd1\xdc\x90\x32\x54\x40\xce\x93\xe5\xb2\xb8\xc3\x69\xf3\x11\x32\xc1\xe8\x64\x70\x71\x97\x38\x88\x56\x1d\xa0\x1c\x68\xec\x64\xc5\x18\xa4\xa2\x2b\x46\x66\x1a\x85\x61\x68\x4f\xce\x21\xa8\x1d\x32\x0d\x22\x86\x09\xb9\x84\xc4\x93\xc9\x23\x5a\x50\xa0\x43\x88\xb6\xe5\x95\x8d\x62\xcb\xee\xd1\xd6\xe8\x97\x25\x6e\x46\x53\x7d\xc8\xff\x20\x31\x15\xa4\x4e\xc9\x64\x01\x61\x00\xe6\xeb\x0c\x5d\xcf\xeb\xd4\x66\x15\x83\x12\x26\xe9\x54\x5a\x36\x21\x7e\xa6\xe8\x2d\xb9\x1e\x98\x85\xc9\xea\xc6\x51\x1b\xb0\xd0\x73\x95\xa5\x5d\x52\x0a\xcd\xd4\x26\xd7\x84\x1d\x7c\x37\xd7\xba\x4d\xfc\x5c\x25\x61\x6e\x3a\x43\x61\xd3\x3d\x4c\xd9\x75\x72\x65\x5f\xf1\x10\x20\xdd\xa1\xe2\x5a\x5b\x84\xe2\xb1\x9f\x06\xc5\x52\xfa\xf5\x9c\xe4\xce\xce\xb6\x39\xff\xad\x95\x29\xab\xe6\xeb\xd5\x6d\x51\x0f\xf7\xb6\x64\x3f\xe8\x16\xf7\xe3\x4e\x9e\x13\x8b\xe7\x13\x8b\x4d\xb0\x88\x04\x8c\x43\xe4\x68\x6a\xcc\xe4\xef\xa7\x9a\x21\x1a\xe6\xc2\x78\x07\x6d\xf4\x86\x73\xce\x5a\xa3\xaf\x0b\x3f\x52\xd6\x0f\x9e\xd4\x62\x83\x30\xd4\x03\x51\xe3\xf0\x57\x61\x8f\xc5\xde\x83\x0b\xcd\x06\xb5\x78\x08\xc7\x9f\x66\x32\xcd\x1e\x8c\xb9\xaf\x61\x59\xd7\xdb\x04\x76\x93\x88\xde\x24\xa2\x05\xef\xf9\x7d\xa5\xa2\x39\x91\x1f\x45\x32\x7a\x40\x94\xff\xa4\x2e\x89\xb2\x73\x7c\xb3\xaa\xef\x7b\xea\xd4\x57\xbe\xfd\x0e\x3d\x6f\x94\x4a\xff\x7e\x8d\x9e\x37\x6c\xcc\x3a\xcf\x69\xeb\x5a\x5e\x23\xbe\xcc\xa5\x01\x93\xbd\x46\x94\x65\xe0\x35\xda\x66\x7e\x40\xa4\xb1\x8a\x32\xc8\x76\xdd\x32\x08\x69\x48\xa0\xe9\x31\xce\x5a\x26\xb2\x1e\x1c\x70\xa4\x5b\x12\xf6\x80\xc0\x55\xe1\x27\x96\xed\x97\x44\x9a\xe0\xf8\x01\xd6\xf5\x12\x34\x78\x24\x87\x59\x7f\xc3\xd4\x1f\x94\xf7\x67\xf4\xe0\x99\x7f\x13\x1f\x12\x32\x73\xf6\x5f\x01\x6b\xce\xff\xcb\x1d\x0c\x27\x00\x12\xe8\xf0\x0c\xc0\x4e\x5c\x3e\x05\x50\x51\x57\x9e\x03\xc0\x50\xcb\x27\x01\x3a\xf4\xee\xc1\x6e\x6a\xa7\x01\x4a\xae\xcc\xfe\xe2\x70\x22\xa0\xb2\xb8\xcd\x99\x40\xc0\x99\x80\xdd\x7c\x41\x21\xe2\xf1\x9f\x0b\x58\x15\x31\x51\x07\x73\x3e\x1b\xb0\xf6\x87\x9d\x0e\xa8\xd0\x4c\x58\x43\xce\x3a\x81\x06\xa3\xa6\x6c\xdc\x2b\x08\xa9\x5d\x89\xe5\xb2\xbb\xdb\xb7\xf2\x4d\x1f\x6d\xe1\xbc\xcf\x71\x83\x4f\x5a\x60\x38\xf5\x32\x1d\x04\x56\xec\x99\x06\xb3\x21\x30\x25\xfb\x12\x58\x32\xda\x15\x83\x74\xb3\x27\x96\x2b\x99\xe9\xaf\x51\x48\x63\xf2\xd9\xaa\xa8\x32\x12\x07\x3f\xd3\x19\x4e\x78\x36\xe9\xbf\x8b\x2c\x9f\xaf\xae\xcb\xa4\xc2\x96\x0b\xbd\xc0\x69\x67\xba\x07\x6c\x94\xbd\x29\x59\x9b\xdd\xcc\xa0\xf6\x95\xad\x32\xc8\x26\x83\x80\x75\x06\x31\xdb\xdf\x0f\xbb\x30\xed\x13\x94\x86\x61\x26\x5b\x65\xd1\x72\xd0\x04\x17\xf9\x3f\x11\x03\x91\xfa\x7f\xd0\xf6\x7f\xfd\x2d\xfd\xc7\xfe\xfe\xbf\x3e\xd7\x5c\x0e\xd4\xeb\xb6\xca\x61\xca\xad\x72\x41\xbb\x2f\x26\xa1\xdd\x2a\x8f\xa8\x5e\x82\x8c\xfe\x47\x52\x70\x95\xf7\x1a\xfe\xf2\x5f\xbf\xfc\x2d\xfd\xeb\xaf\xce\x6a\xbe\xc1\xb5\xf5\x42\x44\x99\xd4\x84\x4b\x31\x5b\xcb\x7e\x14\xe7\x4f\x9d\x04\x84\xc8\xbc\xed\x20\x6e\x28\x1b\xdc\x54\x03\x6c\x43\xd7\xfc\xf2\xed\x6b\xb4\xbf\xb7\x07\x3f\x06\x68\xd0\xf3\x87\xc8\x06\xab\x31\x1e\x23\x4b\xf2\x5a\xa7\xec\x9e\xf4\xd8\x42\xf2\x27\xfb\x06\xe9\x26\x74\xb6\xdf\xb2\x1d\x65\x60\x3e\xc2\xf9\x3e\xe3\xf7\x93\x3d\xe1\xef\xc4\x9b\xd4\x8c\x0c\xdd\x55\xc0\xf6\x12\xeb\xba\x60\x56\x34\xc7\x4b\xbc\x80\x5c\xd2\x5b\x24\x79\x9a\x11\xbb\xd7\xb4\xaf\xca\x62\x55\x54\xda\x7b\x7c\x15\x5e\x14\xe4\xf4\x7b\xbe\x2f\x1d\xd4\xb5\xbf\x3f\x93\x7e\xaf\x93\x7a\x5d\x0d\xc6\x7d\x5f\x42\x7b\x8f\xab\x39\x31\xe9\xca\x6a\x21\x79\x01\x04\x6c\x04\x99\x53\x77\xf6\x7a\xdc\xa5\x58\xe1\xdc\xdc\xbd\x51\xe9\xd2\x4a\x67\x18\x4e\x87\x23\x37\x67\xba\x92\x02\x2a\xfb\x11\xbd\x46\x07\xc7\x3f\xa3\xd9\xc1\xe9\xe9\xc1\xcf\xbf\xec\xef\xa2\x67\xbb\xe8\xf9\x2e\xfa\x62\x17\x7d\xb9\x8b\x5e\xfc\x0a\x4c\xfd\x29\x68\x4e\xc3\x35\xb4\x8c\x69\x5c\x64\x08\xef\xe3\x2a\xd4\x90\xac\x79\xaa\x61\x3f\x5d\x96\xaa\xa8\x71\x69\x68\x33\x34\x05\x18\xa9\xc5\xa6\x28\x76\xfa\x1f\xd1\xac\xc8\x4f\xa2\x51\x6d\x3f\xd9\xdf\x7e\xf9\xb2\x75\x5e\xb4\xb7\x8b\xf6\x03\xec\x8a\x92\x9c\xac\x71\x71\xdc\x81\x2d\x8c\x76\xb2\x9a\xd9\x22\xad\x8b\x85\xcd\xa6\xd2\xac\x5a\x64\x29\xb7\x92\x7f\xf6\xf5\x8e\x22\x11\x9c\x5e\xcc\x05\xa8\x01\x50\xf3\x98\x81\x69\x9d\x83\x93\xb4\x58\xd7\xf3\xe2\xf2\xb2\xc2\xb5\xe9\xb5\x1a\x09\xe4\x97\x5f\x07\x33\xf3\x55\x99\x80\x6f\xb2\xc6\xde\x5a\x53\xb5\x4e\xc3\x9e\x78\x56\xd4\xc6\x43\x21\x60\x96\xc2\x2d\x15\xf5\x5b\x12\xe3\x23\x3d\x00\x5b\xfa\x38\x66\x02\x1e\x45\xb2\x77\x98\xd0\x48\xf6\xec\x18\x46\x93\x40\xd9\x47\x94\x68\xb8\xbc\x9f\x73\x17\xef\x95\xd5\xbd\xb6\x95\x79\xf3\x90\xe2\x1c\xe7\x75\x56\xdf\x9b\x33\x5c\x69\x52\x27\xe8\xbf\xab\x22\xbf\x90\x1b\x1a\x24\x49\xed\x3a\xaf\xd8\xd8\x83\xe4\xad\xcd\x5b\x1b\xb2\xe5\xd0\xec\x38\x9b\xe5\x8e\xa6\xb5\x0d\xcb\xa6\x37\x26\xa1\x6b\x37\x55\x76\x03\xbc\x92\xc3\x1f\x56\x59\x69\x81\xb4\xbc\x7d\xc5\x7e\xfd\x6d\x9d\x2c\xc9\x20\x0f\x04\xda\x77\x1a\x0f\xde\xe6\x0c\xe9\x5e\x2f\x73\x6c\xf0\x73\x15\xc5\x46\x0a\x86\x0d\xaf\x6e\x70\x41\x1c\x50\x4b\xb7\xd2\x57\xf8\x03\x08\x3b\x7b\x8d\xd2\x8a\x5e\xfd\x68\x25\x20\xf4\x4d\xe7\x1c\xc2\x7a\xfc\x00\x3a\x75\x60\x58\xba\xaf\x51\xd9\x35\xc7\xc1\x7a\x19\x20\xfd\x40\x8d\x9d\x0c\x03\x1b\xc1\xfc\x72\xc0\x5e\xc1\x18\xf5\x0c\x4c\x77\x35\x2a\xd2\xef\xab\xa2\xaa\x5d\x82\x18\xd4\x0e\xf3\xc9\x2c\xce\x65\x76\xf4\x16\x49\xa1\x60\x66\x49\xf1\x95\x78\x91\xad\xb2\xde\x34\xfb\xbf\xf5\xc3\x43\x7b\xfa\x99\x27\xfd\xe8\x8a\xdd\x3c\x19\x98\x17\x85\xee\x03\x14\x76\x2a\x3d\xa8\x27\x25\xfe\x4d\x49\x23\x1d\xf5\xca\xd4\x85\x0a\x7b\xe5\x1f\x4c\xab\x01\xf7\xa2\xc8\x9e\x46\xb7\x93\x6a\xe0\xbc\x68\xac\x4b\x40\x90\x22\x40\x5e\xd8\xc9\x1b\xe2\x76\xf4\x14\xca\x07\x7f\x51\x7a\x66\x82\x5f\x7c\x31\x48\x04\x97\xd9\x6d\xa5\xab\x35\xc0\x37\x49\xb6\xd4\xa0\x69\x41\x09\x2a\x45\xbd\x54\x23\x21\xbe\xa8\xb2\x1a\xdb\x2a\xae\x8c\x38\x2e\xb2\x82\x4b\x31\xdf\xe0\x9b\x0b\x5c\xce\xab\x2c\x5f\xb8\x2d\x19\xa9\x2c\xf3\x45\x91\x5f\x66\xe5\x0d\x2d\x5c\xb6\x2c\x1d\xe9\xd1\xc3\xb2\x68\x4b\xa7\x9d\x88\xf9\x3f\x99\x95\x95\xf5\x35\xa3\x47\xfe\xa3\xbf\xc7\x34\xbc\xcf\xb3\x4a\xaa\xea\xae\x28\x53\x85\xb2\xf7\x87\x7b\xcd\xeb\x64\xbf\x07\x9c\x3d\x7f\xb6\xa3\xde\x96\x58\xb2\x08\x30\x63\x9d\x2f\x8a\x25\x30\xeb\xad\xd9\x3d\x19\x27\x6c\x50\x71\x03\x7d\xa9\x7f\x01\x4a\x8a\xc8\x67\x1b\xb6\x87\xcd\x95\x05\x71\xce\x9a\xe1\x77\x06\xfd\xaf\x1e\x2f\x76\xa4\xa9\xa3\xcd\x71\xb5\x25\xd6\x93\x68\x87\xf7\xde\xf9\x57\xc6\x95\xc2\xce\x5b\xba\x52\xca\x93\xe3\x07\xf6\xf2\x97\x0a\x3d\xb7\x0b\x02\x68\xd3\x54\x05\xfd\x48\xf4\x49\x44\x18\x51\xa3\xe4\x9f\xc9\x45\x51\x26\xfd\xe4\x63\xd5\xab\xc6\x6b\xdd\x89\x2b\x13\x2d\x56\xf2\x9a\x58\xf2\x38\x06\x54\x14\x79\xc4\x81\xe5\x37\xd4\xf6\x11\x35\x7c\x0b\xe2\x71\xe8\x95\x4a\xe0\xa9\x4e\xed\xce\x48\x24\x31\x99\x0d\x9b\x8a\x2d\xfd\xc6\x4d\x80\x06\x6d\xe0\x24\xfc\x72\x92\xc1\x6a\x4d\x86\x54\xc3\x63\x32\x29\xfe\x63\xfa\xa3\xb9\x29\x9f\x56\xb1\x2a\xd6\x94\x5c\x79\x1c\x3a\x6d\xbe\x14\x3e\x9a\x3a\xf9\x34\x80\x55\x9d\xea\x64\xc0\x63\x52\x67\xf3\xb5\xe6\xd1\xd4\x29\xe7\x3c\xac\x2a\xd5\x67\x3e\x1e\x93\x5a\x3b\x29\x46\x55\x2d\x9f\xe4\x01\x28\xd6\x74\x08\xf9\x58\xd4\x4a\x65\x18\x5f\xa9\x42\x4e\x0b\xaa\x5a\x4d\x66\xeb\x31\x2a\x98\x4a\x32\xa2\x9a\x85\x44\x9e\x55\xbf\x9a\x74\xde\x63\x52\x2c\x13\x61\x44\x8d\xc6\xad\x02\x6d\x36\x17\x14\xe9\xb0\xc3\x97\x7b\xd3\xad\x1a\x55\x6b\x66\xaa\x4b\x6f\x6b\x2d\xa9\xba\x8b\xc7\x22\x9c\x4b\x3c\x5b\xfd\x4d\x99\x7e\x7e\x4c\xde\x46\x04\x18\x61\x2b\x37\x25\x23\xb2\x1b\x0d\xd4\x48\xba\xb2\x86\x99\x3d\xa3\x6a\xf9\xfc\xd7\xe5\x72\x8d\xf3\xc5\xbd\x40\xa5\xfd\xcd\x31\xc9\xc3\x3e\x0d\x52\x17\xef\x71\xd8\x57\x66\xf8\xaf\x9f\x68\xeb\x43\xaf\x8b\x32\xfb\x7b\xd2\xa4\xb3\x52\xfe\xa2\x72\x89\x2f\x4b\x5c\xb5\x8c\xf4\xbf\x27\x8b\x05\xae\xaa\xc1\xcf\x1e\x55\x25\xd5\xa2\xe0\xb2\xc4\x3a\xd7\xb8\x2a\x93\xbc\x76\xff\xc6\x25\xdc\xb6\x39\x7d\x4f\xca\xce\x87\x7c\x19\x03\x25\x07\x0e\xb5\xff\x55\x89\x2f\x71\x89\xf3\x45\x58\x75\x80\x66\x72\x1d\xce\x95\xec\x2e\xfc\x10\x72\x7f\x8f\x03\x85\x0f\x5c\xcf\xfe\xa4\xc6\x6d\xc0\x96\x71\xd8\x7a\x68\xe8\xa8\x55\xeb\x0b\xb2\x64\xb8\xc0\x73\xd8\x53\x89\xe6\x20\x61\xca\xf3\xd3\x23\xbb\xe6\xf8\xd9\xb3\xf6\x45\xc1\x6f\xf7\xb1\x65\x40\xd4\xd5\x55\x0e\x32\x0c\xf3\x8b\x7b\xdf\x7a\x26\x15\x5f\x53\x32\x22\x0d\x73\x46\x53\x92\xfb\xb8\x1b\x14\xfc\x50\xd2\x38\x6a\x80\x64\x9c\xd1\xb0\x1a\xd3\xbc\x4d\xb2\x65\x72\xb1\xb4\xdc\x15\xe3\x30\x56\x18\xe7\x73\xc3\x52\xdf\xc7\x36\x26\x9a\xc4\x36\x30\x08\xb4\x11\xf7\xc4\x76\xdf\x17\x56\x70\x61\xb4\x91\x0e\x59\x3a\xb7\xac\xbb\x1c\x62\x10\x4c\x63\x13\x5c\xd3\x6a\x98\x03\x0e\xa5\xdb\xaa\xb7\xef\xc7\x27\xd0\xfd\x46\xd1\x94\x55\x8f\x3d\x7b\x50\x5a\xd3\x9b\x3c\x18\x5b\xd3\x34\x26\x9e\x37\xa0\x2d\xd1\x2e\xee\xa6\x24\xa4\xb5\x3c\x23\x82\x29\xd7\x15\xdb\x98\x18\xb1\xe9\x59\x53\xc3\xd7\x34\xcd\x49\x60\x0e\x68\x4f\xac\x0f\xd4\xa0\xee\x92\x7a\x71\x2d\xae\x6b\x7d\xae\xa1\x6f\xed\x38\x50\xeb\x57\xe0\x15\xc4\x0e\xf2\xa2\xce\x2e\xef\xe7\xb7\x59\x32\x67\xb5\x80\x80\xd5\x09\xed\xd3\x7e\x23\x93\xec\x5c\x2f\x4b\xb2\x4a\x21\x04\xca\xdb\x64\xd9\x67\x7c\xbe\x42\x69\x72\x5f\x35\x77\x19\x69\x93\xc2\x0d\x68\x56\x27\x74\x47\x6c\x51\x8a\x98\x0e\xe7\x52\xac\x46\xdd\x28\x7a\x05\x0d\x4d\x8b\xaf\xa9\x7e\x77\xe0\x40\xba\xbb\x0d\xa6\xce\x55\x7e\x8c\xf2\xf6\xfc\xe3\x7a\xea\xf9\x33\x62\x53\x9f\x31\xcb\x22\x36\x56\xac\x6b\xb9\x24\x74\xf8\xee\xf5\x22\xc9\x17\x78\xb9\x04\x5e\xb7\xac\x70\xbd\x24\xfe\xcd\x7d\x0e\xf9\x13\x7e\x8e\x9e\xcf\xdd\xd2\xaa\x9b\xe0\xc7\x67\x1a\x34\xbf\xdf\xe7\xe5\x99\x02\x40\xaf\xcb\x9b\x4a\xb5\x36\x8f\xcb\xff\xee\x1e\x97\xe7\x2c\xe7\x77\xf2\xb6\x3c\x2f\xf1\xa7\xfd\xb4\xfc\x33\xf1\x69\xf9\x67\x9b\xa7\xe5\x9d\x9f\x96\xe7\x8d\x65\x1a\xfb\x91\x01\x43\x9a\x3d\x48\x0f\x67\xdf\x77\x70\x38\x3f\x8d\x67\xe5\x65\x89\xa6\x37\x76\xf6\xa3\x7f\x09\x18\x3a\x8a\xf2\x93\xf2\xc6\xd2\x6c\xff\x17\xe5\x1b\x6a\xd0\x07\xe5\x4d\x5c\x48\xa8\x4c\x0f\x49\xab\x40\x4d\xaf\xc9\x0f\xe0\xb5\x8f\xc9\xcb\x90\x72\x40\x07\x50\x96\x62\x95\x8a\xb4\xe2\x25\x79\x20\x62\xf1\x21\x79\x2d\x72\xe7\xc8\x36\xad\x67\xe4\xd5\x4c\x19\x1d\x04\xfc\x88\xbc\xd2\xcc\x36\x6f\xc8\x7b\xbf\x21\x0f\x30\x5c\x48\x50\x78\xec\x2f\xc8\xdb\xd5\x30\x4d\xd7\x72\x7c\x3f\xde\xde\x1d\xf2\x7c\xbc\x12\xcb\x74\xd5\xe3\xaa\x10\x60\x0c\x9a\xea\xfb\xd7\x8c\xbb\x09\x0d\x87\x55\xff\x40\x85\x8f\xf0\x70\x70\x33\xfc\x65\x4d\x52\xe7\x15\x2d\x1f\x52\x58\x07\xdf\xdc\x4d\x01\xc9\x45\x85\xf3\x7a\xfb\xe5\x4b\x4b\x07\xcb\x9e\x7d\xd4\x87\x8a\x19\x89\xc1\x3b\xc5\xc6\x65\x5c\x94\x67\x8a\x65\xc2\xa3\x49\xb8\x6c\xae\xfc\xc1\x0e\xed\x96\x59\xae\xab\x89\x65\xc5\xd2\x7b\xc6\xd6\x7d\x83\xce\x3e\x42\xea\x8c\x70\x0f\x9c\x18\x1b\x69\xda\xaf\x0e\x40\x6a\x71\x1b\x11\x5d\xba\x70\x03\x2e\x8e\x43\x78\x46\x46\xc2\xd7\x8b\x2e\x21\xeb\x1b\x80\x45\xf7\xa4\x03\xc4\x4c\x4c\xcb\x98\xc7\x95\x87\xe4\x76\x43\xea\x2f\x8f\x44\x78\x8f\xde\x2b\x69\x49\xd5\xfc\x3b\x4e\xf7\x53\xf9\xc5\xdd\x9f\xb0\xe2\x36\x44\x2b\xd0\x6a\x9b\x43\xe8\xf4\x7d\x8f\xde\xfc\x2d\x7e\x12\xba\x7c\xd7\x3d\x07\xd2\xdf\x5b\xd9\x03\x15\xe3\x72\x1d\xf6\x41\x1d\xe0\x1b\x42\xf5\x0e\x83\x6a\xa8\xf9\x96\x0a\x08\x4d\x89\x6f\x71\x59\xe1\xb9\x73\xc7\x65\x91\x5f\xb9\xf7\x5a\x95\x59\x51\x66\xf5\xfd\x78\x73\x51\x56\xcd\x53\xbc\x2a\xf1\x22\xa9\x81\x8e\x7f\x9d\x54\xd4\x4b\x2a\x48\x95\x41\x37\x7f\x25\x65\x9a\xe5\xc9\x12\x22\x4b\x37\x81\x81\xfa\xd8\x9c\x00\xb6\xa0\x08\x39\x3f\x8f\x98\x6b\x30\x04\x01\xea\x89\x64\x12\xee\x93\x7c\x16\x40\x03\xb2\x8f\xb4\xd0\x11\x0e\x49\xbc\xd6\x13\xe2\xe8\xfe\xe7\xd1\xe1\x5f\x14\xeb\xe2\x66\x88\x0f\xce\xb6\xd0\xd9\xe1\xbb\xc3\x37\xe7\x68\x59\x56\x4f\xdb\x85\xe0\xc1\x19\x5b\xc5\x36\xb2\x74\x2d\xfb\xa4\x85\x75\xed\x9b\xb2\x94\xfc\x5a\xe2\x25\x4d\xc3\x56\xd7\xd9\xaa\x6f\xeb\x97\x28\xe2\x6f\xad\xac\x07\xef\x0e\xcf\xde\x1c\xce\x96\x49\x7d\xfb\xb4\x0f\xd9\xbb\xdd\xa2\x6b\x87\xa0\xee\x1b\x48\xaf\xb7\xa7\x27\x3f\xd2\x79\xd2\xb0\x14\x5e\x96\x15\x25\x80\xfe\xf7\xc9\xd1\xb1\xca\xb4\x2b\x74\x72\x4c\x8e\x91\x08\xf3\xaf\x79\x01\xc9\xd4\xa5\xee\x49\xd8\x46\x4b\xd6\x6f\xd9\xf7\x23\x3f\x9b\x3b\x31\xa3\x5a\xd6\xac\xeb\x6c\x59\x37\x9d\xfb\xc6\xf6\x78\xa7\x7e\x7a\x45\x9b\xb6\xbf\xda\xfb\x2a\xfd\xe6\x8b\xaf\xd2\x27\xdf\x7c\xf9\xe2\xf9\x93\xe7\xcf\xbe\x4e\x9e\x7c\x93\x7c\x95\x3e\xd9\x5b\x7c\x79\xf1\xcd\xe2\x79\xf2\xcd\x57\xdf\x90\x97\xd2\x89\xf7\xed\x74\xc4\xdf\x1d\xbe\x3d\xd7\x70\xa0\x9c\x68\x89\xd2\x5b\x9e\x88\xfe\xa5\x79\xf5\x35\xaa\x9e\x0e\x1d\xa8\x65\x95\x74\xa0\x1a\x21\x82\x74\x5c\x9c\x9c\x7e\x7f\x78\x4a\xb6\xb9\x9c\x46\x77\x25\x43\x80\xec\xda\xfa\x0f\xaf\x98\xb6\x6b\x93\xf9\xee\x4a\xc7\x74\x8c\x7d\xe6\xe8\x5f\x5d\x69\xb8\xfd\x44\x3f\xba\xd2\x4b\x37\xa5\x34\x10\x30\x17\x07\xca\xc0\x35\x6f\xe6\x7d\x82\x63\xc7\x24\x9b\xc6\xb8\x09\xbc\xa8\xc7\x8c\x81\x40\xc6\xab\xc4\x8f\x64\x31\xf5\xf1\x17\x39\x54\x3b\x81\x6b\x1c\x8d\xce\x61\xd5\x64\x14\xf4\x31\x56\x93\x79\xd6\x58\x0d\x74\xff\x88\xea\x88\x60\x31\xa4\x93\x69\x2a\x81\x44\x62\x48\x1b\x4d\x5a\x38\xc8\x39\x14\x03\x9f\x90\x84\x56\xd9\xac\x81\x72\xf0\xcc\xda\x28\xd1\x72\x58\x84\xf9\x91\xfc\x35\x62\x98\x76\x7d\xc7\x95\x73\xd3\x5e\xc9\xd1\x83\x2e\x87\x1a\x14\x79\xad\x8f\xe4\x6d\x8a\x79\x7f\x77\xc5\xbc\xb2\x0d\xfd\x4e\x2a\x7a\x07\x62\x6f\xca\x7a\x37\x65\xbd\xc6\xf9\x7f\x60\x31\x93\x58\x0a\x68\xb8\x52\xaf\x0a\x64\x60\x87\x05\xc2\x27\x55\xea\xab\x14\x6b\xa2\xe3\x69\x4d\x64\x28\x7b\x38\x8d\xac\x5c\xfe\x6b\x7f\x4b\xd7\xbf\x06\x98\xa7\x0b\x2d\x04\xb6\xf2\xa3\x42\x6a\x3a\x59\xd0\xc2\x9b\xea\x82\xd5\x9d\xb4\xc5\xc1\x4a\x70\x79\x6e\x80\x32\x22\x06\x38\x3d\x27\x8a\x5a\x61\x17\x12\x62\xc1\xb0\x99\x8c\x5f\xe0\x9c\x56\xe9\xb0\x81\x33\xbb\xaf\x81\x8b\x88\xf5\xd6\xb9\xa9\x24\xf6\xae\x24\x86\x9a\x35\x38\xb4\x3c\xf6\x9a\x62\xa0\x42\x26\xec\x78\x8e\xd5\xc5\x40\x1c\x90\xd4\x8e\x1e\xd5\xc4\xb5\xe5\xa5\x1f\x97\x80\x35\xd5\xb2\x63\x8e\xc5\xa9\x0d\x11\x6c\x4c\x5c\x06\xa1\x3f\x9c\xb5\xae\x84\x26\x73\x42\x2b\xb2\xcf\x7f\xf2\xd8\x26\xc2\xc3\x9e\xd5\xf2\x7c\x7f\xa2\x07\xb6\x92\x88\x93\x73\x20\x97\xc8\x06\x8b\x67\x55\x31\x7f\xbe\xff\xe2\xc5\x7c\xdf\xf4\x85\xe6\xd6\x4d\x52\xcc\x7f\x58\x1b\xfe\x0c\x4c\x47\xe5\x99\x2b\x15\xee\x3d\x4f\x0f\x72\xcf\x9d\x85\xfa\xc2\x85\x4a\xb9\xb0\x7f\xab\xdc\xfc\xfd\x05\x8a\xa3\xa7\xbe\x3f\xf8\x3e\x5c\x55\xac\xcb\x05\x96\xd3\xa3\x4e\x49\x65\x9f\x32\x62\x7e\xb3\x56\x95\x8b\xf0\x0c\x20\xc5\x42\xff\x23\x76\x26\x3f\xa1\xff\x41\xdb\xff\xf5\xcb\xc1\x93\xff\xf7\xd7\x7f\x3c\xfb\x17\xf9\xff\x7b\x4f\xbe\xf9\xf5\x1f\xcf\xff\xf5\x0b\xfd\xff\x5f\xfd\xeb\xf3\xb6\xfe\x0a\x34\xfb\x51\x42\x93\x70\xdb\x9e\x13\x8d\xaf\x56\xe5\x02\xe0\xa0\x77\x56\x2b\x33\xbc\x30\x4e\xbb\x73\x06\xf6\xe5\xce\x03\xd9\x95\x21\xcb\x5e\xdd\x11\xd3\xb8\x1b\x9a\xc6\x1d\x33\x8d\xf3\x27\xff\xeb\x6f\xe9\x3f\x9e\xff\xeb\xa9\xf0\xff\x7e\x79\xf2\xf4\xd7\xff\xf5\xb7\xd4\xd1\x38\xee\x26\x63\x1c\x77\x36\xe3\xb8\xb3\x1b\xc7\x92\x7d\xe0\x8f\xfb\xa4\xe1\xe6\xc6\xcd\x03\xdc\xb8\xe9\x06\x21\xc6\x75\x9b\x1e\x99\xef\x5d\x1b\xa5\xc1\xf1\x88\x27\xe1\x01\x43\x86\xd4\x8e\xc0\xc1\xc1\xfd\x01\xf2\x86\xf5\xc6\x23\x46\xf7\x08\x32\x0c\x11\x7d\x82\xa2\x1b\xc7\x2b\xa6\xf3\x58\xb7\x8a\x25\x8b\x67\x80\x1e\xe6\x6e\xa0\x41\xaf\x11\x6e\x5c\x63\x64\xd7\x88\xf4\x50\x9f\x80\x6d\x0c\xc7\x98\xd0\x15\x76\x05\x47\x46\xb7\x80\x5d\x68\x6f\x80\xe1\xd5\x5e\x1b\xd7\x18\xd9\x35\x02\x6a\xc2\x00\x28\xc7\x70\x92\xa9\x25\x5c\x75\x6c\x19\xdd\xc5\x21\xfd\xda\xf4\x00\xbd\xf4\xbe\x71\x97\x91\xdd\x85\x8e\x42\x34\x4f\x61\xd8\xc6\x70\x92\x09\x3d\x69\xaf\xe0\xc8\xe8\x1a\xb0\xe7\xeb\x1b\x60\xfe\x8b\xb7\x1b\xaf\x78\x28\xaf\xa0\xa3\x10\xcd\x2b\x18\xb6\x31\xbc\x82\x61\x9e\x92\x57\xf0\x1c\x19\xbd\x82\x02\x42\xbd\x42\xfe\x70\xf1\xc6\x33\x1e\xca\x33\xba\x91\x88\xe6\x1d\x3d\xc6\x31\x3c\xa4\xc7\x3e\x25\x2f\x91\xb9\x32\x7a\x4a\x07\x0c\xf7\x16\xfe\x5b\xd4\x1b\x5f\x79\x38\x5f\xa1\xe3\x10\xd1\x53\x18\xbe\x88\x8f\x28\x89\x88\xf9\xef\x6c\x6f\xcc\xe6\xa1\xcd\x86\x8e\x46\x74\xe3\x61\x58\xc7\x09\xb5\x3c\x85\x69\x85\xdb\x21\x67\x96\x90\xcb\x75\x80\x94\xb9\x49\xfd\xa6\x28\x3c\x5c\x6c\xe8\x3c\xb3\x79\xab\x6f\x12\xf1\x22\xde\x53\x7d\x3c\xba\x31\x22\xc4\x94\xbe\x24\xa6\x62\xc9\xe8\x1c\xc0\xaf\x86\x35\xd0\xeb\x72\x93\xdb\x7a\x68\xc7\x58\x97\xf1\x32\x5b\x04\xd7\x18\x2e\x41\xf0\x4e\xc9\x1f\x7a\x7e\x8c\xce\xb0\x2e\xc1\x19\x2d\x5a\x5d\xb4\x71\x85\x87\x75\x05\x32\x08\xd1\x7c\x81\x22\x1b\xc3\x19\x28\xe2\x29\x79\x03\xc7\x90\xd1\x1d\x08\x1c\xc8\x1f\xe8\xa1\xfc\xa6\xbe\xe4\xe1\x7d\x82\x1b\x88\x38\x7e\xc1\x23\x8c\xee\x1b\x3c\xf2\xc9\xf8\xc7\x90\x29\xbd\x8f\x70\xb0\x70\x3f\xd9\xd4\x9a\x4c\xc3\x4d\x22\x56\x9b\x70\xf8\xc6\x71\x92\x89\x55\x9c\x0c\x78\xb2\xb8\x08\xbc\xea\x84\x82\x6f\xea\x4e\x26\xe4\x26\xb1\x2b\x4f\x64\xa4\xe3\x38\xcc\x14\xab\x4f\xd4\x8c\x59\x5c\xc7\xb1\x02\x85\x4d\xd4\x9b\x1a\x94\x49\xb8\x4e\xc4\x2a\x14\x0e\xdf\x38\x0e\x33\xb1\x4a\x94\x01\x4f\x16\x37\x81\x57\xa3\x50\xf0\x4d\x3d\xca\x34\x3c\x24\x62\x45\x0a\x87\x6f\x1c\x0f\x99\x58\x55\xca\x80\x27\x8b\x87\xc0\x2b\x53\x28\xf8\xa6\x36\x65\x3a\x5e\x12\xb9\x3a\x45\xc2\x39\x8e\xb7\x4c\xb0\x42\x45\xc9\x97\xc5\x6b\xdc\xaa\x54\x9a\x2e\x9b\x3a\x95\xa9\xf8\x4d\xc4\x4a\x15\x01\x63\xdc\x5a\x15\x01\xf5\xa6\x5a\x65\x5a\xc6\x13\xb1\x5e\x45\x81\x77\xac\xe0\x3b\xc9\x9a\x15\x2d\x6f\xd6\x20\xec\x51\xb7\x22\xf4\x9c\xa6\x0a\x5c\x84\x87\xcf\x3f\x9b\xfa\x95\x89\x44\x90\x98\x15\x2c\x3c\xc2\x71\x62\xc6\xd4\xaa\x58\x86\x4c\x59\x1c\xc5\xa1\x92\x85\xc2\x6f\x6a\x59\xa6\xe0\x24\xd1\xaa\x59\x3a\x6c\xe3\xb8\xc7\xa4\x2a\x5a\x24\x8e\x2c\x8e\x01\xad\x6a\xa1\xc0\x9b\xba\x96\x49\xb8\x45\xbc\xca\x96\x1e\xdd\x38\x8e\x31\xad\xea\x16\x99\x25\x8b\x6b\x00\x2b\x5c\x9a\xa3\xe2\xcd\xc1\xfd\x14\xbe\xe1\x1d\xed\xdc\x9e\x47\x17\xd9\x37\x78\xd4\x13\xf1\x8d\x21\x4b\x3a\xdf\xe0\x20\xc1\xbe\xb1\x39\xb2\x9f\x8e\x83\xc4\x3d\xb1\x1f\xe0\x1c\xc5\x55\xa6\x77\x5e\xaf\xe1\xcb\xec\x34\x4e\xa7\xf5\x4d\xe4\xd9\x1c\xd6\x4f\xc1\x69\xa2\x9d\xd5\xf3\xe8\x46\x71\x95\x49\x9d\xd4\x0f\x59\x32\x3b\x08\xf4\x9c\x9e\x41\x6f\x8e\xe9\x27\xe1\x1b\xd1\x4e\xe9\x79\x74\xa3\xf8\xc6\xa4\xce\xe8\x87\x2c\x99\x7d\x03\x7a\x42\xcf\xa0\x37\x07\xf4\x93\xf1\x8f\xa8\xe7\xf3\x32\xca\x51\xfc\x64\x72\xa7\xf3\x6a\xb6\xcc\xfe\xe2\x72\x36\xdf\xf6\xd8\x1c\xcd\x4f\xc4\x63\xa2\x9d\xcc\x8b\x08\x63\x1e\xcc\x8b\x98\x37\xe7\xf2\x93\xb2\x9c\x68\xc7\xf2\x2a\xb4\x23\xc5\xdc\x09\x1e\xca\xeb\x59\xb3\xc5\x5e\xe7\x23\x79\xb1\xe3\x24\xe5\x77\x90\xdc\x45\xe6\x69\x1d\xad\x2a\x78\x32\x4b\x0c\x3e\x58\x65\xe0\x9b\x73\xd5\x09\x84\xc9\x48\xc7\xaa\x3d\xb2\x51\x42\xe2\x84\x0e\x55\x65\x86\xcc\x2e\x01\x3b\x52\x65\xb0\x9b\x13\xd5\x29\x38\x44\xac\x03\x55\x0e\xdb\x28\x2e\x31\xa5\xe3\xd4\x01\x47\x66\xa7\x00\x1e\xa6\xf2\x29\xf5\xcd\xa9\xd1\x04\x1c\x44\x39\x20\x31\x7c\x45\x8d\x38\xb2\xdb\xa8\x89\x4c\xc4\x83\x4c\xcc\xe9\x9c\x49\xd9\xc7\xcd\xaf\x36\x67\x4a\xd3\x71\xa9\x68\x07\x4b\x03\x9c\xe3\x39\xd2\xa4\x8e\x98\x34\x7c\x01\xdc\x07\x7a\xd8\xc4\x75\xd9\x9c\x38\x4d\xc7\x73\xa2\x1d\x3b\x0d\x70\x8e\xe7\x39\x93\x3a\x80\xd2\xf0\x05\xf0\x1c\xe8\x51\x14\xd7\x65\x73\x1e\x35\x2d\xef\x89\x7a\x28\xa5\xc4\x3b\x9e\x17\x4d\xee\x78\xca\xc0\x1b\xc0\x9b\x5c\x0e\xaa\x84\x6e\x9b\xd3\xaa\x29\xf9\x53\xb4\x23\x2b\x05\xd6\x98\xe7\x56\x0a\xf4\x9b\xc3\xab\xe9\x19\x52\xb4\x13\x2c\x2d\xee\x31\x03\xf4\x04\xcf\xb2\x2c\xfc\x81\x02\xb5\xf3\xa9\x96\xa2\xf7\x74\xd5\xe1\xaa\x08\xb7\xf9\x6a\x73\xf1\x74\x42\x51\x26\xde\xe5\xd3\x21\xd2\xf1\xe2\xca\xb4\x4e\x4a\x75\x8c\x01\x1c\x08\x7c\x66\xca\xf5\xd9\x1c\x9c\x4e\xc5\x79\x22\x9d\x9e\x4a\x18\xc7\x73\x9b\x09\x9d\xa3\x2a\xb9\x02\x38\x0c\xec\x44\x95\xeb\xb0\x39\x56\x9d\x8c\xbb\xc4\x3a\x5b\x95\x51\x8e\xe7\x30\x53\x3a\x65\x55\xb3\x05\x70\x19\xe0\x79\x6b\x73\x58\xb0\x39\x12\x7a\x58\x7f\xe1\x86\x21\x86\xaf\xf0\xe8\x22\xfb\x09\x8f\x7a\x22\x3e\x32\x64\x49\xe7\x1f\x1c\x24\xd8\x37\x36\x87\x3e\x93\xf0\x8d\x68\xe7\x3d\x3c\xba\x51\x7c\x63\x52\xa7\x3c\x43\x96\xcc\xbe\x01\x3d\xdb\x61\xd0\x9b\x63\x9d\xc9\xf8\x47\xd4\x13\x1d\x19\xe5\x28\x7e\x32\xb9\x73\x1c\x35\x5b\x66\x7f\x71\x39\xbd\x69\x7b\x6c\x0e\x6e\x26\xe2\x31\xd1\xce\x6c\x44\x84\x31\x8f\x6b\x44\xcc\x9b\x93\x9a\x49\x59\x4e\xb4\x43\x1a\x15\xda\x91\x62\xee\x04\x8f\x66\xf4\xac\xd9\x62\xaf\xf3\x81\x8c\xd8\x71\x92\xf2\x3b\x48\x0e\x9e\x73\x36\x87\x2f\xd3\x08\x1c\xf1\xce\x5d\x04\x7c\xa3\x84\x8a\x69\x9d\xb6\x28\x78\x32\xbb\x08\xf8\x8c\x85\x81\x6f\x8e\x57\x26\xe0\x1e\x91\x4e\x56\x7a\x64\xa3\x38\xc6\x84\xce\x53\x64\x86\xcc\x2e\x01\x3b\x45\x61\xb0\x9b\x03\x94\x29\x38\x44\xac\xb3\x13\x0e\xdb\x28\x2e\x31\xa5\x13\x93\x01\x47\x66\xa7\x00\x9e\x93\xb0\x0c\xda\x26\x17\xfc\xc0\x6e\xc1\x0d\x43\x0c\xbf\xe0\xd1\x45\x76\x0c\x1e\xf5\x44\x3c\x63\xc8\x92\xce\x35\x38\x48\xb0\x6f\x6c\x72\xc1\x93\xf1\x8f\xa8\xb9\x60\x19\xe5\x28\x7e\x32\xb9\x5c\xb0\x9a\x2d\xb3\xbf\xb8\xe4\x82\xdb\x1e\x9b\x5c\xf0\x44\x3c\x26\x5a\x2e\x58\x44\x18\x33\x17\x2c\x62\xde\xe4\x82\x27\x65\x39\xd1\x72\xc1\x2a\xb4\x23\xc5\xdc\x09\xe6\x82\xf5\xac\xd9\x62\xaf\x73\x2e\x58\xec\x38\x49\xf9\x1d\x24\x07\xcf\x39\x9b\x5c\xf0\x34\x02\x47\xbc\x5c\xb0\x80\x6f\x94\x50\x31\xad\x5c\xb0\x82\x27\xb3\x8b\x80\x73\xc1\x0c\x7c\x93\x0b\x9e\x80\x7b\x44\xca\x05\xf7\xc8\x46\x71\x8c\x09\xe5\x82\x65\x86\xcc\x2e\x01\xcb\x05\x33\xd8\x4d\x2e\x78\x0a\x0e\x11\x2b\x17\xcc\x61\x1b\xc5\x25\xa6\x94\x0b\x1e\x70\x64\x76\x0a\x60\x2e\xb8\xcf\x0a\x6c\x72\x5e\x13\x70\x0f\xc5\x70\xc4\xf0\x13\x15\xda\xc8\x0e\xa3\x22\x31\x11\xcf\xd1\xb3\xa6\x73\x21\x45\x0f\x47\x5f\xda\xe4\xc2\x26\xe4\x49\xd1\xf2\x61\x43\xa4\x31\x73\x62\x43\xec\x9b\xbc\xd8\xe4\xac\x28\x5a\x6e\x4c\x87\x7a\xc4\xb8\x3c\xc1\x1c\x99\x99\x3d\x48\x7c\x76\xce\x95\x0d\x3b\x4f\x56\x17\x8e\x5a\x70\x9a\xa3\x36\xb9\xb3\xe9\x04\x97\x78\xf9\xb3\x01\xce\xd1\xc2\xc9\xb4\xf2\x68\x1a\xbe\xec\xae\x03\xce\xa7\xf5\x5d\x36\x39\xb5\x89\xb8\x4d\xa4\xbc\x9a\x88\x70\x34\x87\x99\x50\x7e\x4d\xc5\x94\xdd\x55\x60\x79\xb6\x1e\x7e\x93\x6b\x9b\x8a\xa3\xc4\xca\xb7\x49\x18\x47\x73\x95\x29\xe5\xdd\x94\x5c\xd9\x9d\x05\x9c\x7f\xe3\x57\xbd\x9b\x7d\xdf\x64\x5c\x47\x3b\x2c\x71\xfc\x48\x8f\x3e\xba\x53\xe9\x49\x4d\xc6\xc3\x6c\x2c\xea\xdd\x4d\xdb\xd3\xd9\xf7\x36\xfb\xa1\x69\x39\x5d\xcc\x3d\x91\x02\xef\xa8\x6e\x36\xb5\xbd\x91\x96\x37\x98\x63\x39\xec\x91\xf8\x6e\x9b\x7d\xd2\x84\xdc\x29\xda\x5e\x49\x46\x3a\xaa\x23\x4d\x6a\xcf\xa4\x66\x0c\xe6\x42\xd0\xbd\x13\xdf\x67\xb3\x7f\x9a\x92\x03\xc5\xdb\x43\x0d\xb0\x8e\xea\x42\xd3\xda\x4b\x69\x38\x83\x39\x91\xe3\x9e\x6a\x73\x0a\x3b\x19\x17\x8a\x7a\x06\x2b\xa2\x8c\x7b\x02\x2b\xe2\xde\xec\xc3\x27\x66\x3f\xd1\x77\xe0\x1f\x65\xef\x3d\xe9\x5d\xb7\xcf\x7e\xdb\xeb\xd4\x55\xec\x3a\x51\x2d\x38\xc9\xef\x30\x17\x6d\xb2\x0b\x53\x09\x25\xf1\xf3\x0a\xe3\x66\x14\xa6\x99\x4b\x70\xca\x22\x38\xe7\x0f\x36\x99\x83\x49\xb8\x4a\xe4\x9c\xc1\x88\xd9\x82\x09\xe6\x09\xe0\x19\x02\xb7\xdc\xc0\x26\x2b\x30\x0d\xe7\x88\x9d\x0f\x18\x33\x13\x30\xc5\x1c\x80\xc3\xee\x1f\xb8\xef\x6f\xe6\xa4\xcd\x52\xeb\xa1\x5d\x44\x18\x88\x18\x3e\x22\x22\x8c\xec\x24\x22\xf2\x89\x78\x89\x8a\x29\x9d\x9b\x08\xb0\x70\x3f\xd9\x2c\xb2\xa6\xe0\x24\x91\xd6\x58\x1c\xb6\x71\xdc\x63\x42\x2b\xac\x01\x47\x16\xc7\x80\xad\xaf\x1a\xe0\xcd\xf2\x6a\x12\x6e\x11\x6b\x75\xc5\xa3\x1b\xc7\x31\xa6\xb4\xb6\x1a\xb2\x64\x71\x0d\xe0\xca\x8a\xb8\xda\x66\xba\x78\x50\xbf\x68\x86\x20\x86\x4f\xb4\xa8\x22\xfb\x43\x8b\x76\x22\xbe\x20\xb2\xa3\xf3\x83\x06\x0a\xe8\x03\x9b\xc9\xe1\xe1\x9d\x20\xd6\xcc\xd0\xe1\x1a\xc1\x0d\xa6\x34\x27\x48\xfc\x98\x1c\x01\x38\x1b\x50\x6c\x1b\x57\x78\x58\x57\xe8\x06\x21\x86\x2f\xf4\xc8\x22\x3b\x43\x8f\x78\x22\xde\x20\x33\xa4\x73\x87\x0e\xce\xee\x0f\x90\xef\x4d\x5e\x65\x29\x5a\xaf\xb3\x54\xfa\x39\x4f\x6e\x30\x5a\x5c\x27\x65\xb2\xa8\x71\x89\x6e\x93\xf2\x9e\x8c\x93\x08\x74\x81\xaf\xb2\x7c\x4e\xec\x77\x7e\x8f\x93\x12\x55\x37\xc9\x72\x99\xe5\xf5\xa0\xf5\xa6\xc8\xeb\x6b\x7d\x73\x9a\xdc\x4b\x8d\x38\x4f\xb5\x78\xbb\x36\x15\xd6\xae\x71\x88\x93\xaa\x63\xbe\x28\x52\xdc\xaa\x82\xfd\x5e\xdf\xaf\xa4\x5f\x92\x12\x27\xe2\x2f\x8b\xe2\xe6\x06\xe7\xf5\x50\x23\xb3\x67\x5f\x7e\xb9\x23\xb8\x87\x4d\x69\x1f\x29\x46\xe0\x3c\xc5\x29\xba\x28\x8a\x25\x4e\xf2\x0e\xe0\x32\x59\x56\xd8\xe0\xbc\x91\x3e\x8d\xc9\xd0\x10\x0e\xa4\xee\xb3\xd9\x4c\x1c\xda\xa3\xb3\x8e\x9b\x1d\x74\x72\x8a\x66\xd2\xe8\xea\xdb\xc9\x00\xf3\xad\x3b\xe8\xe0\xf8\x7b\xda\x8e\x53\xf4\x1a\xd5\xe5\x1a\xef\xb0\x2e\x0a\x92\x14\x5d\x0b\x2f\xd3\x53\x34\xb6\xc4\x28\x21\xad\xc4\xbd\x85\xc9\x62\xf7\x2d\xe8\x3b\xb4\xd7\x60\xe7\x7e\xfc\x16\xed\xef\x91\xff\xed\x00\x6a\x81\x68\xaf\x64\x99\x25\xf6\x4c\x32\x01\xd5\xb4\x81\x1c\x7c\x59\x2c\x92\x25\xa6\x53\xc0\x47\x35\xde\xa1\x4b\x56\x45\x59\xcf\x3f\xc1\xa0\xb4\x2a\xb3\x9b\xa4\xbc\x9f\x5f\x16\xe5\xbc\xd1\x36\xc4\x67\x03\xbc\x9b\x5a\xce\xef\xca\x2d\x99\xc4\xc1\x51\xad\x1d\x2a\x59\x75\xcd\xb0\x89\x2c\x2a\xc6\xf5\xe8\x0c\xbd\x3d\x78\x77\x76\xd8\x88\xcf\x75\xeb\x74\x35\x24\x5a\xe1\xa4\x5c\x5c\xcf\xaf\xb3\xbc\xae\xe6\x49\x89\xe7\xf8\x66\x55\xdf\xf7\xd4\xa9\xa7\x7c\xfb\x1d\x7a\xd6\xe8\x94\xfe\xfd\x1a\x3d\x6b\xd8\x98\x75\x7e\xb3\xd3\x2c\xdc\x5e\xa3\x19\xf7\x67\x03\x26\xfb\x8c\x28\xcb\xc0\x67\xb4\xcd\xfc\x78\x48\x43\x15\x65\x8c\xed\xba\x65\x10\xd2\x90\xec\xc0\xd6\x84\x9c\xb1\x4c\x63\x4d\x38\x60\x48\xb3\x26\xec\xe1\x60\x6b\xc2\x06\x27\xb5\x15\xcb\x14\x42\x43\xae\x62\xc5\xbf\x4a\x4a\x9c\xd7\xd2\x22\xe9\x3a\x5b\xa6\xc0\x7d\x49\x8a\xab\x45\x99\xad\xea\xac\xc8\xb9\xe9\x65\xb0\x18\x75\x1c\x36\x22\xd1\xf4\xc6\x8e\xe3\xca\x3a\x80\x14\x18\x3a\x8a\x79\x5e\xd4\x09\x55\xe1\xcc\x3a\xdf\x73\xc0\x32\x00\x70\xc5\x51\xd7\x65\x76\xb1\xae\x71\xc8\xaa\x43\x42\x35\xe7\x27\x7a\x00\xe8\x3c\x59\x2e\x8b\x3b\x9c\xce\x6f\x93\xe5\x5a\x5a\x21\x0c\xe0\x89\xd1\xf6\xa6\x35\x9c\x15\x3a\xc8\x41\x40\xb7\x53\x16\x03\x92\x92\xb4\x38\x09\xd2\xe0\x0c\x44\x7c\x72\x0e\x42\xee\x1c\xd9\x3a\x14\x53\xf2\x10\x89\x29\xa3\x83\xb4\xb0\x50\xff\x10\xcd\x0c\x12\xe9\x34\xfb\x3b\x11\x32\xe2\x66\xf0\xb2\xc4\xcd\x88\xb6\x6b\xb9\x09\x84\x59\x98\x3e\x25\xc3\x85\x04\x05\x98\xe3\x33\x7c\x3d\xb7\x93\x9b\x6a\x0c\x6a\x98\xa6\x6b\x69\xf9\x04\x79\x9b\xa2\x3b\xa8\xb0\x5e\x85\x65\xba\xea\x71\x55\x08\x30\x06\x5d\x65\xe9\xbc\xc4\x69\x56\xe2\x45\x8d\x66\x6a\xcb\x6b\xe2\x0f\xbe\x9b\x6b\xdd\x67\x51\x62\x87\x4d\x34\xd8\x94\x27\x34\x1c\x56\xfd\x03\x15\x9e\xad\x32\xc0\x6a\x88\x40\x75\xb1\x79\xb6\xbf\xbf\x13\x94\xa4\x73\x1b\x1d\xcd\x7a\x24\x5b\x65\xb1\x32\x6f\x04\x15\xf9\x3f\x11\x01\x91\xf9\x7f\xd0\xf6\x7f\xfd\x2d\xfd\xc7\xfe\xfe\xbf\x3e\x6f\x32\xf9\xd0\x84\x53\x56\xe5\x20\xc5\x56\xb9\xa0\xd9\x17\x53\xd0\x6c\x95\xc7\x53\x2d\xc1\x45\xff\x23\x29\xb7\xca\x7b\xed\x7e\xf9\xaf\x5f\xfe\x96\xfe\xf5\x57\x57\x15\xdf\xe0\x3a\xb1\xcd\xa3\x65\x52\x13\x1e\xc5\x24\x12\xfb\x71\xbe\x28\xd6\xf2\x3c\x39\xe0\x9f\xd0\x98\xb7\xf0\xe2\xba\xb7\x41\x4d\xc5\x67\x4b\xcf\xe6\x97\x6f\x5f\x93\xec\x24\x38\x35\xd9\x60\x2f\x93\x3b\x80\xbd\x10\xd5\x17\x25\x4c\x58\x5b\x66\xab\x27\x3c\xb2\x84\x75\x72\x05\x10\x8d\x40\xa9\x5b\x84\x81\x0a\xcf\x99\x82\x99\x8e\x31\x26\x7a\xa9\xb2\x6a\xbe\x5e\xdd\x16\xf5\x30\x83\x49\x92\x7e\x8e\x6b\xcd\xb8\x2b\xf6\xa9\x2d\x21\xa7\xb6\x16\x02\xae\x80\x80\xeb\x9e\xfc\x6a\x9d\x5c\x59\x47\x2f\xab\x0a\x76\x54\xf2\x8c\xdb\x49\xcd\x9e\xef\xc8\xad\x17\xa6\xd6\x7d\xae\xf1\xd9\x8e\xd1\x2c\x88\x83\x0f\x77\x5f\xbf\xad\x71\xbe\xb8\xb7\x1a\x40\x47\xf0\xb9\x82\x1b\x2e\x10\x75\x70\x52\xe4\xe1\x85\x1d\xe4\xce\x39\xe4\x62\x36\x18\x64\x4f\x4c\xdb\x53\xb1\x26\x81\x1b\x9d\x2d\x31\x20\xbb\x25\x91\x83\x7d\x39\xed\x64\xa8\xdc\x00\xed\x2e\x43\x56\xd2\x36\x16\xdb\x92\x89\x68\x9c\xa6\x59\x8d\xd3\x79\x52\x89\x19\x58\x47\xa6\x20\xa1\x54\x15\x20\xcb\xa2\xd0\x4d\x52\x2e\xb1\xd3\xa9\xc0\x40\x15\xbc\xd5\xc1\x37\x78\x9e\x54\x1b\xf0\x50\x75\x13\xf1\x2c\x2d\x63\x1a\x27\x1b\xc2\xc3\xfc\x8d\x59\x5d\x72\xb1\xc4\x6a\x13\xb2\x98\x2e\x54\xc7\xd3\x51\xaa\x4d\x8b\x30\xb5\x11\xc3\x8c\xa0\x30\x1d\x76\x9a\x81\x51\x61\xef\x9a\x43\x22\xcd\x4d\x96\x4b\x3b\x8a\x9b\xe4\xc3\xa0\x7a\x66\x34\x7f\x9b\x98\x97\xc1\x7c\x0b\xe4\x51\x37\x38\xcd\xd6\x37\xf0\xa3\x13\x06\x6f\x6c\x84\xe5\x50\x95\xb0\xa6\xd3\x93\x61\x07\xed\xf1\xc9\x00\x54\x3e\x3f\x81\x10\x17\x0f\x50\xd4\xd4\x15\x27\x28\x50\xd4\xe2\x11\x8a\x1e\x3d\xfc\x0c\x65\x80\x63\x12\x06\xab\xe1\x4a\x6d\xb7\x32\xb0\xb3\xf9\x6e\xce\x51\x02\xcf\x51\xcc\xe6\x7b\x59\x94\x37\x49\xbb\x7a\x6c\x40\x9b\xdf\x42\x22\x42\x18\x53\xa0\xd3\x1d\x97\xd0\x34\x89\xe3\x1d\x2f\x2d\x44\x1e\x28\x75\x38\x76\x59\x49\x41\x78\x9e\x66\x98\x72\x3c\x91\x02\xf4\x87\x1c\x49\xa9\xd1\x4c\x58\x43\xce\x3a\x81\x86\xf4\x45\x5a\x17\x8b\xa0\xd5\x08\xc3\xf0\xa0\xd7\x20\x86\x6b\x12\xca\x94\x73\xaa\x1f\xee\x69\x0c\xff\x94\x0c\x86\xe7\xc8\x68\x27\x14\x10\x6a\x1e\x42\x80\x73\x9d\xe9\x15\x89\xb6\xd0\x18\xaf\x28\xb8\xbd\x4e\xaa\x79\x9a\x55\x8b\x2c\xad\x40\x75\xb0\xf1\x52\xb8\x82\x8e\x26\x65\x0c\x02\x4b\x46\x6b\x60\x90\x0e\x31\x73\x4a\x62\xda\xe5\x83\xda\x79\x96\xa7\xf8\x83\x30\x8f\xeb\x4e\x3d\x8a\x05\x5a\xad\x2f\x96\xd9\xe2\xe9\x62\x7d\x81\x6d\x8b\x89\x62\x99\xce\xd9\x29\x0a\xab\x59\x9f\x99\xfc\xe5\xc5\x17\x3b\xe0\x55\x0a\x75\x17\x9a\x9c\xe9\x37\xfe\xb0\x44\x00\x2b\x41\x20\xd6\xdf\xfa\x51\xe7\x2e\xfb\xfa\x83\x35\x05\xbd\x79\x87\x49\xde\xf8\xf5\x24\x5e\xa3\x7d\xb6\x61\xe3\x7f\x7a\x06\x38\x5f\xa3\x5f\x08\x1e\xf3\xca\x92\xe2\xb2\x4f\x9a\x96\xb8\xaa\x14\x3d\x5d\x36\x20\xaa\x2b\x43\xf4\x7b\x2b\x49\x8d\x2b\xb4\x2a\xba\xb8\xf5\xf8\x6e\x12\x3d\xae\x0b\x15\x5e\x37\x23\xa8\xd9\xfd\x4e\xee\x44\x30\x59\xc3\xd6\x48\x1a\xc7\x85\xdd\x4a\xa2\xa0\x9b\x5b\x49\x9b\x5b\x49\xbc\x3d\xfe\x9e\x6e\x25\xf1\x12\x6f\x6e\x25\x6d\x6e\x25\x19\xd7\xdf\xbc\xb1\x4c\x62\x11\x3e\x64\x48\xbd\x12\xe7\xe0\xac\xcb\x71\x1e\xe7\xa7\x71\x2b\x49\x96\x68\x7a\x63\x67\x4d\x30\xc9\xc0\xd0\x51\x94\x6f\x25\x99\xe6\xfb\x80\x5b\x49\x0d\x35\xe8\xd1\x9a\x89\x0b\x09\x95\x69\x1b\xa5\x02\x35\x9d\xab\x0d\xe0\xb5\xc7\x6a\x32\xa4\x1c\xd0\x01\x94\xa5\x80\xa4\x22\xad\x38\x53\x03\x22\x16\x8f\xd4\xb4\xc8\x9d\x23\xdb\xb4\x0e\xd4\xd4\x4c\x19\x1d\x04\x7c\x9c\xa6\x34\xb3\xcd\x69\x9a\xf7\x91\x0d\xc0\x70\x21\x41\xe1\xb1\xdf\x4a\xb2\xab\x61\x9a\xae\xe5\x78\x06\x64\xef\x0e\x49\x67\x2a\xb1\x4c\x57\x3d\xae\x0a\x01\xc6\xa0\xa9\xde\x4a\x62\xdc\x4d\x68\x38\xac\xfa\x07\x2a\xbc\xbf\x31\x60\x5a\x87\x4c\xe6\xc6\x40\xc7\x34\x77\x63\xc0\xc4\xf8\xc3\xde\x18\x68\xb8\xfd\x44\x6f\x0c\xf4\xd2\x4d\xc9\x31\x80\xd1\x09\x14\x93\xba\x4f\x93\x8f\x99\xf8\x4f\xca\x3a\xab\xea\xb6\x42\x5b\xe3\x49\x38\xbf\xaa\xaf\x1f\xfb\xcb\x5f\xb7\x59\x8a\x0b\xd7\x2c\x5c\x37\x06\xe1\x19\xa9\x1e\x15\xd3\xe7\xe0\x2d\x2c\xfa\x6b\xbf\x59\xa1\x69\x27\xf6\x1b\x79\x1b\xcb\x9e\x6b\xee\x09\x80\xf2\xcd\x1d\xf8\x26\xe7\xbc\xc9\x39\x3f\x60\xd2\x54\x32\xdb\xdf\x49\xb2\x5b\x96\x7a\x84\xe2\x1c\x99\xc4\x24\xa6\x49\x35\x53\xea\xb9\x52\x82\x85\x4f\x98\x9f\x54\xca\x54\x25\xd5\x34\xc7\xd2\xba\xf8\x51\x75\x70\x19\x55\x39\x85\x6a\x9b\xc2\x02\xd2\xa8\x1c\x55\x68\x2a\xd5\xc6\x8d\x02\xa5\x29\xb3\xa2\x03\x37\xa5\x55\x95\x7d\xb4\xa9\x55\x15\xb4\x1c\x7d\x81\x5c\x88\x51\x50\xcb\x86\x22\xcd\xea\x40\x40\x4c\xb5\x1a\x89\xc0\xd3\xad\x2a\x34\x53\xf3\x2e\x50\xda\x55\x01\xef\xe2\x5b\x9b\xf4\x6b\xa4\xf4\x2b\xd0\xa0\xa1\xc1\xe4\xb1\xa7\x61\x61\xea\x98\xae\xcb\x39\xa6\x63\x61\x28\x20\x29\x59\x2d\xa6\x69\xab\xca\x47\x39\x0e\x71\x6a\xaa\x29\xda\x9e\xc3\x89\x0d\x0f\x68\x3c\x1c\x06\x60\xdc\xd7\x78\x44\x3a\xa3\xbd\x57\xd3\x93\x19\xbc\xca\x63\x5b\xc5\xc5\x79\x99\x47\xc5\x40\x24\x69\xff\xf3\xe8\xf0\x2f\x1a\x61\x9b\x0f\x6d\x1e\x9c\x6d\xa1\xb3\xc3\x77\x87\x6f\xce\xd1\xb2\xac\x9e\xb6\x5f\xf6\x38\x38\xeb\xd9\x62\x0c\xf7\xad\xfb\xa4\x95\x75\xef\x9b\xb2\x94\xf5\x59\xd2\x25\x76\x75\x9d\xad\xfa\xb6\xfe\xeb\x13\xe2\x6f\xad\x26\x0e\xde\x1d\x9e\xbd\x39\x9c\x2d\x93\xfa\xf6\x29\xbd\x24\x4e\xe3\xe2\x6e\xf7\x25\x8d\x1d\x82\xba\x6f\x20\xbd\xde\x9e\x9e\xfc\xc8\x2e\xd2\x4a\xdf\x1c\x95\xc4\x5b\x96\x15\x25\x82\xfe\xf7\xc9\xd1\xb1\xa0\x89\x06\xa0\x42\x27\xc7\xa4\xda\x8a\x08\xf0\x9a\x17\x92\xe8\x52\xdd\x93\xb0\x8e\x96\xac\xdf\xb2\xef\x47\x7e\x36\x77\x62\x93\xf6\xb2\x66\x5d\x67\xcb\xba\xe9\xdc\x37\x36\x83\xba\xac\x9f\x5e\xd1\xa6\x6d\x9c\xbc\xb8\xdc\x7b\xf1\xcd\xd7\x4f\x5e\x7c\xf5\xf5\xb3\x27\xcf\xf7\xd2\x17\x4f\x2e\xf6\x5f\xa4\x4f\x9e\x7d\xf3\x7c\xef\xeb\xfd\x8b\x17\x2f\xbe\xfa\xea\x8b\xed\x97\x2f\x49\xc8\xdb\xe9\x88\xbf\x3b\x7c\x7b\xae\xe1\x40\x5c\x1e\x33\x7d\x22\xa2\xf8\x96\x27\x32\x06\xd2\x2a\xe3\x35\xaa\x58\x51\xbd\x10\xac\x5b\x56\x49\x07\xaa\x11\x22\x48\xc7\xc5\xc9\xe9\xf7\x87\xa7\x24\xc4\x70\x1a\xdd\x95\x8c\x01\xea\x96\xfd\xc1\x90\xcd\x1f\x27\x73\x38\x24\x30\x1f\x2b\xa0\x8c\x7e\x48\xd4\x7c\xb1\xf7\xa1\x8f\x18\x1a\x36\xe6\x57\x65\xb1\x5e\x69\x60\xaa\x3a\xa9\xd7\x95\x54\x47\x94\x2c\xde\x27\x57\x9c\x72\xdb\xe1\x6d\x1e\x5b\x12\x7e\x65\x4b\x5a\xf1\xb7\x8b\xa4\xa4\x5f\x9a\x50\xef\x4f\x1e\xf8\xac\xe3\xb7\x75\xb2\xcc\xea\xfb\xe1\xd5\x94\xed\x27\xfb\xdb\x2f\x5f\x46\x33\x6d\xc5\xf4\xc4\x86\x23\x76\x39\x7c\x8b\x17\x78\x40\x41\x81\x37\xc7\x13\x9b\xe3\x89\x07\x3d\x9e\xe0\x4c\xf6\x77\x73\x38\xc1\xcb\x3c\xca\xd1\x04\x4f\x60\x22\x3b\xa6\x21\x4b\xba\x5d\x13\x07\x09\xd8\x39\xf1\x78\x3f\x95\x23\x09\x59\xa6\x29\x8e\x21\x20\x1d\x21\x83\xc3\x47\x73\x78\x14\x61\x9a\xac\x82\x0e\x22\x1a\x8a\xf0\x63\x08\x13\x27\x03\x74\xe6\x23\x08\x15\xb0\xf9\x00\x62\xd0\xc3\x70\xfc\x20\xc3\x0e\x0f\x1f\x00\xf4\xe5\xa3\x07\x25\x03\xca\x83\x07\x20\x72\xf9\xd8\x41\x4b\xc0\xe5\xd0\x41\x46\x32\x2d\xff\x01\x1e\x38\x48\xd0\x70\xef\xd9\x1c\x36\x44\x3b\x6c\x00\x18\x31\x2c\x60\x3c\xfe\x83\x06\xbb\x2a\xa6\xea\x66\xce\x87\x0c\x76\x04\xb0\x23\x06\x25\x9e\x29\x2b\xc9\x5d\x2d\xe0\xa8\x44\xf3\x45\xe5\x3d\x68\x42\x6f\x61\xd5\xad\xba\x4d\x96\x76\x83\x35\xd8\x5c\x41\xfd\x7e\x51\xdc\xe2\x32\x29\x6b\xfb\x77\x66\x00\x3b\xd9\x56\xb4\x5b\x5c\xce\x93\xb2\x26\x5f\x98\xd6\x84\x52\x28\x7b\xeb\xfc\x7d\x5e\xdc\xe5\x4e\xaa\x8d\xad\x3c\x45\x3e\x9c\x31\x87\x6f\x71\x5e\xf3\xc9\xf0\xdf\xda\xa6\x26\xfb\xf2\xb4\x63\x45\xf8\x81\xf2\x20\xfc\x92\x26\xf7\xed\xdf\x8d\xa4\x7d\xbe\xba\x45\x2e\x59\x99\x48\xaa\xfd\x9f\x0c\x24\x31\x60\x04\xe3\xd8\x32\xc2\x75\xcc\xea\xa0\x38\x11\xda\xff\x51\x51\x0c\x3e\xd3\xc1\xfe\x74\x7c\x74\x72\x8c\x0e\xde\xbd\xeb\x7b\x4b\xf2\x4b\x26\x61\xd6\x83\x0c\x6c\xd1\x87\x12\xdc\xa0\x17\x25\xfc\x40\x3f\xc4\x34\xbb\x64\x1b\x48\x2b\x12\xde\x1d\xf4\x1b\xcc\x5d\x26\x70\xb8\x69\x60\x8e\xa6\x66\x1f\x38\x4f\x3c\x4c\xac\x3d\xbe\x42\x74\xc5\x16\x88\xea\x76\xb4\x6c\x2b\xc3\xee\x92\x73\x35\xe6\xe1\x37\x99\xd7\x4d\xe6\xf5\x63\x66\x5e\x39\xf3\xfd\x9d\xe5\x5f\x79\xc9\x47\xcc\xc2\xf2\x64\x26\xb5\xf6\x1f\x32\x66\x5e\xf8\x73\xf0\xe0\x55\x3f\x4f\xe3\xd3\xca\xce\xca\x92\x4d\x77\x6c\xc1\x3b\x3b\xb9\x93\xeb\x28\x6b\xb2\xb6\xc6\xe9\x2e\x42\xee\xb6\xa1\xee\x98\xc1\x35\x72\xa5\x41\x0d\xc9\xe6\xaa\xba\x40\x72\xba\x83\x7e\xd6\xcc\xae\xdc\x43\x97\xdf\x05\x70\xa4\xce\xf2\x2a\x59\x32\xe4\x7a\x81\x84\xd4\x19\x5f\x2d\x31\xf7\xbc\xaf\x8c\x6a\x8a\x9e\xe9\x94\x03\x96\xfa\xb8\xfa\xe5\x26\x1f\x1c\x39\x1f\x0c\x30\x74\x97\x20\xf4\xa9\xe4\x86\xed\x6a\x99\xb6\x2b\x7a\xe6\x89\xed\x68\x5c\xb2\xc5\x4a\x6c\xd3\x57\x9b\xaf\xa2\x1c\x63\xd9\x74\x0b\xd4\x79\x2e\x27\x38\x5c\xe0\xf1\x71\x1c\x10\x50\xc1\x3a\x9f\xce\xb4\xc6\xa5\xcb\xac\xac\xea\x79\xdb\x47\xb7\xaf\x57\x40\xa9\x76\xf8\x0a\xb0\xe1\x5e\x3f\xa4\xa0\x5e\xd6\xc5\x88\x45\xf5\x3c\xa9\x36\x0b\xf0\xa9\xbe\x3f\xa2\x97\x76\x8a\xce\xa5\xe0\x0f\x12\x09\xf9\x6e\x8e\x6e\xa7\xb8\x58\x61\xdf\xd5\xc4\xba\x5c\xa1\x66\xe4\x23\x59\x7e\x85\x17\x45\x9e\x3a\xd9\xfe\x23\xca\x27\x88\xd2\x4d\xd1\xd6\x95\x1c\x42\xac\x5d\xec\xe8\x68\xef\x12\xd5\xff\x2e\x32\x97\x24\x83\xd8\x3b\xca\x9a\x40\x33\xc4\xda\xe3\xd0\x56\x8c\xe1\x15\xa1\x2b\xe9\x8e\x10\x07\xdf\x5e\xee\xb9\x32\x5c\x14\xba\x32\xdd\x14\xba\x52\x5d\x15\xba\x1a\xef\xae\x90\x42\x56\x42\xcf\xed\xc2\xd0\x95\xef\x8d\xa1\xab\x91\xae\x0c\xed\xed\xef\xed\x7f\xfd\xc5\xf3\xaf\x9e\x7c\xb3\x9f\x7e\xfd\xe4\xf9\x8b\x8b\x6f\x9e\x5c\x5c\x7e\xfd\xcd\x93\xe7\x97\x5f\x7f\xfd\x65\xfa\xe5\xf3\x8b\x2f\x2f\xd2\x89\x5f\x19\xba\x12\xee\x0c\x5d\xb9\x5c\x1a\xe2\xc7\x94\xbf\x38\x64\x77\xbb\x09\x5d\x1e\x92\x84\x88\x39\x71\x46\xbe\x44\x64\x0c\xc1\x93\x9a\x0f\x00\xa1\x1f\x1c\xe5\xe9\xa7\xde\xc3\x6a\x6f\x19\x0a\x71\x46\x4f\xea\x64\x59\x5c\xcd\xf3\xf5\xcd\x05\x2e\x8d\xb7\x90\xc6\xf9\xd2\xac\x20\xde\xb4\x46\x8f\x67\xc9\x3c\x7d\x53\x48\xf0\x48\x42\x36\x84\x74\x1f\x96\xa4\xa9\xe3\xe9\x73\x96\x5f\x16\x86\xb2\x29\x06\x94\xdc\x24\x7f\x2f\xf2\x79\x52\x65\xb9\xf2\x33\x61\x22\x58\x55\x17\xa5\x72\x73\xb4\xb7\x23\xd7\x6b\xad\x4a\x5c\xe1\x7c\x81\x05\xa9\x15\xcd\x5d\xde\x33\xb9\xa8\x70\x5e\x6f\xbf\x7c\x69\xe9\xe0\x9a\x7d\xec\x6f\xe5\x7d\xc2\x1b\xbf\x4e\xc8\x69\x79\x8d\xcc\x96\xd9\x73\x3a\x68\xb0\xf7\xf4\xf3\x92\x76\x54\xeb\xac\x5e\x02\x87\x95\xd5\xfc\x98\xdd\xc5\xd9\x0b\x69\xa4\xbc\x29\xd2\xec\x32\x73\xed\x59\x14\xef\xd7\x2b\x5d\x1a\x88\xc1\x50\xc4\xf7\x66\x98\xaa\x58\x97\x0b\xac\x6b\xfd\x68\x77\x4e\x1d\xac\x99\x6c\x8f\x27\x65\xc7\x3d\x43\x66\x0b\x2e\x93\x3b\xc9\x76\xb5\x3b\x1c\xc0\xf3\x07\x5c\x2d\xe2\x23\x79\xfc\x40\x10\xed\xb1\x3f\x7d\xf0\xfc\x32\x79\xf6\xcd\xe5\xde\xfe\x93\xaf\xf1\xfe\xf3\x27\xcf\xf1\x17\xdf\x3c\xf9\xe6\x62\x2f\x79\x92\xa4\xcf\xf6\x9f\x25\xc9\xb3\xcb\xaf\xf7\xd3\x4f\xf5\xe9\x83\x66\x20\xd9\x25\xf7\x4f\x78\xde\x64\x12\x4e\x2b\xd8\x08\x3c\x99\xe3\x0d\x03\x05\x4f\x97\x83\xfd\xe8\x63\xd9\x89\x2a\xf7\xa0\x13\x7d\xc2\x62\xb5\xcc\x16\x09\xfb\xf4\x66\x91\xd7\x65\x61\xdd\xa7\x2d\xd6\x25\x71\x8b\x79\xb5\xb8\xc6\x37\x09\x19\xf4\x35\x5d\xe8\x9a\xa1\x79\x42\x72\x17\x6e\x3c\x78\x30\x32\x32\xda\x81\x01\x39\xcd\x40\xb6\xa9\x78\x8e\x8e\x31\x9d\xfb\x0c\xe0\xad\x3e\xc4\x22\x8f\x6d\x2c\xb3\xaa\x98\x8b\xcb\xa9\xd9\x17\x3b\x0a\x18\x79\x8b\x3d\x7b\xbe\xe3\xfd\xe1\xe6\xcb\x92\x0d\xff\xbd\x21\x7e\x02\x46\x97\x09\x38\x8d\x01\x15\x78\x51\x8f\x21\x03\xb1\x0f\x1b\x5d\x6a\xc0\xea\xcd\x19\xec\xa6\xd0\x7c\x53\x68\xbe\xf9\x6c\xe3\xa3\xff\x6c\xa3\x42\xb1\x7d\x24\xf8\x9d\x94\xee\x0b\x22\x8f\x50\xb3\x2f\xe0\x9f\xc6\xc4\x31\xe4\x48\x33\x7d\x70\x80\x4e\x93\xc8\x27\x72\x8e\x3e\x10\x69\x82\xe3\x67\x3d\x31\x1f\x40\x83\x47\x52\xae\xbd\x37\x4e\xfd\x01\x45\xf7\x2d\x3d\x68\xb5\xbd\x91\x0f\x19\x99\xe9\x78\x5e\x09\x6b\x2a\xac\x1f\x76\xd0\x56\xd4\x0f\x40\xe5\x68\x0a\x21\x2e\x86\x34\x35\x75\x45\xf1\x3c\x14\xb5\x58\x35\xaf\x47\x0f\x2f\x97\x1f\xe0\x98\x94\xc3\x80\x0a\xe4\x65\x60\xb0\xbb\x6c\x4a\xe2\x23\x95\xc4\x43\xcc\x17\x14\x22\x1e\x7b\x11\x3c\x40\x11\x13\x75\x30\xc7\xb2\x77\x40\x7f\x48\xbd\xbb\x1a\xcd\x84\x35\xe4\xac\x13\x68\x30\x9a\x6a\x4d\x7b\xc3\xde\x94\x86\xc4\x3e\x06\x50\xa5\x77\x67\x10\x9f\x6a\x05\xb5\x4a\xce\x49\x8d\xa5\x8a\x33\xa3\x77\x09\x1d\xa0\xe3\xdc\x9f\x10\x18\x57\xa2\x93\x39\x20\xe8\xd9\xe6\xce\x07\x8c\xac\x3f\xec\xf1\x40\xcb\x6f\x54\x2f\x62\x67\x7c\x0c\xe9\xb0\xc3\x97\x7b\x93\xf7\xba\xc9\x39\x1b\xd4\xc7\x40\xae\xd5\xfb\x54\x84\xb1\x2e\xf1\xa5\xf5\x62\x10\x40\xf1\xc4\x61\x26\xa1\xf0\x9e\x11\xb5\xa2\xeb\xe4\x0a\xa2\xe0\x79\x5b\xfb\xd0\x68\xba\x4e\xae\xf6\xf5\x3e\xfe\x4c\xd3\x74\x87\xb3\xab\xeb\x88\xd1\x6b\xb0\x73\xe6\x39\x95\x76\xcd\x94\xe3\x6f\x29\x77\xf6\x3b\x1e\x75\x99\x2c\xde\x7b\x3e\x7f\x64\xfb\x04\xc0\x0d\x4e\xb3\xf5\x8d\xa6\xf1\xb3\x55\x51\x65\x84\xfb\xcf\x74\x96\xcc\x0e\xb6\x14\xb9\xaf\x51\xbf\x00\xfc\x91\xce\x59\xb2\x8a\x24\x5e\x93\x39\xd3\xbf\xe3\x71\x02\xed\x14\xfe\xe9\x5e\x86\x66\xa4\xcf\xf6\x32\xe4\x53\x5d\x62\x33\xee\xa6\x11\xb7\x38\x56\x34\x91\x8b\x40\xd8\x63\x17\xc5\x03\xa8\x63\xb4\x54\x6c\x44\xad\x72\xd4\x96\x20\x80\xc7\x68\x32\x15\x7c\x32\x3b\x86\xb1\x52\x54\xef\x0d\xc7\x6b\x5d\x2e\x3d\xe3\x2e\xe9\xa9\x08\x8b\x1f\xff\x9d\xb7\x75\xb9\x8c\xfe\xba\x1b\xc1\x39\xd5\xa8\x41\x78\x9b\x84\x2d\xf6\x8c\xa8\xad\x70\x5d\xda\xcb\x50\xe8\x16\xc4\x62\x80\xc6\x2d\x8e\x61\x62\xa5\xb8\x35\x37\x5a\x49\xdb\x9c\x8e\x81\x8b\xd5\x55\xeb\x15\x2e\x2b\x6c\x3f\x7b\x07\x0c\x23\xe5\x60\x12\xe3\xc8\x71\xa2\x1e\x48\x02\x60\x1d\xc9\xbb\xa2\x7c\x3f\xe6\x0b\x96\x9f\xd8\xeb\x94\x44\x5d\xd1\xc3\x16\x45\x0a\xaa\x0d\x22\x90\x9b\xca\xa0\x4d\x65\xd0\xa6\x32\xe8\xd3\xab\x0c\xea\xa3\xc0\xef\xa4\x2e\x88\x13\x78\x84\xaa\x20\x0e\xfb\x24\xa6\xeb\x01\x3f\xea\x49\xbb\x07\x03\x4d\xdd\x9f\x54\x35\x90\x24\xd0\xe4\xc6\xcd\x9a\x8f\x95\x60\x81\x23\x28\x57\x01\x19\x26\xf9\x80\x1a\x20\x46\x0b\x5a\x01\x64\xe0\x41\x44\x64\x3a\xf7\x57\x40\x9a\x6a\x7f\x64\x70\x6d\xe5\x8f\x04\x28\x47\x4b\x3b\x59\x31\x60\xa9\xe8\x2a\x6a\x7e\x60\x68\xc5\x8a\x1f\x1d\x6a\x78\xbd\x8f\x84\x61\x42\x2e\x01\xaa\xf5\x11\x41\x81\x0e\xb1\xa9\xf3\x89\x54\xe7\x63\x37\x59\x40\x18\x78\xec\x35\x3e\x56\x25\x4c\xd2\xa9\x1c\xeb\x7b\xac\xbd\x21\xd5\x3d\x2a\x24\x93\xd5\x8d\xa3\x36\x60\xa1\x67\xaa\xc9\x43\xca\xdc\x74\x86\xc2\xa6\x7b\x98\xb2\xbb\x2f\x52\x5b\x97\x3d\xf2\xb7\xab\x83\xd2\x40\x6e\x83\xa3\x5e\x7c\xb4\x0c\x8d\x93\x0a\x02\x3d\xcf\xe9\xfd\xfc\x65\x47\x62\xb4\xb7\xff\x28\x85\xc1\x63\x87\x86\xf1\x8d\xf3\xc6\xa1\x44\x36\x92\x78\x83\xa7\x13\x28\x19\xc5\xbb\x09\xd5\x1d\xff\x36\x02\x81\x6a\x8c\xb7\xba\xe3\x1f\x54\x10\x1e\x4d\xa8\xee\xf4\x8f\x26\x54\x77\xc3\x47\x13\x9a\xdf\xe2\x3f\x9a\xc0\xb8\x9a\xd3\x31\x5a\x56\x77\x4e\x2f\x26\xf4\xf2\xb9\xbd\x98\xd0\x08\x13\xfd\xc5\x84\x8b\xbd\xf4\x8b\x2f\x9e\xbf\x78\xf1\x64\x91\x5e\xee\x3d\x79\x9e\x2c\x2e\x9e\x5c\x60\xfc\xe2\xc9\xde\xe5\x8b\x2f\x93\xaf\xbe\x4a\x5e\xe0\xcb\xbd\x69\xbf\x98\xd0\x6b\x74\x57\xb2\x03\x80\xe3\xf5\x85\x3e\x06\x8f\x9b\x4c\xe9\x5c\xcb\x72\x84\x40\x31\x7a\xd9\x1c\xe3\xf5\x13\x2d\x3d\xed\x84\x9b\xd0\x3a\x03\xb6\xd0\xd3\x2e\xef\xaa\x3a\xa9\xb3\xaa\xce\x16\xd5\x53\xf2\xa0\x5c\xf7\x27\x9a\xf5\xa3\xa4\x48\x88\x2d\x92\x1a\x5f\x15\xe5\xbd\xaa\xed\xb3\xce\xea\x3f\x73\x7d\x73\xb4\x7b\x45\x2d\x11\x11\xab\xac\x8e\xe3\x5c\xe6\xda\xd5\xe8\x14\x57\xf5\x85\xdc\x8b\xea\x95\xb7\x45\xb1\x5c\xe2\x05\x71\x72\xf2\x27\xe8\x0d\x55\x3d\xdf\xcd\x47\x25\x67\x1d\x7a\xf6\x1f\x55\xe5\x88\x42\xe1\x34\x4c\x2a\x7e\x97\x0d\x5f\xbf\x2c\x90\x18\x61\xb9\x69\x71\x51\x40\x19\xfa\xee\x35\xda\x7e\xb6\xb7\xb7\xf7\x64\x6f\xff\xc9\xde\xfe\xf6\xcb\x97\xe4\x57\x6d\x8e\x46\x29\xe9\x83\x79\x8e\x81\x9b\xde\x71\x54\x40\x4a\xbf\xb9\xcb\xde\x67\x69\xb1\xa8\x9e\xb6\xff\x98\x67\x79\x8a\x3f\x34\x43\xb8\x22\xeb\x5e\x9d\xef\x94\xf8\x36\xab\x0c\xe9\x49\xc6\x37\x23\x73\x72\xfc\xee\x67\xee\xa9\x3e\x72\xac\x94\xdd\xe2\xa7\xe4\xdf\x34\xc8\x32\xd8\x37\x27\xef\x7e\xfa\xf1\x98\x98\xfd\xd9\xe1\x79\x6f\x8a\xf8\x43\x7d\x9b\x2c\x67\xdb\xfa\xfe\x8d\xfc\xdb\x2f\x5f\x96\xf8\x6a\xb1\x4c\xaa\x4a\xc3\x02\x33\x0b\x7f\x16\xf4\xfd\xc1\x2c\xf0\x31\x2d\x59\xd6\xb8\xcc\x93\x3a\xbb\xc5\xf3\xa6\x0a\x12\xc4\x86\x19\x47\x30\x2b\x6d\xe5\x57\x10\x2f\xe2\x2b\xa4\xc1\xcc\x38\x0c\x12\x04\x53\x30\x5b\xac\x20\x33\x88\x1f\xbe\xa2\xcf\x91\x91\xfe\x6c\xc0\x9d\x83\xae\xaf\x1f\xe9\x55\xf7\x92\x8d\x07\xed\xbe\xb3\x1f\xf1\x12\x27\x1e\x54\x4b\x9c\x78\x93\x6b\x8a\x35\xfc\x88\xf2\x67\x80\xbe\xa4\x7d\x0d\x5f\x44\x10\xc0\x44\x77\x8e\xe4\xc9\x83\x74\x78\x10\xc2\x42\x90\x2e\x54\x39\xc5\x60\x66\xa4\x1c\x7b\x14\xd6\x54\xa9\x60\x0f\x46\x03\x74\x15\xa0\x21\x5a\xea\xeb\x41\x95\xf4\x0b\x20\xe9\xef\xa6\x7d\xef\x60\xf2\xde\x2a\x97\x50\x04\x31\x12\xe2\xae\x22\x86\x28\x6c\x04\xea\x24\x86\xd3\xaa\x30\x05\xbb\xad\x15\x69\x08\xb3\xcd\x6d\x14\x5f\xb6\x58\xf7\x10\x06\x82\x06\xcd\x7f\xa8\xd6\x75\xc1\xd2\x3e\x73\x4c\x36\xa5\x7e\x6b\x8c\x21\x92\x58\xcc\xcc\x69\x5e\x29\x0a\x47\x5c\x45\xaf\x1b\x5b\x8b\xb4\x2e\x16\xee\x3c\xd0\x6e\xfe\x04\x69\xca\xce\x93\x68\x7f\x15\xc2\x8d\xb0\x9f\x0b\x78\x5b\x3e\xed\x98\x7b\x0d\x70\xd7\xd5\x9b\x70\x51\xfa\x51\x2d\xca\x00\x92\x6d\xee\xc7\xcb\xcd\x06\x28\xe2\x30\xe2\x19\x79\xd4\x78\x42\x58\x2a\x92\x75\x7d\x3d\xaf\x8b\xf7\xd8\x5f\x39\x1c\x8e\x10\x56\x56\x25\xbe\xc4\x25\xbd\x1e\xe5\xcb\x49\x8f\x22\x84\x91\x6a\x7d\x51\x2d\xca\xec\x02\xcf\x7d\xd7\x79\x1a\x44\x71\x98\x8a\x60\xce\x2a\x64\x71\x98\x0b\xf4\x72\x19\x51\x1c\xa6\xd8\x27\x59\xc2\x79\xe2\xbf\x55\x12\xc8\x52\x7b\xda\x1a\xcc\x93\xf0\x22\x89\x23\x53\x34\x8b\xed\xce\x02\xe9\xe6\x4f\xd0\x77\x0b\xc3\x75\x0e\x25\xee\x1b\x7d\x25\x0c\x21\x6c\xf8\x6f\x5f\x24\x04\x31\x98\x08\xd3\x47\xf8\xde\x45\x89\x28\x70\xeb\x62\xc7\x19\xc0\x6a\x88\xbe\xbc\xb5\x74\x85\x73\x72\x7e\xea\x4c\x96\xf5\xf3\x25\x59\x62\x2f\x8a\x25\xf6\x27\xe8\x1b\x21\xb8\xce\x5e\xc4\xb3\xbc\xaa\xcb\xf5\x8d\x57\x50\xec\xfb\x06\x92\xf6\x15\x5e\xc6\x10\x85\x0d\x4f\x43\x57\xa2\x09\x66\xc8\x3f\x64\xaa\xb0\x44\x63\x27\x82\x8e\xc2\x23\xa8\x1e\x5b\x60\x18\x05\x22\x0e\x65\x3a\x58\x87\xfe\x9a\xab\x4a\x8f\x3c\x03\xe9\xe5\x49\xee\xce\x8b\xdc\x9d\x1f\xb9\xe5\x9c\x65\xde\xbd\x0e\x94\xb8\xce\x61\xc4\xfd\x36\x51\x42\xf7\x10\x06\x3c\xd7\xb9\x7c\xef\x10\xf2\x21\xd3\xca\x00\x45\x08\x23\x9e\xdb\x20\xbe\x77\x08\xf9\xd5\x32\xf1\xd9\xda\xf3\xbd\x43\xc8\xf7\xcf\x33\xf9\xb2\xd0\x61\x08\x63\xc3\xb3\xe8\x40\xec\x1f\x81\x85\xe6\x53\xb3\xa1\x8c\x50\x2c\x21\xec\xf8\x6e\x85\x85\xee\x21\x0c\xac\x4b\x7f\xaf\xe8\x9f\x1e\xf1\x22\x4d\x0b\x43\x7d\x69\x73\x17\x17\xdc\x89\xb3\x83\x9d\x80\xd0\xcc\x21\x08\x63\x22\x20\x3c\xf7\xfd\xc3\x58\x08\x0d\xd1\x12\x92\x30\x66\x02\xc2\x74\xdf\x3f\x8c\x85\x80\x50\xdd\xf7\x0f\x63\x21\x30\x5c\x8b\x38\x42\x59\x09\x08\xd9\x3c\x86\x28\x6c\x84\x84\xed\x21\x9e\x30\x96\x42\x42\x37\x87\x20\x8c\x09\xef\xf0\xdd\xf6\x0e\x23\xef\x1f\xc2\xbb\xee\x9e\x0c\xb0\xc0\xe7\x1d\x3e\xb9\xee\x41\x0c\x84\x05\x4f\x19\x47\x10\x2b\xde\xa1\x93\xeb\x1e\xc4\x80\x77\xe0\xe4\xba\x07\x31\x10\x14\x36\x25\x14\x81\x8c\x78\x07\x4d\x01\x41\x0c\x26\xfc\x43\xa6\x02\x4d\x10\x43\xfe\x01\x93\xef\x1f\xc4\x82\x67\xb8\xec\x3a\x07\x11\xf7\x0d\x96\x7d\x6f\x4f\xf2\x7c\x80\x09\x8a\x57\x4a\x44\xe1\x4c\x79\x47\x2e\x19\x47\x38\x2b\xde\x31\x4c\xc6\x11\xce\x4a\x50\x34\x53\xe1\x89\xc1\x92\x77\x5c\x1b\x62\x89\xc6\x8e\x7f\x84\xd3\xe1\x0a\x67\xcd\x3f\xd6\x0d\x90\x84\x33\xe3\x19\xf5\x44\x0c\xe1\x6c\xf8\xc6\x3f\x09\x85\x27\x23\x2c\x40\x78\x87\x1a\xae\x7b\x10\x03\xde\x01\x86\xeb\x1e\xc4\x40\x50\x58\x91\x50\x04\x32\xe2\x1d\x4c\x04\x04\x31\x98\xf0\x0f\x21\x0a\x34\x41\x0c\xf9\x07\x0e\xbe\x7f\x10\x0b\x9e\xe1\xa2\xeb\x1c\x44\xdc\x37\x48\xf4\xbd\x3d\xc9\x33\xcf\xf2\x76\x4f\xae\x7b\x10\x03\x41\xee\x29\xa1\x08\x64\xc4\xdb\x3d\x05\x04\x31\x98\xf0\x77\x4f\x05\x9a\x20\x86\xfc\xdd\x93\xef\x1f\xc4\x82\xa7\x7b\x76\x9d\x83\x88\xfb\xba\x67\xdf\xdb\x93\x7c\x6f\xd4\x41\x1e\xa2\x40\x13\x81\x21\x6f\x4f\x19\x20\x89\xc5\x8c\xbf\xc7\x68\x50\x05\x33\xe6\xef\x39\x32\x8e\x60\x56\x3c\x3d\x48\x40\x10\xcc\x84\xaf\x27\x89\x18\xbc\xd9\xe0\x87\x36\x82\xcd\x68\xd1\x45\x61\x30\xc4\x76\x86\x78\xa2\xb0\xe4\x6d\x43\x12\x92\x28\xcc\xf8\xdb\x92\x8c\x25\x90\x9d\xa0\x50\x28\xa0\x88\xc3\x48\xb8\x49\xc7\x34\xe6\x70\x33\x8e\x62\xc0\x81\xa6\x1b\x6e\xb4\xa1\xe6\x1a\x60\xa8\x8d\x02\xfd\x47\x42\x40\x10\xc6\x84\xe7\x38\xf4\xbd\xc3\xc8\xfb\x8e\x02\xd7\xdd\x93\x01\xc2\xba\xa7\xf0\x4d\xd7\x00\xc2\xbe\x62\xb7\x7d\x3d\x49\xd3\xae\xbe\xb4\xbb\xce\x7e\xc4\x3d\x73\x5e\xfe\xb9\x2e\xda\xd3\xb3\x96\x9b\xeb\x1c\x4a\xdc\xb3\xb2\x56\xc6\x10\xc2\x86\x7f\xdd\xb6\x84\x20\x06\x13\x61\xfa\x08\x2f\xd4\x56\x22\x0a\xac\xd1\xb6\xe3\x0c\x60\x35\x44\x5f\x01\x5a\x6a\x5e\xc7\xf5\x20\xcc\x7a\xfa\x91\x25\x2f\xb3\xb9\x93\x24\x4f\x59\xfa\x92\x0b\xb7\xcc\x21\x12\x7f\x66\x02\x58\xf0\x26\xec\xfb\x20\x58\xc0\x23\x60\x4d\xd7\x80\xc8\x24\x63\x88\xc2\x86\xa7\xfa\x95\x68\xe2\x31\x14\x1a\x9d\x00\x48\x43\x98\xf5\x7c\x28\x82\xef\x1d\x42\xfe\xb2\x28\x6f\x92\xda\x9b\x3e\xeb\xee\xc5\x80\x67\x9a\xde\x3f\x3d\xcf\x7a\x7a\x2e\x69\xb8\xce\xa1\xc4\x3d\xdd\x44\xc6\x10\xc2\x86\x7f\xe0\x90\x10\xc4\x60\x22\x4c\x1f\xe1\x51\x43\x89\x28\x30\x68\xd8\x71\x06\xb0\x1a\xa2\x2f\x6f\x2d\x05\xa4\xec\xc3\xd2\xf4\x7d\x6f\x4f\xd7\x95\x10\xc4\x60\xc2\x73\x08\x54\x58\x42\xd9\xf1\x77\x65\x05\x92\x58\xcc\x84\xeb\x27\xdc\xad\xb5\xc8\x02\x5d\x1b\x86\xd7\x93\x65\xcf\xd4\x6b\x48\xc2\xb5\xed\xeb\xed\x5d\x5c\xf7\x70\x06\xbc\x2d\x47\xc6\x11\xc6\x4a\x88\x57\x49\x28\xe2\x30\x12\xaa\x97\x18\xfe\xa4\x44\x15\xec\x4d\x76\xac\x41\xec\x7a\x9e\x1e\x84\x9f\x19\x88\x18\x02\xbd\x8b\x43\x12\x8b\x99\x40\x8b\x92\x31\xc5\x60\x2b\xdc\xeb\x24\x44\x31\x99\x8a\xa3\xaf\x78\x7e\xa8\x44\x18\xc9\x1b\xed\xb8\x23\xb0\xde\x7e\xa2\x37\x86\x5e\x79\x5c\x11\x58\xab\xf0\xa2\xc8\xd3\x58\xcc\x89\xd8\x82\xd8\xf3\x3c\x07\x10\xba\x07\x31\xb0\x4a\x16\xef\x93\x2b\xcf\x6d\x80\x84\x22\x88\x11\xaf\x97\x2e\xb9\xce\x41\xc4\xab\x3a\xa9\xd7\x01\xe1\x9c\xf5\xf7\x64\xa1\x7f\x9b\x7d\x51\xe4\x75\x59\x78\xd9\xc3\x00\x89\x17\x33\xec\x83\x1f\xee\xf4\x59\x3f\x3f\x92\x9e\xc7\xbe\x01\xa7\xbd\x4d\x57\xcf\x29\x9c\xef\x1d\x4c\xde\x33\x1e\x0d\x50\x04\x31\xe2\x3f\x4d\xcb\x18\xa2\xb0\x11\xa8\x93\xf0\x19\x59\x8d\x29\x70\x2a\x06\x20\x0d\x61\xb6\xfb\x12\x5a\x90\xf2\x04\x2c\x21\xec\x04\x71\xe1\x4d\xbc\x4e\x3c\xa6\x31\xf2\x65\x34\x2f\x62\x7e\x5f\x22\xf1\xff\xfa\x08\xeb\xe9\x35\x4f\x76\x5d\xbd\x08\x7b\xd5\x85\xf8\x56\x84\xf8\xbd\xf5\xed\xfd\xae\xb7\x5f\xf9\x87\x77\xdd\x47\xff\xf9\x7a\x4f\xa2\xfe\xd3\x8e\xf4\xe5\xfc\x20\xfa\xde\x1e\x2a\x7d\x22\xdf\x93\x87\xa0\xe9\x46\xf5\xc1\xed\x50\x3e\x22\xe9\x23\xca\x44\x03\xfd\xca\xb5\x07\xa3\x01\xba\x72\xd2\x90\xf2\xdb\x7c\x20\xba\x86\xaf\xb4\xd9\xc9\xea\x3f\x74\xc6\xbe\x37\xf7\xfd\xf7\xfc\x47\xef\xda\xb6\xf9\xea\x3d\xbe\x47\x7f\x3e\x3d\xfa\xf1\xe0\xf4\x67\xf4\x7f\x0e\x7f\x46\xb3\x2c\x05\x53\xe8\x7e\x51\x91\xe8\xc1\xe3\xd0\xd0\xca\x22\x42\x28\xa9\xed\xa2\x66\x08\xc1\x64\xb3\x1b\x52\xf7\xa2\x23\xd9\xb7\x2a\xc8\xdd\x64\x37\xac\x0d\x4c\x4c\xdc\xa1\x1b\xb5\xaa\x01\x55\xb0\x21\x40\x6a\x58\x49\x8b\x05\xbd\x07\x4b\x37\x5f\xc2\x13\x85\xf8\x43\x72\xb3\x5a\x2a\x85\x57\x80\xb9\x0c\xb1\x86\x26\x7b\x7d\x0a\x40\x95\x07\x0c\xa6\xdb\x3c\xdb\x62\x25\x2b\xc0\x05\x53\xe5\x2e\x20\xdb\x49\x0f\x81\x83\xe9\xb3\xcc\x8b\x9d\xb4\x00\x17\x4c\x95\x9d\xf9\xda\xa9\x0a\x70\xc1\x54\xfb\x13\x32\x3b\xe5\x01\x6c\x04\xea\xcc\x09\x21\xb4\x05\xc8\x68\x94\x59\xa0\x80\xd3\x17\xe0\x83\xb9\x68\x36\x45\x76\xf2\x22\x60\x30\x5d\xb2\x80\xb7\x13\xe5\xa0\x82\x29\xd2\x85\x82\x9d\x24\x0f\x16\x46\x93\x7f\x32\xd0\x42\x57\x01\x1a\x81\x36\x28\x76\x0e\x21\x23\x50\x86\xc7\x4f\x0d\x78\x04\x1e\x40\x31\x74\x08\x19\x81\x32\x28\x8e\x0e\x21\x23\x50\x06\xc7\x52\x35\x74\x14\x0e\x40\xf1\x54\x05\x1b\x91\x3a\x28\xa6\xea\x7b\x44\xe0\x04\x16\x57\x15\xa0\x11\x68\x03\x62\xab\x0c\x17\x81\x2a\x24\xbe\x0e\x00\x43\xe8\xf2\x2f\xfa\x19\xe9\x2a\x00\xc3\xe9\x42\x43\x9c\x0e\x3a\x9c\x03\x40\x80\x53\x00\x86\xd3\x05\x84\x37\x05\x60\x38\x5d\x60\x70\xd3\x00\xc7\xa0\x0f\x08\x6d\x4a\xd0\x78\xb4\x01\x81\xcd\xd0\x21\x9c\x0f\x48\x58\x53\x41\x86\x53\xb6\x06\xb5\x01\x58\x38\x4d\x7b\x48\x1b\xc2\x85\x50\x55\xbf\xb6\x67\x64\xc0\xd8\x25\x12\x2f\x80\x40\xa3\x83\x8e\xc4\x01\x20\xe4\xe8\xa0\x23\x71\x00\x0c\x3e\xa6\x1e\xd1\x38\x01\x84\x21\x3d\x7c\x64\x2e\x00\x01\xc9\xd6\x2b\x12\x47\x90\xd0\xa4\x05\x8f\xc4\x83\x35\x48\xa9\x61\x23\x51\xb7\x87\x2b\x0d\x70\x08\x7d\xfe\x99\x3c\x23\x6d\x05\x60\x38\x5d\x40\x60\x50\x00\x86\xd3\x05\x86\x03\x0d\x70\x0c\xfa\x80\x20\xa0\x04\x8d\x47\x1b\xe0\xfa\x86\x0e\xe1\x7c\x40\x1c\x5e\x05\x19\x4e\xd9\xea\xe6\x03\xb0\x70\x9a\x76\xe7\x1e\xc2\x85\x50\xe5\x9f\xb7\x33\x92\x55\x00\x86\xd3\x05\xfa\x97\x06\x38\x06\x7d\x80\x7f\x29\x41\xe3\xd1\x06\xf8\x97\xa1\x43\x38\x1f\x10\xff\x52\x41\x86\x53\xb6\xfa\xd7\x00\x2c\x9c\xa6\xdd\xbf\x86\x70\x21\x54\x55\xef\xd2\x19\xc9\x1b\x3a\xc4\xe2\x03\x60\xf3\x5a\xf0\xb8\x3c\x00\x6c\xdf\xd2\x29\x0e\x3f\x10\x1f\xd0\x41\xc7\xe1\xc0\xea\x0b\x4a\xd0\x38\xb4\xed\x3e\xa1\x86\x0d\xa3\xae\x7f\x59\xce\xc2\x8a\xb5\x63\x3c\xbe\x60\x76\xa1\xef\x11\x8f\x13\x80\x7d\x68\xc0\xe3\xf1\x00\xb1\x13\x1d\x7c\x0c\x2e\x80\x91\x4b\x09\x1c\x93\xbe\x83\x95\x8e\x66\x9f\x2e\x96\x19\xdf\x26\xc1\xd6\x18\xd9\x0e\xe1\x16\x18\xcb\xf6\xc4\x67\xe1\x8c\xa4\x95\xa0\x11\x68\x5b\x95\x3d\x84\x8b\x40\xd5\xae\x6a\x05\x60\x08\xdd\xf6\x05\x38\x23\x4d\x09\x28\x94\x9e\x5d\x48\x19\x2a\x84\x62\xff\xda\x9b\x91\xe4\x00\x2c\x80\x66\xf7\x88\x91\xf0\xbb\x92\xae\x1a\xd4\x85\x76\xf3\x75\xfa\x11\x4b\x1f\x87\x14\xba\x5f\x54\x24\x7a\xf0\x38\x34\xb4\xb2\x88\x10\x5e\xa5\x8f\x7c\xb1\x6b\xb2\xac\x71\x99\x27\x75\x76\x8b\xe7\xec\x4d\x1d\xa5\x02\x07\x50\x2e\x62\x9a\xe9\xcd\x69\xb1\x3f\x90\x2a\x85\x55\xd0\x1e\x82\xee\x22\x0a\xeb\xc8\x52\x13\xd3\x6d\xdc\x74\x57\x09\xc3\x95\xd0\xa2\xd2\xda\xae\x06\x36\x06\x6d\x90\xe6\x75\x2a\x87\x51\xcb\xf3\x42\x1f\x08\xfa\x56\x6f\xfc\xab\xee\x22\xa1\x92\x40\xdf\xec\x4d\x81\x96\xa0\x92\x5b\x04\xea\xc8\xd2\xb6\x86\xe3\x37\x84\x2f\x01\x24\x8c\x92\x79\x40\x44\x10\x95\xa3\x95\x38\xd9\xe5\x06\x0e\x4c\xb7\x2d\xf0\xd7\x93\x6d\x21\xc2\xe4\x33\x5c\x24\x00\x90\x1e\x76\x8a\xc8\x0d\x94\x7e\x08\xc5\xab\x2c\x9d\x97\x38\xcd\x4a\xbc\xa8\xb5\xf4\x78\x20\x05\xb5\x2b\x30\x39\x2d\x85\x10\x11\xea\xe4\x4a\x8b\x97\x5c\x7e\xd3\x19\x65\x9d\x5c\x39\x90\x20\xb7\xcb\x8c\x64\xc8\xed\x33\x1d\x29\x9c\x66\x75\x51\xba\x91\x34\x19\x40\xd8\xb0\xb3\x5a\x4f\x7d\x88\xea\xdb\x63\xd0\x30\xad\xb2\x44\xa0\x50\x6a\x96\x50\x25\x01\x29\x07\x8b\xc0\x38\x87\x2b\x86\xd8\x1c\xb0\x44\x98\x50\x49\x9d\x83\x96\xb5\x5b\x54\x8e\xe0\x3c\x84\x51\x5d\x90\xa0\x54\xcf\xf3\xe4\xc6\x44\x92\x83\xd2\x8e\x79\x03\xb4\x8b\x3e\x5b\x15\x55\x46\x46\xfe\x33\x57\x36\xec\x1c\x84\x09\x6b\x8f\xd4\x03\xb0\x80\x58\x4d\x71\x65\xab\xcc\x40\x29\x5b\x65\x06\x1f\xca\x56\x99\x03\xa1\x2a\x37\x52\xaa\x72\x23\xa9\x2a\x77\xa0\x75\x83\xeb\xc4\x40\x8b\x34\x87\x8d\x94\x01\x79\x98\x05\x94\x49\x9d\xe5\x86\x89\x48\x02\x32\x68\x8c\xcd\x47\x70\xca\xda\x29\xb6\x6d\x35\xd0\x82\xce\x79\x1d\x2a\xb3\x7c\xa6\xa9\x96\x17\xce\x95\xb0\x39\x6c\x05\x05\xab\x75\x5d\x30\x9e\xe6\x78\x89\x17\xda\x09\x6a\x08\x16\x91\xe2\x9c\xdc\x20\x87\x92\x25\xb0\xbe\xb4\xe9\x0b\xbf\x2a\x42\xb4\x21\x08\xab\xce\x32\xba\x46\x6f\xec\xc5\x3a\xaf\xcb\xfb\xb9\x6e\x89\xca\xb7\x6b\xd6\x78\x00\x2a\x29\x5e\xe2\x1a\xa7\x73\x9c\xd7\x59\x7d\xaf\xa2\x23\x42\x78\x47\x6f\x3a\xd9\xe8\x64\xe9\x1a\x15\xe8\xd9\x14\x08\x14\xa7\x41\xa4\x8b\x78\x5c\xb3\x81\x12\x69\x86\xd2\x4a\x93\x5a\x2f\x11\x69\xd4\xd0\x81\xe2\xa7\x99\x32\x2d\x81\xa6\xa0\x5c\x23\x09\x6d\x85\x12\xea\x6b\xe0\xb4\xd4\xf8\x5a\x52\x0d\xc9\x1e\x04\x4a\x97\x96\xe7\x68\x49\x36\x55\x6a\x1a\x6a\xb4\x15\x4a\x28\xd7\xc4\x9a\xae\xd1\xd7\x51\x7b\x04\x25\x5e\x64\xab\xcc\xa4\x42\x11\x4c\x79\xfb\xb9\x69\xdb\xed\x3b\x00\x79\xd0\x12\x0d\x91\x8b\x56\x77\xe8\x31\xb3\x22\x27\xcd\xf0\xd0\x56\x28\xa1\xee\xc8\x5c\x4b\xac\x83\xd0\x12\xec\x20\xe0\x44\xb9\xe3\x46\x03\x61\xe1\x95\x3c\x2d\x71\xfb\xbd\x75\x1d\x03\x56\xd2\x16\xa2\x50\x72\xec\x08\x4a\x4b\x8d\x35\x6b\x89\xb1\x66\x28\xad\x75\xa9\x77\x6b\x72\x4a\xa4\xa3\xb2\x2e\xc1\x2e\x4d\x0e\x7d\xb4\x34\x48\xa3\x96\x08\x69\x04\x52\x29\xca\xf9\xa2\x58\xb6\xab\x10\xd3\x0c\x36\x84\x54\xd0\xef\x21\x9c\x26\x35\x19\xb7\x69\x86\x53\xc1\x5a\x39\x71\x98\xf4\x44\xfc\xe4\x9f\xc9\x45\x51\x26\x75\x51\xc2\x38\xe2\x7b\x58\xf8\x02\xef\x09\x86\x54\xec\x2b\x1b\x5b\x1f\x0b\x6f\xf0\x75\x8f\x48\xc4\x38\xa5\x2b\x40\x6d\x2a\x72\x98\xe5\x45\xec\xf6\x29\x5f\x07\x6f\x61\xc9\x79\x15\x20\xd2\x31\x2e\x09\x14\xa0\x16\x6e\x5c\x56\x09\x02\x76\x18\x0b\x01\xb3\xab\x84\xc8\x34\xd5\x2a\x40\x2d\x62\xbb\xcc\xbe\x22\x76\xeb\x54\xac\x01\xb7\x30\xe4\x3a\x3b\xcb\x54\x00\x53\xb5\xa1\x8b\x95\x39\xf7\xd9\x5b\x49\xcd\x8d\x35\x10\x53\x5e\xec\x98\xa7\x7a\x15\xac\x85\x19\xa7\xd9\x5f\xc4\xaf\x2d\x03\x50\x42\xc6\xf3\x28\xd3\xfa\x60\x08\x69\x91\xdf\x6d\xc9\xd0\x7e\x82\xcc\x40\xbe\x05\xd1\x2c\x52\x48\xb6\xa8\x05\x81\xd3\x2d\x92\x75\x7d\x3d\xaf\x8b\xf7\xd8\x14\xbe\x38\xa8\x30\x6d\x1b\x68\x84\x8d\xe2\xaa\xc4\x97\xb8\xc4\xb9\x31\x22\xf6\x40\x81\xd4\xaa\xf5\x05\x79\x4d\xf7\x02\xb7\xab\xa5\x66\x21\x60\xa0\xad\xeb\x62\x18\xcd\xab\x10\x96\x5c\x58\x89\xa5\x0c\xd0\x54\xa8\x02\x8f\xc6\x81\xd5\xca\x64\xd0\x68\x94\xd9\xca\xc2\xc5\x0e\x84\x1e\xf1\xcd\xc0\xb6\x2a\x92\x19\x89\xa5\x88\x66\x72\x70\xd1\x84\xd8\x25\xbe\x2a\xac\x73\xdb\x80\x95\x30\x65\xdc\x25\xf5\xe2\xda\xee\x87\x3c\x58\x8c\x73\x0e\x01\x6d\x1f\xeb\x2a\x2b\x0b\x1c\xac\x56\xf9\xae\xf4\xc5\xc5\x94\x65\x46\xd7\x75\x31\x98\xc2\x10\xd8\x97\x43\xf6\x2e\x3c\x98\x3b\x06\x0e\xe0\x8c\x01\x42\xb8\x62\xd5\x8d\xba\x5a\x06\xae\xd9\xdb\x2e\x39\x14\x96\x1a\xcb\xe0\x42\x86\x06\x8f\xb1\x8e\x41\x86\x51\x69\xf3\x96\x26\x35\x9d\xaa\x18\x1a\xb4\xa6\x22\x06\x09\x24\x50\x48\xc7\x12\x06\x7b\xaf\x98\xfc\x80\x39\x08\xa2\x69\x3b\xd1\x1f\x42\xf9\x1f\x09\x51\x54\xba\xa3\xef\xbe\x35\x48\x20\x3d\xea\x20\x35\x99\x8f\xbd\x65\x18\xbd\x3f\xc0\x83\x31\x45\xa9\x39\xf3\xee\x1a\xf5\x84\x60\x07\xcf\x3d\x22\xa3\x64\xfa\xf3\x6e\x41\x2c\x47\xaa\x46\x1b\x0f\xb1\xec\x2b\x9c\xa7\x58\xb9\x8a\x64\x2d\x01\x78\x4b\xac\x0f\xf4\x5c\x73\x10\x05\x3d\x6e\x4f\xac\x5c\x22\x4f\xcb\xbc\x0c\x13\x8b\x96\x76\x98\x95\x80\x31\xa8\x1a\x67\x2e\x25\xa0\x8a\x6a\x07\xe7\x38\x87\xf1\x04\x4c\x13\x99\x0a\x2e\x86\xf4\x8e\x53\x1a\xb0\x6b\x74\xce\xdc\x78\x09\xa7\x6e\x9b\xe6\x34\xa0\xde\x73\x9d\x39\xd7\x6e\xcc\xac\xbb\x8a\xa6\x99\x23\x44\x08\x8b\x89\xc3\xe2\xb6\x84\x52\x33\x65\x0c\xa1\x2c\xc4\xdd\x26\x0f\x1e\xbd\xdd\x90\x82\xcc\xa7\x2a\xe6\xcf\xf7\x5f\xbc\x98\xef\x2b\x89\x74\xad\xca\x44\x62\x8a\x5d\x28\x3c\x33\x52\x78\x16\x81\xc2\x73\x23\x85\xe7\x21\x14\xca\x85\x1a\x77\xb9\xf0\xd7\xfc\x9d\x06\xe7\x9d\x37\x4e\xee\x39\x78\xdb\x6b\xf1\xa1\x14\x74\x79\x03\x01\x20\x90\x8a\x76\xb1\xcb\xb7\x07\xd2\x30\x07\xb1\x01\x50\x20\x35\x6d\xc2\x8b\x6f\x0f\xa4\xa1\x3d\x73\xe3\xdb\x03\x69\x18\x8f\xd3\x64\x98\x60\x5a\x96\x53\x32\x15\x5c\x1c\x9a\x00\x6a\x61\x74\xf4\x59\x3f\x01\x20\x90\x8a\xa6\x6c\xa5\x6f\x0d\xc4\xaf\x3b\x93\xe2\x9a\x43\x28\x70\x4f\xa6\x43\x5e\x55\x0f\xa5\x64\x8c\x3a\x3d\x44\x30\x1d\x7b\xe4\x91\xc0\x82\x29\x1a\xa3\x4f\x0f\x11\x4c\xc7\x18\x81\x7a\x88\x60\x3a\xd6\x28\x24\x42\x45\xa0\x07\x88\x44\x43\xc8\x58\x74\x41\x14\x43\x69\x99\x23\x12\x07\x12\x4c\xc9\x10\x95\xda\xf6\x60\x1a\xa6\xc8\xd4\x01\xf8\x53\xe1\x9e\x1b\xb7\xbf\x47\x1e\x48\xc5\x16\x2e\x64\xa8\x50\x7a\x86\x60\xc1\x01\x84\x52\x31\x84\x0a\x0e\x20\x94\x8a\x25\x50\x48\x40\xe1\xd4\xac\x61\x42\x01\x18\x89\x2a\x84\x5e\x20\x25\x53\x88\xe0\x21\x42\xe9\x68\x03\x44\xd7\x1c\x4a\x41\x1f\x1e\xfa\x76\x7f\x1a\xca\x07\xbb\x5d\xde\xf6\x8e\x41\xd9\xe0\xc6\x32\x54\x14\x7a\x06\x87\x96\xa1\xa2\xd0\xb3\xb8\xb6\x0a\x32\x12\x5d\xab\x93\xeb\xa0\x63\xd2\x07\x53\x8e\x41\xd3\xe4\xf8\x03\xb0\x28\x14\xb5\x21\x40\x84\x89\x42\x4b\x1f\x0c\x24\x20\x7f\x6a\xdc\xc3\xd8\xf6\x97\xb3\x03\xa9\x18\x1c\x91\x03\x08\xa5\x62\x71\x3f\x09\x28\x9c\x9a\xd5\xe9\x14\x80\x91\xa8\x42\xe8\x05\x52\x32\x39\x18\x0f\x11\x4a\x47\xeb\x56\x5d\x73\x28\x05\xbd\x33\xf5\xed\xfe\x34\xb8\x87\xa8\xed\x2f\x55\x07\x52\xb1\x58\xb8\x04\x14\x4e\xcd\x6a\xe1\x0a\xc0\x48\x54\x21\xf4\x02\x29\x99\x2c\x9c\x87\x08\xa5\xa3\xb5\xf0\xae\x39\x94\x82\xde\xc2\xfb\x76\x7f\x1a\x8a\x27\xa0\xe1\x6f\x45\x47\xa1\x6a\xb5\x43\x0d\x70\x44\xea\x50\xba\x11\x28\x9a\xec\x52\x86\x8a\x41\x4f\x6b\x9f\x02\x48\x0c\x4a\x7a\x3b\x15\x61\x42\x68\x69\x9f\x63\x76\x7f\xbf\x39\x12\x17\xe6\xf1\x1c\x42\xc6\xa2\x6b\x18\x57\x09\x2c\x16\x45\xd3\xf8\xca\x70\xe1\x34\x1d\x46\x37\xf2\xb8\x5a\x62\x82\x48\x33\x98\x1a\xc4\x7e\x62\x59\x8e\xd5\x66\xa2\x58\x8b\xdd\x4e\xc2\x2c\x44\x78\xfa\x18\xf2\x3a\x72\x28\x25\xad\xda\xfa\xf6\x60\x1a\x7a\xa5\x71\x00\xfe\x54\x9a\xa7\x8c\xcd\xef\x1c\x07\x61\xd7\x0b\xd0\xb6\xfa\xe3\xef\x1e\x29\xb6\xbd\x61\xec\x4b\x81\xee\x14\xb4\xd5\x7c\x5c\x73\x04\x0a\xda\x42\x1b\x19\x26\x90\x96\xb1\x72\x4f\x86\x51\xd0\xa2\x20\x8e\xf5\x7a\x0d\x5a\x53\xa9\x9e\x04\x12\x28\xa4\x63\x81\x9e\xbd\x57\x4c\x7e\xc0\x1c\x04\xd1\xb4\x15\xe3\x0d\xa1\xbc\xeb\xf0\x18\x2a\xcd\x1b\x72\x5d\xa3\xde\x96\x60\x2f\xc8\x35\x88\x34\x0f\xc8\xf5\xad\x06\x3a\xb0\xe7\xe3\x18\x2a\x5d\x09\x7d\xdf\x1a\x34\x3e\x7a\xd4\x41\xa3\x6e\x2e\xa1\x97\x61\xf4\xaa\x02\x97\xd0\x33\x94\x9a\xf2\xc8\xae\x51\x4f\x08\x56\x8f\xd8\x23\x32\x4a\xa6\x2f\x85\x14\xc4\x72\xa4\x6a\x74\xd9\x30\x47\xd5\xdf\x67\x36\x5c\x64\x86\xe1\xce\xf2\xf7\x5c\x3c\xd1\xbf\x5c\xa9\x04\x54\x69\x30\xcb\xdf\xef\x22\x31\x42\x39\xf3\x01\x60\x60\x24\xca\xf3\x1a\x7f\xa8\x0d\x33\x80\x0e\x78\x3c\x7e\x74\x66\x35\x04\x0b\xb2\x01\x36\xa0\xc9\xc5\x12\x43\x89\x6b\x7b\x28\xf8\xf0\xd2\x82\x96\x72\x88\x9c\x74\xc8\x80\x12\x2a\x60\x63\xc9\xc6\x90\x41\xf9\x18\xc2\x6a\xec\x8d\xb6\xf9\x1a\x9d\x9d\x07\x4f\xc5\x37\xdf\x5e\x30\xba\xb7\x0c\x13\x8b\x96\xb8\x48\xbb\x2c\xca\x9b\xa4\x06\xd1\x57\xf4\x53\xf0\xd4\x74\x63\xed\xbb\x6a\x2c\xc1\x5c\xb3\x08\x13\x2a\x83\x0a\x8b\xa7\x44\x22\xce\x38\xf2\xf9\x0a\x14\xd3\x4e\xe0\x3c\x84\x51\xd5\xbe\x89\xca\xb7\x07\xd2\xb0\x9a\x89\xd6\x02\x5c\xa8\x64\x79\x8a\x3f\x18\x88\xd0\x76\xad\x95\x81\xe9\x18\x28\x78\xf2\x5f\x94\x29\x2e\xe9\xe4\x65\x8c\x7d\x0a\x30\x53\xe8\x05\x10\x66\xa7\x2e\xda\x6c\x01\xd7\xec\x2b\x1a\x8f\x42\x27\x97\x0c\x13\x48\xcb\x98\x2d\x90\x61\x14\xb4\x28\x88\x63\xb6\xa0\x41\x6b\x9a\x54\x24\x90\x40\x21\x1d\x83\x97\xbd\x57\x4c\x7e\xc0\x1c\x04\xd1\xb4\x65\x0b\x86\x50\xde\xd9\x02\xed\xa9\x79\xd0\x69\x39\xeb\xac\xd9\x87\x76\x8d\x7a\x0b\x85\xed\x08\x7b\x44\x9a\x7d\xa8\x00\xa0\xa7\xe6\xb6\x0f\x6d\x90\x1a\x8d\x21\xc4\x04\xfa\x73\x38\x6d\xf4\x92\x40\x22\x51\xd2\xca\xa4\x82\x8b\x40\xd3\x18\xcd\x54\x70\xea\xc7\x84\x19\x98\x63\x54\xe3\xd0\x9b\x22\x9b\x02\x2c\x82\xe0\x8e\x11\x0e\xd6\x33\x36\x5f\x4e\x9c\x04\xd3\xb6\x45\x3c\x35\xa4\x77\xd4\xeb\xd1\xe9\x12\x8b\x22\x44\xb0\x80\x66\x12\xc1\xea\x33\x27\x1a\x55\x70\x66\x5f\x02\x27\x1c\x7b\xd4\x9a\x60\x2f\x00\x98\x89\xc2\xc2\xaf\x88\xd0\x2a\xb1\x3e\xf8\x0f\xc4\x85\x73\xc0\x0e\x48\x0d\xe1\x99\x03\xf0\x1f\x5c\x1e\x89\xde\x23\x65\xa8\x60\x7a\x96\xb0\x2c\x43\x29\x35\x4b\x81\x9c\x43\x72\x83\xda\x1c\x90\x25\xa0\x60\x71\x9d\x83\xb1\xbd\x5f\x5c\x9e\x1c\xb8\x08\xa4\xdb\x7c\x6f\xc3\x44\xb0\x01\x31\x0d\x7a\x03\xe2\x44\xf7\x16\x97\x9a\x0f\xb6\xca\x30\xa1\x32\xda\x27\x9a\x21\x5c\xc0\x34\xc3\xd7\xbc\x58\xe3\x05\x07\x16\x2c\x26\x87\xca\x66\x44\x32\x6c\x24\xda\xa0\x38\x22\xc3\xea\x0d\x8b\x81\x7a\xc6\x94\x86\x0c\x24\xb2\x48\xa0\x91\x94\xe1\x19\x65\xec\xbd\xc7\xe0\xcf\x99\xa3\x28\x3c\x80\x3d\x73\x00\x1d\xc9\x3f\xf5\x4b\x41\x19\x2a\x8a\xc0\x76\x52\x51\xd4\xba\x2a\xb3\x9b\xa4\xbc\x07\x0e\x2c\x0f\x1d\x87\xbe\x6d\x69\xaa\x86\xb5\xc7\x01\x87\x25\xaa\x58\xdf\xb9\x28\xf2\xb4\x13\xf1\xbf\x8b\x0c\x10\x9f\x14\x9d\xec\x0c\x8a\x9d\x02\x19\x75\xe6\x31\xca\xd8\x69\xd7\xf4\x12\x90\x5d\x19\x6e\x2b\xeb\x1e\x31\xc8\x6c\x4c\x6b\x7c\x85\xcd\xb8\x72\xa3\xad\x10\x11\x00\x42\x35\x6e\x8b\x3e\x31\xe2\xce\x2a\x59\xbc\x4f\xae\xb4\xdb\x51\x09\x28\x90\x9a\x91\x46\x98\x1c\x16\xab\x08\xf8\x24\x9d\xfd\x85\x5d\xeb\xa3\xba\x4e\x74\x2c\x1e\x66\xf4\x2d\x57\x3b\x06\xf8\x93\xd5\x93\x7c\x7d\x68\x9d\xbf\xcf\x8b\xbb\x1c\xb2\xc5\x90\x40\xf5\xbc\x80\xc8\xf7\x1f\x96\x5f\x14\x79\x5d\x16\x1a\x47\x1e\x80\xf9\x0e\x6c\xb5\x28\xb3\x95\x72\xfd\xc2\x5a\xbc\xf1\xb2\x02\x63\xed\x1e\x82\x6f\x8f\x41\x43\x3b\xeb\x0c\x80\x42\xa9\x19\xf7\x09\x03\x20\x05\x35\x06\xe3\xb8\x33\x68\x11\x9b\xb6\x04\x32\x4c\xa8\xa4\x8e\x9b\x00\x40\xb7\xa8\x1c\xc1\x79\x08\xa3\x6a\x5b\xe8\x2b\xc0\xbc\x57\xf8\x0d\x2e\x7a\xbe\x4c\xd3\x81\x66\x39\x05\xb8\x30\x31\x0d\x54\xc2\xd4\xa7\x99\x32\xfa\x56\x83\x8b\xc0\x82\x36\x87\x4a\x33\x5f\x88\x10\x06\x82\x6e\xb3\x45\x8b\xd6\x3c\x48\x21\x63\xa3\x51\x9e\x5a\x6b\x50\x8c\xf3\x12\x2f\xb5\x11\x8c\x6f\x57\xd0\xa8\x93\xab\x7d\xaa\x9e\x67\x10\x5a\x65\xb2\x78\x6f\xf5\x9f\x21\x94\xb7\xfb\x50\x54\x7a\x1a\xbe\x2a\xa3\x9d\x35\xa6\xd5\x35\xfa\x62\x27\x37\x59\x6c\x2a\x92\x61\xbc\x15\xa4\xb9\xae\x13\x70\x51\x47\xf7\xc1\xe8\x90\x8f\x43\xd3\x6b\x37\xda\xa5\x43\xdf\x1a\x8e\x5f\xeb\xbb\x12\x48\x18\x25\xe3\x92\x41\x02\x51\x50\x22\x10\x8e\xcb\x05\x86\xd4\xb4\x58\x10\x21\xc2\xe4\x73\x5c\x28\x58\x3b\x45\xe4\x06\x4a\x3f\x84\xa2\xcd\x7b\x07\x40\xde\xee\x4b\x31\x99\x0a\xff\x05\x00\xad\x29\x39\x7c\xc4\x8c\x22\xd4\xed\xf5\xbb\xc6\x10\xf5\x69\x11\x87\x0c\x89\x39\x8b\x27\x81\x68\xf5\x04\xce\xd9\x51\x84\x9a\xb9\xba\x6d\xd3\x52\x81\xad\x35\x3a\x34\x26\x99\xf4\x0b\x1c\x5e\x20\x37\x92\x26\x27\x72\x75\x9d\xaa\x4e\xea\xac\xaa\xb3\x45\xf5\x74\x59\x5c\xcd\xbb\x3f\x55\x04\x04\x00\x05\x91\x3c\xb9\x21\xa7\x77\x49\x8d\xaf\x8a\xf2\x7e\x17\x7d\x56\x67\x37\xb8\xaa\x93\x9b\xd5\x67\x76\xea\x3d\x62\xed\xf3\x87\x12\x88\x82\x83\x34\xd1\x7e\x09\x5c\x45\xca\x4c\xc4\x41\x8b\x77\xd9\xfb\x2c\x2d\x16\xd5\xd3\xf6\x1f\xfa\x7a\x5b\x11\x42\x41\x64\x45\xe2\x04\x51\x25\xa3\xf5\xe6\xf4\xf0\xe0\xfc\x10\xfd\x74\x7c\xf4\x7f\x7f\x3a\x44\x47\xc7\xdf\x1f\xfe\x15\x25\x65\xf3\x48\x7b\x96\x7e\x20\x61\x0c\x9d\x1c\x23\x7a\xbc\x39\x27\x2d\x49\xb9\xb8\xce\x6e\xf1\xd3\x16\x0a\xfd\x74\x76\x74\xfc\x03\xba\xa8\x4b\x8c\xb9\xb0\xd6\x20\x66\x18\xfb\xde\x04\x65\x93\x1e\x51\xa3\xed\x7e\x11\xf1\x0a\x29\x15\x28\xd3\x6c\x1c\x7d\x99\xee\x7b\x13\x94\xf4\x2f\x35\xd2\xee\x17\x11\x2b\xf7\x55\x60\x01\x6f\xb2\xac\x71\x99\x27\x75\x76\x8b\xe7\x6d\x79\x74\xfa\x61\xce\xff\xcc\x69\x88\xf7\xcf\x61\x4f\x91\xa2\x02\x85\x85\x7e\x03\xc5\x18\x60\x4f\x8c\xb2\xfb\x3c\x26\xd2\x2d\x77\x22\x6d\xbe\xb7\x61\x98\x34\xc4\x9b\x11\x73\x22\xa9\x1e\x36\x1d\x85\x6e\x92\x64\x79\x2e\x67\x6a\x6d\xff\xdd\x26\x51\xe6\x40\x9a\xb8\x9b\x33\xbd\x81\x8f\x9a\x69\x00\x4c\x46\x49\x06\x66\x27\x6c\xd3\xe3\x64\x25\xb4\x0b\xd4\x46\x4c\x14\x6d\xda\x53\x10\xb2\xc4\xb7\x55\x9f\x4d\x25\x04\xd8\xa7\x58\x15\x06\xd8\xc3\x89\xe8\xdb\x0e\x2a\x11\x64\xdc\x77\x39\x2e\xe1\x88\x09\xb4\x0a\x6b\x89\x93\x66\x73\xc2\xc6\x00\x27\x03\x9c\x1d\x88\xac\x73\x9c\x08\x18\xa5\xa8\x29\x20\x6e\x4e\x57\x5d\x70\xef\xa2\x65\xb1\x48\x96\x78\x07\xfd\xe5\x4f\x87\xa7\x87\x68\x36\x6b\x90\x90\x8b\x25\x73\xd6\x86\x5e\xa3\xba\x5c\xe3\x1d\x74\x70\xfc\x3d\x9a\x35\xbf\x1d\x9d\xa1\xe3\x93\x73\x74\xfc\xd3\xbb\x77\x3b\x20\x06\xe5\xe8\xae\xe6\x10\x1c\xde\x59\x97\x6e\x53\x60\xd6\x6b\x0b\xe6\xae\x5b\xd3\xae\xc7\x28\x8c\xa1\xa3\x87\x78\x1a\xea\x4a\xdf\x72\x23\xaf\x00\x77\xd3\x09\x5c\x0b\x60\xb9\x85\xbd\x17\x95\x13\xdf\xcd\x35\x24\x78\x58\x29\x8a\xd0\x4e\x16\x61\x0c\xdc\x83\xd9\x35\x8d\x04\x6c\x52\x20\x58\xc8\x5e\x80\x60\xaa\x93\x2b\xa5\xa0\xe4\x77\x01\x59\xb7\x29\x50\xe3\x22\xfb\x0a\xa3\x57\x34\x40\x16\x9f\xd0\x60\x65\x3b\x13\x38\x5e\x7e\x6b\x66\xc6\x6c\x90\x7f\x88\x56\xd6\xc1\x70\x80\xad\x36\x0a\xb7\x4c\x3a\xfb\xf1\x61\x9c\xfc\x30\xc4\xd9\x83\xa9\xa6\x4f\x13\xbb\x12\x01\x6d\x38\x37\x53\x18\x3d\xa4\x73\x6c\x9a\xb5\x2b\x01\xba\x68\x59\x0a\xec\x26\x4d\xeb\x82\x3b\x4c\xdb\x5e\x01\xde\xda\xd5\x4b\x54\xa7\x20\xef\xc8\x82\xb2\x83\xab\x76\x5c\xf4\xe1\xa0\x81\xe6\x15\x0a\x22\xa7\x7d\xb8\x39\x60\xeb\x80\x0b\x54\xc0\x93\xca\x00\xda\x3a\xad\x08\x74\xf4\x31\x97\x4a\x05\x8b\xb6\x1d\xa6\x0b\x7c\x95\xe5\x60\x7c\x3d\xb4\x19\x2b\xce\x53\x30\xce\x16\xd6\x6a\x2c\x46\xeb\x70\x30\x07\xba\xdb\x2b\xee\x70\x69\xb0\x7c\x69\x6f\x47\xa0\x67\x6c\x76\x7d\xf9\xb2\xc6\x1f\xea\x1d\x33\x7a\x28\x62\x6b\xa2\xa5\x43\xb8\x5e\x2e\xe7\x8b\xe2\xe6\xa6\xc9\x32\x80\x10\xb7\xf1\xb9\xed\x77\x74\xc6\x62\xaf\x91\xf5\xaa\x28\x6b\x30\xff\x1d\x30\x48\x88\x75\x9e\xfd\x46\xa1\x5d\x25\xd9\x45\x4d\x07\xa5\x48\xed\x94\xa2\x15\x8b\xcb\xec\x5a\xdd\xbf\x87\x75\xf3\x7e\x89\x86\x6e\xf9\x62\xa4\x61\x58\xc3\xd0\x7e\xc6\x15\x5c\x0b\x01\x5b\xc3\x75\xf8\x40\x0c\xbb\xae\xb8\x06\xd8\x8d\x1c\x3b\xaf\xba\x58\x47\xfb\x84\x61\x9f\x26\x04\xc4\xec\x39\x05\x82\x32\xcd\xaa\xc5\x10\x2b\x6d\x17\xf1\x31\x40\x55\x46\xb3\xc3\x75\x59\x62\x9c\x5e\xcc\x41\xe8\x3a\x58\x2d\x46\xa2\x50\x03\x77\x43\x55\x2a\x38\x54\x08\x4d\xd0\x12\x6e\x60\x38\x59\xee\x85\xd6\xb7\xed\xa2\x25\x4e\xd2\x62\x5d\xcf\x8b\xcb\xcb\x0a\xd7\xbb\xcd\xf9\x37\xfb\xcb\x28\x06\x0f\xe8\x44\x58\x8b\x9b\x4e\xdc\xed\x8e\x49\x46\xd9\x35\x82\x66\xc8\x06\xba\x0d\x5d\x1a\x64\xaa\x09\x57\x13\x26\x24\x84\x4d\xf9\x25\x18\x2f\x03\xd7\xe1\x4d\x93\x3a\x61\xd3\x5a\xfb\xc2\x84\x12\x31\x01\x6b\xd0\x5e\x65\x39\x61\xb6\x4c\xee\xe7\x25\xbe\x29\x6e\xf1\xec\xe0\xf4\xf4\xe0\xe7\x5f\x66\x33\x0a\xf4\xff\xfb\xee\x3b\xb4\xfd\x8f\x0e\xdb\x6e\x96\xfe\x6b\x9b\x4d\x7c\xbf\xfc\xba\xb3\xf3\xf2\x65\x96\xd7\xf8\x0a\x97\xbb\x68\x00\xbf\xeb\xda\xa9\x58\xa6\xce\x7d\x72\x7c\xe7\xdc\xa7\xad\x61\xa9\xae\xb3\x95\xbd\xf3\xaf\xbb\x74\x4e\xe9\xfe\xde\xd1\xa9\xbe\xf9\x4e\x95\xc6\x44\x68\x2b\x2c\xed\x4f\xe1\xc9\x18\x2e\x96\x45\x85\xe7\x75\x76\xa3\x1c\xc4\x16\x59\x49\x06\xb0\x07\x35\x21\x64\x81\x7a\x9e\xa5\xf3\x14\x57\x0b\x13\x52\x3e\xb0\xef\xa2\x2c\x45\xdf\x1f\x9e\xbd\x01\xa0\x2e\x56\x38\xb7\x72\x2c\x22\xef\xba\x18\xd1\x7f\x58\x65\x25\x50\x17\x1c\xac\x09\x25\x88\x55\x8a\x10\xc4\x21\xf3\x4b\x45\x74\x1f\x4a\xce\x40\x89\x5a\xbb\x55\x0c\xfb\x09\x7d\xfb\x1d\x7a\x66\x22\xd2\x4c\x75\x76\x12\xd4\xa2\x51\x96\x6a\x91\x71\xdf\x65\xd2\x58\x6c\x0f\x22\xa2\xee\x7f\xd7\x21\x6f\xde\xcc\xd4\xe0\xa5\xad\xf2\x89\xc9\x05\x5e\x5a\xb1\x99\x22\xa5\x02\xa9\x39\x50\xe6\x45\xb3\xe3\x57\x9d\x4e\x74\x10\x43\x8b\x05\xe1\x1b\x2e\x9f\x0c\x18\x95\x2b\x27\x11\xe7\xaa\xa8\x6a\xad\xa9\x0a\x68\xa9\xbd\x76\xe0\x70\xc4\x2e\x6a\xe8\xb9\x21\x41\x81\x46\xc7\x33\xf4\xee\xe0\xec\x9c\x55\x11\x18\x43\x05\xa5\x5c\xe2\x45\xb6\xca\x1a\xdb\xeb\xff\xd2\x92\xe7\x40\xa4\x13\xa9\xe6\x67\x1d\x35\xf6\x84\x89\xce\x10\x69\xab\x24\x1a\xf9\x49\x87\xad\xbf\x8a\xaf\xc3\xd8\x41\x0c\xf8\x64\x3f\xeb\x31\xf3\x97\x80\xf4\xd8\x39\x28\xe5\xd9\x1c\x6b\xb2\x51\xb1\xe0\x87\x9e\xfa\xd1\x3e\x4d\x21\xab\x0e\x25\x6b\x96\xfc\x92\xfe\xa6\x43\x48\xea\x0d\x75\xd8\xd6\xa5\xe4\xe2\xeb\x52\x1b\x35\x68\x11\x8a\x0e\x11\x69\x14\x31\x91\x5f\x34\xa8\x8a\x72\xbe\x28\x96\x4b\xbc\xe8\x8e\xea\xf4\x2e\x2e\xc0\x5a\x5d\x5d\x58\x7e\xab\x49\x5d\xa9\x83\xbd\x81\xce\x95\x36\xe8\x0f\xb1\xab\x36\xf7\x16\xf4\xe6\x2d\xfe\x90\x8c\x69\x73\xa6\x86\x76\xd8\xa7\x75\xeb\x19\x93\x28\x3e\x09\x9c\x06\x71\x57\x09\xc0\x97\x05\x68\xa4\xe8\x9a\x95\xb5\x00\x56\x19\xd8\x49\x71\x5d\xbc\xc7\x6c\x68\x92\xc5\x02\x57\x15\xfb\x41\x43\x91\xeb\x22\x12\xe5\xfb\xea\x85\x93\x29\x1a\xad\x5a\x4b\x0b\x68\xd6\x32\xb1\x12\x5f\x96\xb8\xba\xf6\x93\x4f\xe8\x6c\x25\xbd\x2a\xf1\x25\x2e\x71\xbe\xe0\xe7\x67\x93\xe9\xf7\x1d\xd4\x0b\x56\xcd\xd1\x5a\xd3\xbb\x5a\x5f\x54\x8b\x32\xbb\xc0\x73\x21\x4b\xab\x4a\x34\x69\x7a\xb8\x9d\x2e\x98\xc8\x92\x34\x9b\x17\xd1\x56\x54\xe3\x26\x56\xc0\x22\x45\x96\xfe\x4f\x2b\x7d\x5d\xa4\xe9\x7f\x77\x10\x5e\x62\x03\xa4\x00\x73\xc4\xde\x45\x7d\xbb\x03\x23\x5c\x68\x02\x31\xa1\x8a\x55\x2d\x03\x1d\x54\x3a\x37\x2e\x18\x05\x84\xfd\xb2\x99\xfe\xcb\xca\x00\x68\x59\x6e\x96\xb9\x27\x09\x12\x59\x41\xb1\x95\xd8\xb4\x21\x10\x70\xf4\xeb\x8f\xe6\x9f\x56\xaa\xc0\x25\x89\x59\x52\x8e\x2c\x48\x54\x15\xd1\x6e\x74\xb5\xeb\x21\x56\x2d\xd8\x1d\xd2\x76\x85\x85\x02\xa9\x1e\xc8\x96\x5d\x10\x45\x92\x90\x6b\x4e\x80\xcd\xe8\x47\x3e\xff\xe5\x79\x34\xae\x23\x24\x38\x87\x9a\x4d\xe1\xf0\xd7\xa4\x60\xf5\xd1\x2f\x4c\xc9\x1e\x07\xbf\xf6\x9e\x3e\x42\x3a\x1c\xfb\xba\x32\xa0\x82\x77\x54\x8c\x83\x2a\xe0\xc2\x03\xcf\x62\x87\xc0\x6e\x15\x3e\x5d\xf2\x4f\x2b\x01\x9c\x65\xe3\x90\x80\xaa\x7c\x18\x1e\xd0\x41\x94\x0c\x0a\xcd\x4f\x28\x29\xe8\xfd\x47\x4b\x40\x97\x03\xa5\xbd\x0c\xc7\x5c\x1d\x00\xe4\x94\xab\xc7\x06\x51\x86\xdb\x19\xd7\x10\xb7\x89\x5b\xc7\x13\xae\xa6\x9f\xd5\x3b\x1c\xf7\x4d\x57\x38\x4f\x71\xa9\x43\xc9\x5a\x21\xf6\x7a\x85\xf3\x12\x73\x93\x08\xfd\x5b\x81\xae\x05\x92\x70\x92\x06\x23\x93\x02\x72\xcd\x0c\x65\x46\x3f\xf2\x0c\xc5\x88\xeb\xf5\x58\x3a\x8e\x4a\xa9\x0f\xc8\x0a\x6c\xb0\xbd\x2c\x97\xea\xed\x95\xd9\xff\x28\xd3\x91\xc1\x41\xc9\x5f\x41\x0e\x25\x41\xcd\xe8\x41\xa9\x8d\x3c\x8e\x03\x96\x4d\x2e\xa7\x04\x86\xb8\x0b\xdf\x51\x58\x78\xc0\x46\x43\xbd\x04\x71\x1d\x11\x8f\xc5\x08\xb0\xbb\xb7\x0a\x1c\x96\x25\x5e\xac\x68\x3b\xf9\x68\xcc\x55\x47\x8e\x5a\x01\xae\x57\x34\x3d\xdc\x16\x2d\xe2\x21\x90\x59\x2a\x47\x31\xec\xe3\x07\x5a\xc8\x70\x18\x0d\x6b\x01\x11\x0a\xb2\x20\x90\xf0\x9a\x57\x05\x43\x60\xe0\xd2\x40\x43\x05\xe4\xed\x4a\x4a\xc6\xa3\x37\x0d\x35\xab\xbe\x1c\x97\x24\x7c\x67\x98\x3f\x80\xbd\xa0\x2a\xe6\xcf\xf7\x5f\xbc\x98\xef\x6b\x2b\x1b\x7b\x10\x50\xed\x46\x07\xfe\xcc\x8e\xf1\x99\x1b\xc6\xe7\x76\x8c\xcf\x81\x18\x4b\x56\x1e\x44\xfe\x31\xc4\x55\x4a\x85\x41\xe4\x17\xc3\xe0\xf0\xc8\xb8\xe3\x28\x10\xda\x5d\x64\x3a\xa8\xea\x50\x3b\x60\x55\xe3\x93\x38\xbe\x6b\x39\xbe\x53\x88\x7f\x37\xe0\xf3\x6e\xb1\x8b\x34\xa7\x35\x1d\x2e\xd2\x6e\xc7\xa5\xc1\xb2\x9c\xb3\x0b\x25\xed\x7d\x0b\x9c\xd7\x59\x7d\xbf\x2f\xe3\xe3\xc0\xe4\x2a\x56\x0a\xaf\x17\x59\x26\xa0\xca\x20\x59\xb0\xef\xed\xa2\x86\xcc\x2e\x62\x9f\x8d\x23\xff\x65\x8f\xfe\x98\x44\xe2\xca\x72\xcd\x42\xa9\x8a\x73\xa1\x62\x19\xd3\xcf\x56\x0a\x7e\xa2\xf5\x3b\x67\xb3\x64\xaa\x32\x1c\xa0\x60\x3d\x09\x83\x5c\x5a\xfc\x7e\x62\x49\x33\xaa\x59\x36\xdd\xdc\x0a\x15\x50\x22\x66\x90\xd2\x4c\xc9\x4f\xd4\x3e\x79\x6c\x96\x52\x95\x35\x06\x0a\x68\xce\x4f\xdb\xf0\xfb\x89\xd5\x95\x20\x58\xc4\x52\x14\x23\x40\xc5\xea\x49\x18\xc4\xd2\xe2\xf7\x13\x4b\xa8\x85\xb0\x88\xa6\xa9\x8a\x80\x8a\x27\x92\x32\x88\x68\xa4\xe3\x2b\xa6\x54\x98\x61\x15\x55\x5b\xa2\x01\x17\x57\x26\x69\x14\xd9\x42\x2f\x4c\x6c\xb0\xc0\x61\xa2\xc2\x84\x8c\x27\x1e\x77\x7a\x63\x96\x4e\x79\x6c\x03\x14\xce\x72\x44\x64\xa5\xe0\x27\x5a\x53\x3e\x63\x91\x6b\x50\x48\x03\x15\xaa\x45\x6f\x90\x48\x83\xdb\x4f\x9c\xb6\x8a\xc7\x22\xcf\xb0\x9e\x07\x2a\x50\x47\xc0\x20\x91\x0e\xbb\x87\x48\xec\xf6\x1a\x68\x19\xc6\x81\x7a\x88\x26\x13\xd2\x89\x67\xa1\xe2\x2b\x22\x68\x39\xd6\x43\xfa\x0a\x68\x5f\x92\x99\x69\xf8\x8a\x07\x5f\x96\x49\xe0\xbe\x82\x02\x97\x66\x00\x6a\xbe\x22\x83\x96\x67\x3d\xa4\xaf\xa0\xf6\x25\x9a\x99\x86\xaf\x78\xa0\x65\x5a\x0f\xe9\x2b\x9e\x7d\xa9\x66\xa6\xe1\x2b\x1e\x78\xb9\x26\x42\xfb\x8a\x09\x5b\xb2\xd9\x69\xf9\x8b\xeb\xb0\x6c\x1b\xf6\xf0\x17\x1b\xba\x74\x83\xd1\x0c\x15\xdf\x41\xf0\x50\x91\xa1\xc2\xc6\x14\x13\xb6\x8c\xe3\x40\x7d\x85\x04\x2c\xe5\x2c\x54\x7c\x45\x04\x2c\xe7\x5a\x38\x5f\xe1\x6c\x4b\x3a\x13\x7e\x5f\xb1\x20\xcb\xba\x0e\xd0\x57\x30\xeb\xd2\xce\x48\xc1\x59\x34\xb6\x16\x01\x2c\x7c\x38\x40\x67\xd1\x64\x22\x6a\xd1\x2c\x14\x3c\x45\x83\x2e\x7a\x64\x68\x4f\x21\x41\x4b\x1e\x08\x2d\x4f\x71\x01\x0b\x1e\x0e\xd0\x53\x48\xdb\x72\xc7\x42\xc1\x53\x34\xc0\x62\x87\x03\xf4\x14\xcd\xb6\xd4\xb1\x50\xf0\x14\x0d\xb8\xd0\x91\x80\x3d\x45\x84\x2c\x73\x00\x94\xbc\x45\x05\x2f\x72\x14\x1d\xbc\x45\x86\x2d\x71\x80\x14\x03\x45\x87\x0b\x1d\x28\x2e\x50\xd0\x88\x22\x42\x16\x37\x3c\xa4\xa7\x80\xd6\xa5\x8d\x8d\x86\xa7\x78\xd6\x85\x4d\x07\xe6\x29\x98\x79\x59\x63\xc4\xee\x29\x92\x7d\x51\xd3\xc3\x79\x0a\x65\x59\xd2\x98\xf1\x3b\x8b\xc5\xcf\xc0\xc0\xb9\x5f\xd9\xc5\x59\x58\x3d\x61\xb5\xdc\x60\xaa\x21\x2a\x00\xac\x07\x64\xe8\x10\xc1\x6d\x2b\x03\x08\xad\x10\x71\x01\x6b\x04\x19\x3a\x44\x5c\xdb\x6a\x01\x42\x2b\x44\x5c\xe0\xba\x41\xd5\x23\x44\x6c\xc8\x0a\x02\x4a\x33\x4c\x7c\xf0\x5a\x42\xd7\x2b\x4c\x0d\xb0\x55\x85\x0b\xed\x18\xea\x70\x54\x44\x0c\x15\xb8\x08\x1f\x5b\x6c\xc8\x9a\x63\x00\x1e\x22\xb4\x75\xf5\x01\xa2\x16\x22\xb2\x75\x1d\x22\xc2\x86\x08\x6b\x5e\x91\xd8\xe9\x84\x88\x69\x5f\x9b\x48\xc0\x21\x82\x5a\x56\x29\x00\x4a\xce\xa2\xb2\xd9\x12\x30\x45\x73\x80\xce\x22\xca\x44\xd4\xe2\x59\x28\x78\x8a\x06\x98\x8e\x39\x40\x4f\xd1\x6c\x93\xb0\x85\x82\xa7\x68\xc0\xa9\x57\x02\xf6\x14\x11\x32\xe1\x02\x28\x79\x8b\x0a\x9e\x66\x15\x1d\xbc\x45\x86\x4d\xae\x40\x8a\x81\xa2\xc3\x85\x0e\x14\x17\x28\x68\x44\x11\x21\xd3\x27\x0f\xe9\x29\xa0\x75\xd2\xb4\xd1\xf0\x14\xcf\x3a\x55\x76\x60\x9e\x82\x99\x27\x48\x23\x76\x4f\x91\xec\xd3\x62\x0f\xe7\x29\x94\x65\x32\x34\xe3\x77\x16\x8b\x45\x70\xc0\x64\xc1\x01\x3a\x0b\x26\x13\x51\x4b\x66\xa1\xe0\x29\x1a\x70\xb2\x90\x80\x3d\x45\x84\x4c\x16\x00\x4a\xde\xa2\x82\x27\x0b\x45\x07\x6f\x91\x61\x93\x05\x90\x62\xa0\xe8\x70\xa1\x03\xc5\x05\x0a\x1a\x51\x44\xc8\x64\xc1\x43\x7a\x0a\x68\x9d\x2c\x6c\x34\x3c\xc5\xb3\x4e\x16\x1d\x98\xa7\x60\xe6\xc9\xc2\x88\xdd\x53\x24\xfb\x64\xd1\xc3\x79\x0a\x65\x99\x2c\xcc\xf8\x9d\xc5\xea\xc3\x1b\x30\xaa\x2a\x3a\x38\x0b\xaa\x23\xaa\x96\x18\x48\x31\x48\x74\x70\x94\xd5\x74\x0a\x52\x01\x2c\xda\x3a\x50\x8e\xa0\x0a\x37\x25\x44\x10\xdf\x41\xf0\xc8\x22\x43\xa2\xb0\x0c\x1d\x20\xb0\x35\x1a\x43\x68\x05\x88\x6b\x8d\xca\x02\x68\x80\xa0\xe6\xe8\x6c\xa5\x12\x20\xa2\x3d\x4a\x8b\xb0\x01\x42\x5a\xa2\xb5\x9d\x8e\x87\x98\x7c\xbc\x70\x0a\x5d\xda\x8e\x1e\x0a\x30\x33\xa1\xd3\x86\x13\x07\x81\xaa\x81\xf9\xf5\xb0\x47\xa0\x32\x00\xfe\x0d\xa3\x19\x28\x3e\xc0\xcf\x25\xf0\x40\xc1\x6d\xfe\x0e\xa0\x16\x28\x32\xc4\xef\x65\xf8\x40\xa1\xad\xfe\x0f\xa1\xe7\x2d\xb6\x87\xf7\xc7\xf1\x7b\x57\x8f\x1f\xcf\xd7\x81\x0b\x16\x01\x38\x58\x6c\xb0\xc0\x51\x45\x75\x09\x68\x81\xa1\x0c\x1c\xc4\x46\x08\x5f\xe0\xc0\x15\x12\xb2\x60\xc1\x2a\x76\x98\x82\x07\xa8\xa0\xd0\x04\x0c\x4a\xd1\xc2\x51\x63\x2d\x10\xfb\x14\x40\x9d\x05\x1c\x12\x52\x4b\x68\xa5\xe2\x2b\xa2\xd5\x36\x7b\x38\x5f\xe1\xcc\x96\x69\xc6\xef\x2b\x96\xdd\x2e\x39\x40\x5f\xc1\x2c\x56\x69\xa1\xe0\x2c\xda\xba\x64\xff\x67\x14\xab\x01\x72\x16\x89\x47\xae\x16\xc7\x80\xd9\x4b\x14\xfb\x10\xb5\x50\x5e\xc2\x58\x06\xc7\x84\xdb\x59\x1c\x4a\xcb\x2e\x4f\x07\xe6\x2c\x90\x48\x40\x2d\x91\x11\xbb\xab\x48\xf4\x00\xa7\x7f\xe7\x4e\xf9\xd8\x32\x07\xe4\xf4\xc2\xb2\x8c\x5c\xf3\x88\x9e\x19\xfd\xc8\x4f\xe7\xf1\x3c\x9a\x1e\x84\x92\xe1\x20\x2f\x42\x35\x7d\x84\xb7\xf2\x4c\x0a\x56\xbf\x90\x07\x53\xb2\xc7\xbb\x78\xf6\x9e\x3e\x42\x3a\xbc\x86\xe7\xca\x80\x0a\xde\x51\x31\x0e\xaa\x80\x0b\x0f\x7c\xf4\x6e\x08\x0c\xfc\x5e\x6a\x5f\xb3\xa3\x7a\xb0\x4b\x51\xab\x63\xfe\x0a\x69\x8f\x4e\xa7\x09\xb8\xe8\xe6\x4f\x90\x2a\x90\xc1\x1e\xfd\xec\x91\x03\xd1\x9a\xbf\xeb\xc1\xa1\x33\x7c\x7e\x54\x87\x16\xf2\xf5\x51\x0d\x39\xeb\x87\x42\x35\x34\x7d\xbf\x13\xca\x28\x83\x5e\x4e\x96\x41\x81\x8f\x02\x2a\x29\x18\x82\x9a\x8e\x80\xee\x89\x7c\xd6\xcb\xf0\x5a\x62\x07\x00\x79\x28\xb1\xc7\x06\x51\x86\xdb\xf3\x88\x43\xdc\x26\x6e\x1d\x9f\x29\x6c\xfa\x59\x43\x96\xe3\xcb\xc9\xc2\x17\x61\xb2\xaa\x98\x2f\x8a\x14\xcf\x87\x2b\x18\xe5\xc7\x60\x7a\x78\x57\x02\xcf\x2e\x1c\x29\x3c\xbb\x70\x26\x51\xbb\x92\xa8\x5d\x49\x3c\x77\xa4\xf0\xdc\x40\x80\xac\xc9\x80\x53\xd3\x10\x14\x14\x9e\x49\xb7\xee\x9b\x7b\xa4\xbf\x0a\xb1\xe4\x93\xdd\xc7\x24\x51\x4f\x90\x7e\x1d\xd5\x22\x89\x95\x7f\x28\xd7\x37\x38\xcd\xd6\x37\xd2\x92\x89\xfd\x28\xe3\x95\x41\x45\xf4\xac\x55\xcf\xf6\x80\x10\x7c\xd9\x04\xe8\xea\x25\xaa\xc3\xc2\xc9\x99\x05\x65\x07\x57\xed\xb8\xe8\xc3\x41\x03\xfd\x17\x86\xe9\xbf\x34\xe8\x15\x1f\x19\xa6\x3f\x41\xf0\x1a\x0d\x48\x81\x18\x68\x3c\x3d\x01\xd5\x3e\xcd\x86\x7e\x17\x0d\xf9\x57\x51\xb9\x2c\xca\x9b\xa4\xb6\x28\x9f\x01\x39\x28\x3d\x4b\x3f\x74\xc9\xb4\x55\x51\x65\xaa\x4f\x1d\x35\x6a\x53\x7d\x4f\x6f\x17\x7d\xd6\xf6\xfa\xcc\x4c\x84\xfb\xc4\x32\x04\x3f\x07\x6e\xc0\x9b\xa7\xf8\x83\xea\xeb\x78\x7c\x7b\xf7\x7d\xe0\xaa\x46\x33\xa3\xa2\x8b\x65\xca\x7f\x56\x4b\xeb\x75\x12\x9c\xcf\xba\x96\x95\xc0\x70\x3b\x62\xf2\xb7\x4c\x87\x03\xb2\x7d\xde\x51\x90\x63\x80\x5c\xbd\xdd\x36\xa3\x1f\x79\xbb\xcd\xf3\x68\x0a\x2a\x32\x1c\xc4\xb4\x9b\x3e\xc2\xdc\x61\x52\xb0\x7a\xe6\x80\x29\xd9\x63\xde\xb0\xf7\xf4\x11\xd2\x61\xd6\x70\x65\x40\x05\xef\xa8\x18\x07\x55\xc0\x85\x07\x6e\xb7\x87\xc0\xc0\xed\x76\x5f\x1a\xac\xda\x6e\x2b\x4a\x82\x35\x4f\x63\xf7\x78\xae\x70\x61\x42\xc3\x62\xd4\x6a\x7d\xb1\xcc\x16\x4f\x97\xcb\x79\x5d\xcc\x71\x52\xd6\xd7\xb3\x45\x41\x4b\x30\x92\x1a\x57\xbf\xec\xfd\xba\x8b\xf8\xbf\xf7\x7f\xdd\xe1\x36\x87\xdd\xef\xda\x0d\xa2\x62\xb0\x4c\xa3\x03\x1f\x0e\xa3\xb9\x81\x9e\xc9\x67\x78\x0c\x7b\xbe\x0e\x00\xb2\xe7\xeb\xb1\x99\xf7\x7c\x02\x1c\x70\xcf\x37\xc4\x6d\xe2\xd6\x71\xcf\xd7\xf4\xb3\xfa\x8d\xe3\x9e\xaf\x2f\xe4\xb1\x4e\x0d\x12\xa8\xe6\x35\xf4\xf8\x53\x84\x9e\x51\xed\x6b\xed\x30\x56\xe1\x5a\x31\xa9\x5d\x05\x0b\xf1\x0f\xae\x9f\x30\x29\x41\x84\x52\x4f\x4e\x6e\x82\x79\x4c\x52\xb0\xde\xbe\xc2\x3b\x4c\x56\x3e\x8c\xe8\xfa\x78\x28\xcb\x51\x3d\x6e\x0a\x01\x4e\x60\xea\x0e\xc0\x49\x4c\x2c\x4f\x6e\x5e\x0b\x5b\x94\xaa\xef\xa4\x6b\x6a\x93\x85\x3e\x10\x15\xda\x74\xe6\xa6\x24\xab\x61\x80\xa6\x96\x1e\x1f\x28\x29\xaa\x02\x07\x4e\x0d\x7d\x57\xc3\x44\x26\x00\x41\x26\x33\x11\x2b\x94\x79\xb7\x49\x4d\x4d\xc3\xc6\xbd\x7d\x72\x33\x62\x27\xfb\x3c\x47\xfc\xa0\xe0\xc7\xb6\xb4\x80\x99\x8e\x03\xd4\x6c\x71\xc7\x9a\xe5\x64\x16\x9b\x5f\xdc\x59\x34\x61\x97\x26\x1c\x33\x05\xdd\x64\x33\xa4\xa2\xd6\xb6\xd7\x44\x63\xef\x0b\x0b\x18\x40\x1e\xd4\xb1\xc4\x95\x09\x75\x0f\x67\x15\x39\x29\xc5\x45\x0d\x34\x73\x52\xde\x37\x1f\xb1\xa6\xff\xd6\x11\x69\x9b\xa5\xaf\x56\xd3\x1f\x0d\x14\xc0\x93\xd7\x10\xdc\xed\xf3\x5e\x62\xa1\x26\xd8\xa3\x39\x70\xf5\xd0\x51\x80\xb1\xbd\x5b\x66\x5a\xf8\x3d\x8c\x75\x37\x8d\x41\xcc\x4d\x86\x77\x31\xb8\xa6\xaf\x2a\xdc\x80\x84\x35\x86\x1e\x47\x81\x03\xc2\x90\x1d\x43\x88\x52\x3c\x42\x92\x2b\x43\xa6\x7e\x9e\x0a\xf4\x50\x99\xbb\x92\x5c\xc3\xc9\xa0\x13\x78\x3d\x2c\x97\x7d\x5b\xd6\xc4\xda\xaa\x6f\xf0\xba\x58\x26\x68\xd3\xa5\xbb\xf2\x40\x46\xe4\x52\x28\x21\xa2\x6f\x43\x22\xdc\x1a\xf8\x1e\xee\xe2\x00\x97\xe8\xea\x2e\xe0\xc5\xae\x81\xa2\x43\xe0\xd2\x11\xf6\x8a\x5c\x15\x5e\x14\x79\xea\xa6\x6a\xb1\x8f\xbb\xb2\x8d\xbb\x14\x09\x10\xb6\xce\x97\xb1\xbb\x8c\xa3\xeb\x8e\x45\x47\x0b\x22\x0d\x7c\xe7\xd2\x5f\x99\x80\x04\x8b\xa0\x30\x61\x1e\x73\x97\xe1\xb5\x04\x05\xe0\xb6\xb9\xc7\x05\x71\x0a\xa8\x0f\x88\xd8\xb9\x12\x32\x55\x01\x91\x00\x06\xab\x1e\x1a\x62\xb6\x6c\x7d\x14\xb8\xc1\xdb\x9e\x55\xb2\x78\x9f\x5c\x19\x33\x1f\x12\xa0\xcb\x30\xf6\xb5\x55\x55\x3d\xbf\x29\xd2\xec\x32\xc3\x5a\x0a\x8a\xf2\x2a\xae\x17\x84\x4a\x51\xbc\x5f\xaf\xd4\xe7\xb4\x7a\x22\x5c\x27\x00\x0d\xca\xce\xbd\x23\x0d\xbe\x93\x7d\x48\xaa\x3a\xa9\xd7\x95\x6d\x3c\x18\x94\xcb\x60\x00\x82\xa5\x63\x98\x84\x07\x48\xbf\xd0\x08\x0c\x8a\xee\xa7\x14\xd5\xa2\xcc\x56\xb5\x50\x19\x25\x23\x67\x20\xea\xaa\x28\x03\x62\x76\x8b\xc2\xba\xd1\xe3\xe1\x44\x1a\xac\x65\xac\xad\xdd\x80\x3f\xf6\x83\x2b\x7b\x40\x05\x98\x16\x02\x03\x40\x88\x29\xb7\x9d\x84\x9d\x9a\x59\x04\xf5\xde\x0c\x2a\x86\xc7\x6e\x0c\xd0\xd5\x4b\x54\x87\xfd\x97\x33\x0b\xca\x0e\xae\xda\x71\xd1\x87\x83\x06\x80\x9b\x2b\x05\xb4\x5b\xaa\x86\xbb\xd5\xa6\x95\xc2\x81\x6d\xf3\xd8\xb8\xec\x67\x1a\x84\xf4\xf6\x0b\xcd\x2b\xdb\x95\x2d\xc0\x3a\x30\x6d\x98\x22\x7a\x08\xc8\x0c\xc1\xe1\x33\x4f\x10\x22\x20\x70\x7e\x50\x60\x37\x06\x01\x25\x76\x45\x08\xd0\x62\x37\xea\xc3\x75\xee\x69\x3a\xda\x47\xd1\xf1\x8c\xbc\x1d\x3c\x95\xd5\x0d\x86\x4d\xb3\x66\x66\xa5\x6b\x40\x97\x1b\x02\x03\xf3\x18\xac\xa3\x75\x4b\x42\xc1\xbc\x36\x24\x3d\x81\xab\x2c\x05\xa0\xbd\xb2\xb0\xd9\xd4\xe4\xe9\x8a\x0c\x15\x18\xdb\xfa\x48\x63\x89\x61\x4f\x40\x39\x66\x43\xac\xc6\x51\x33\x9e\xca\x2b\x90\x99\xbe\x34\xcf\x30\x4a\x29\x05\xf5\xf8\xeb\xb2\x07\x0a\xac\xeb\x12\x7c\xcf\x48\x06\x75\x8b\xe5\xed\xdd\xd0\x2b\x25\x62\x07\x9f\x6a\x11\x91\x5e\x36\x44\xeb\x52\xb5\x9b\xbb\x2d\x9a\x65\x8a\xca\xc2\x49\xe3\x30\xe6\xd9\xb0\x14\xa5\x03\xb2\xa2\xdc\x45\x14\x69\xbb\x92\xac\xd6\x2b\x5c\x56\x38\xc5\x29\x7a\x8d\x2e\x93\xa5\x72\xa4\x64\x7a\xf4\xef\x3a\x1b\x1a\xa9\x9e\x68\xd7\xc5\x84\x1e\x84\xb7\xcc\x72\x34\x53\xa3\x13\x06\x8b\xde\x29\xb5\x2e\xbd\x7b\x28\x91\x6f\xf2\xfb\x58\xcb\x6e\x89\x33\xf2\xa7\x0b\x5b\x20\x91\x4d\x13\x8a\x04\x06\x09\x7e\xac\x8b\xb0\xd0\xd6\xb3\xad\x5e\x64\x43\x58\xf7\x58\x60\x5b\x3b\x7a\x88\xe7\xb0\xb8\x76\x24\xaf\x00\x77\xd3\x09\x5c\x0b\x60\xb9\x81\x31\x78\x00\xeb\x16\x84\xbb\x1b\xe0\x1a\xee\xc1\xec\x9a\x46\x02\x34\x3f\x52\x2c\x86\xb5\x6d\xdb\x0e\x59\xd9\x76\xb8\x0c\x6b\x43\x1e\xc6\x61\x65\xc8\xba\xd9\xc6\x1c\xbc\xa4\xaf\x93\x3a\xab\xea\x6c\xd1\xe9\xae\xfb\xa5\x7a\xda\xfd\xd3\x69\x23\x22\x60\x9c\xa7\x09\xbd\x59\xb5\x5c\xe2\x45\xcd\xb2\x7a\x30\x02\xbb\x48\xec\xc9\x08\xfe\x7f\x03\x00\x3d\x0f\x75\xf5\x0b\x71\x05\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -73,10 +167,29 @@ var SQL = func() http.FileSystem {
 		fs["/musicbrainz"].(os.FileInfo),
 	}
 	fs["/app"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/app/migrations"].(os.FileInfo),
 		fs["/app/schema.sql"].(os.FileInfo),
+		fs["/app/seed.sql"].(os.FileInfo),
+	}
+	fs["/app/migrations"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/app/migrations/2026_10_18_stats_data_downloads.sql"].(os.FileInfo),
 	}
 	fs["/fingerprint"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/fingerprint/extensions.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations"].(os.FileInfo),
 		fs["/fingerprint/schema.sql"].(os.FileInfo),
+		fs["/fingerprint/seed.sql"].(os.FileInfo),
+	}
+	fs["/fingerprint/migrations"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/fingerprint/migrations/2010_01_01_initial.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2020_03_01_fingerprint_created_index.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2020_03_03_drop_duplicate_indexes.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2020_03_03_meta_gid.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2020_03_03_more_created_updated_indexes.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2026_10_18_add_updated_columns.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2026_10_18_change_log.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2026_10_18_foreignid_created_updated.sql"].(os.FileInfo),
+		fs["/fingerprint/migrations/2026_10_18_foreignid_created_updated_indexes.sql"].(os.FileInfo),
 	}
 	fs["/ingest"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/ingest/schema.sql"].(os.FileInfo),
@@ -108,6 +221,11 @@ func (fs vfsgen۰FS) Open(path string) (http.File, error) {
 			vfsgen۰CompressedFileInfo: f,
 			gr:                        gr,
 		}, nil
+	case *vfsgen۰FileInfo:
+		return &vfsgen۰File{
+			vfsgen۰FileInfo: f,
+			Reader:          bytes.NewReader(f.content),
+		}, nil
 	case *vfsgen۰DirInfo:
 		return &vfsgen۰Dir{
 			vfsgen۰DirInfo: f,
@@ -161,7 +279,7 @@ func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
 	}
 	if f.grPos < f.seekPos {
 		// Fast-forward.
-		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
+		_, err = io.CopyN(io.Discard, f.gr, f.seekPos-f.grPos)
 		if err != nil {
 			return 0, err
 		}
@@ -189,6 +307,37 @@ func (f *vfsgen۰CompressedFile) Close() error {
 	return f.gr.Close()
 }
 
+// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
+type vfsgen۰FileInfo struct {
+	name    string
+	modTime time.Time
+	content []byte
+}
+
+func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
+
+func (f *vfsgen۰FileInfo) Name() string       { return f.name }
+func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
+func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰File is an opened file instance.
+type vfsgen۰File struct {
+	*vfsgen۰FileInfo
+	*bytes.Reader
+}
+
+func (f *vfsgen۰File) Close() error {
+	return nil
+}
+
 // vfsgen۰DirInfo is a static definition of a directory.
 type vfsgen۰DirInfo struct {
 	name    string
@@ -3,6 +3,7 @@ module github.com/acoustid/acoustid
 go 1.19
 
 require (
+	github.com/jackc/pgconn v1.3.2
 	github.com/jackc/pgx/v4 v4.4.1
 	github.com/pkg/errors v0.8.1
 	github.com/pkg/sftp v1.11.0
@@ -19,7 +20,6 @@ require (
 	github.com/hashicorp/hcl v1.0.0 // indirect
 	github.com/inconshreveable/mousetrap v1.0.0 // indirect
 	github.com/jackc/chunkreader/v2 v2.0.1 // indirect
-	github.com/jackc/pgconn v1.3.2 // indirect
 	github.com/jackc/pgio v1.0.0 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgproto3/v2 v2.0.1 // indirect
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"errors"
+	"github.com/acoustid/acoustid/pkg/export"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var dataExportBackfillCmd = &cobra.Command{
+	Use:   "backfill",
+	Short: "Export files for a range of days again",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		storage, err := BuildStorageConfig(logger)
+		if err != nil {
+			return err
+		}
+
+		db, err := BuildDatabaseConfig(logger, "database.fingerprint.")
+		if err != nil {
+			return err
+		}
+
+		config, err := BuildExportConfig(logger)
+		if err != nil {
+			return err
+		}
+
+		var backfillConfig export.BackfillConfig
+		backfillConfig.From, err = parseDay(viper.GetString("backfill.from"))
+		if err != nil {
+			logger.Error("Invalid first day", zap.Error(err))
+			return err
+		}
+		backfillConfig.To, err = parseDay(viper.GetString("backfill.to"))
+		if err != nil {
+			logger.Error("Invalid last day", zap.Error(err))
+			return err
+		}
+		if backfillConfig.From.IsZero() {
+			return errors.New("missing first day")
+		}
+		if backfillConfig.To.IsZero() {
+			backfillConfig.To = backfillConfig.From
+		}
+		backfillConfig.Tables = viper.GetStringSlice("backfill.table")
+		backfillConfig.Force = viper.GetBool("backfill.force")
+
+		return export.BackfillAll(logger, *storage, db, config, backfillConfig)
+	},
+}
+
+func init() {
+	dataExportCmd.AddCommand(dataExportBackfillCmd)
+
+	dataExportBackfillCmd.Flags().String("from", "", "First day to export (YYYY-MM-DD)")
+	dataExportBackfillCmd.Flags().String("to", "", "Last day to export (YYYY-MM-DD), defaults to the first day")
+	dataExportBackfillCmd.Flags().StringSlice("table", nil, "Table to export, can be repeated, all delta tables by default")
+	dataExportBackfillCmd.Flags().Bool("force", false, "Replace files that already exist")
+
+	viper.BindPFlag("backfill.from", dataExportBackfillCmd.Flags().Lookup("from"))
+	viper.BindPFlag("backfill.to", dataExportBackfillCmd.Flags().Lookup("to"))
+	viper.BindPFlag("backfill.table", dataExportBackfillCmd.Flags().Lookup("table"))
+	viper.BindPFlag("backfill.force", dataExportBackfillCmd.Flags().Lookup("force"))
+}
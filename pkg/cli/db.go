@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"github.com/acoustid/acoustid/database"
+	"github.com/acoustid/acoustid/pkg/migrate"
+	"github.com/jackc/pgx/v4"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Commands for managing databases",
+}
+
+// connectMigrator connects to the database selected by --database, configured in the database.{name}.* settings.
+func connectMigrator(ctx context.Context, logger *zap.Logger) (*migrate.Migrator, *pgx.Conn, error) {
+	name := viper.GetString("migrate.database")
+	valid := false
+	for _, db := range migrate.Databases {
+		if db == name {
+			valid = true
+		}
+	}
+	if !valid {
+		return nil, nil, fmt.Errorf("invalid database %q, expected one of %s", name, strings.Join(migrate.Databases, ", "))
+	}
+
+	migrations, err := migrate.Load(database.SQL, name)
+	if err != nil {
+		logger.Error("Failed to load migrations", zap.Error(err))
+		return nil, nil, err
+	}
+
+	config, err := BuildDatabaseConfig(logger, "database."+name+".")
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		logger.Error("Failed to connect to database", zap.String("database", name), zap.Error(err))
+		return nil, nil, err
+	}
+
+	migrator := migrate.NewMigrator(logger.With(zap.String("database", name)), conn, migrations)
+	migrator.DryRun = viper.GetBool("migrate.dry-run")
+	return migrator, conn, nil
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending migrations to a database",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		ctx := context.Background()
+		migrator, conn, err := connectMigrator(ctx, logger)
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		count, err := migrator.Migrate(ctx)
+		if err != nil {
+			return err
+		}
+		logger.Info("Migrations finished", zap.Int("applied", count), zap.Bool("dry_run", migrator.DryRun))
+		return nil
+	},
+}
+
+var dbMigrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List applied and pending migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		ctx := context.Background()
+		migrator, conn, err := connectMigrator(ctx, logger)
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "MIGRATION\tAPPLIED\tTRANSACTION")
+		for _, status := range statuses {
+			applied := "pending"
+			if !status.Pending() {
+				applied = status.Applied.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%s\t%s\t%v\n", status.Name, applied, !status.NoTransaction)
+		}
+		return w.Flush()
+	},
+}
+
+var dbMigrateBaselineCmd = &cobra.Command{
+	Use:   "baseline [migration]",
+	Short: "Mark migrations as applied without running them, for databases migrated by hand",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		var until string
+		if len(args) > 0 {
+			until = args[0]
+		}
+
+		ctx := context.Background()
+		migrator, conn, err := connectMigrator(ctx, logger)
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		count, err := migrator.Baseline(ctx, until)
+		if err != nil {
+			return err
+		}
+		logger.Info("Baseline finished", zap.Int("marked", count), zap.Bool("dry_run", migrator.DryRun))
+		return nil
+	},
+}
+
+var dbInitCmd = &cobra.Command{
+	Use:   "init [database...]",
+	Short: "Create schemas of empty databases from the embedded SQL files",
+	Long: `Create schemas of empty databases (fingerprint, musicbrainz, ingest and app, all by default) from
+the embedded SQL files. Connection settings are read from database.{name}.*, the musicbrainz schema
+is created in the fingerprint database unless database.musicbrainz.name is set.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		databases := args
+		if len(databases) == 0 {
+			databases = migrate.InitDatabases
+		}
+		for _, name := range databases {
+			valid := false
+			for _, db := range migrate.InitDatabases {
+				if db == name {
+					valid = true
+				}
+			}
+			if !valid {
+				return fmt.Errorf("invalid database %q, expected one of %s", name, strings.Join(migrate.InitDatabases, ", "))
+			}
+		}
+
+		var config migrate.InitConfig
+		config.Seed = viper.GetBool("init.seed")
+
+		ctx := context.Background()
+		// databases are initialized in dependency order, regardless of the order of arguments
+		for _, name := range migrate.InitDatabases {
+			selected := false
+			for _, db := range databases {
+				if db == name {
+					selected = true
+				}
+			}
+			if !selected {
+				continue
+			}
+
+			prefix := "database." + name + "."
+			if name == "musicbrainz" && viper.GetString(prefix+"name") == "" {
+				prefix = "database.fingerprint."
+			}
+			dbConfig, err := BuildDatabaseConfig(logger, prefix)
+			if err != nil {
+				return err
+			}
+			conn, err := pgx.ConnectConfig(ctx, dbConfig)
+			if err != nil {
+				logger.Error("Failed to connect to database", zap.String("database", name), zap.Error(err))
+				return err
+			}
+			_, err = migrate.Init(ctx, logger.With(zap.String("database", name)), conn, database.SQL, name, config)
+			conn.Close(ctx)
+			if err != nil {
+				logger.Error("Failed to initialize database", zap.String("database", name), zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var dbCheckSchemaCmd = &cobra.Command{
+	Use:   "check-schema",
+	Short: "Check that the migrations of a database reproduce its schema.sql",
+	Long: `Apply the migrations of a database to one scratch database and schema.sql to another, and report
+the differences between their tables, columns, indexes and constraints. The scratch databases are
+created on the server configured in database.{name}.*, which needs the CREATEDB privilege.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		name := viper.GetString("check-schema.database")
+		valid := false
+		for _, db := range migrate.Databases {
+			if db == name {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid database %q, expected one of %s", name, strings.Join(migrate.Databases, ", "))
+		}
+
+		config, err := BuildDatabaseConfig(logger, "database."+name+".")
+		if err != nil {
+			return err
+		}
+
+		differences, err := migrate.CheckSchema(context.Background(), logger, config, database.SQL, name)
+		if err != nil {
+			logger.Error("Failed to check schema", zap.String("database", name), zap.Error(err))
+			return err
+		}
+		for _, difference := range differences {
+			fmt.Println(difference)
+		}
+		if len(differences) > 0 {
+			return fmt.Errorf("found %d differences between migrations and schema.sql", len(differences))
+		}
+		logger.Info("Migrations match schema.sql", zap.String("database", name))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbCheckSchemaCmd)
+
+	dbCheckSchemaCmd.Flags().String("database", "", "Database to check (fingerprint, ingest or app)")
+
+	viper.BindPFlag("check-schema.database", dbCheckSchemaCmd.Flags().Lookup("database"))
+
+	dbCmd.AddCommand(dbInitCmd)
+
+	dbInitCmd.Flags().Bool("seed", false, "Load a small data set for development")
+
+	viper.BindPFlag("init.seed", dbInitCmd.Flags().Lookup("seed"))
+
+	dbCmd.AddCommand(dbMigrateCmd)
+	dbMigrateCmd.AddCommand(dbMigrateStatusCmd)
+	dbMigrateCmd.AddCommand(dbMigrateBaselineCmd)
+
+	dbMigrateCmd.PersistentFlags().String("database", "", "Database to migrate (fingerprint, ingest or app), connection settings are read from database.{name}.*")
+	dbMigrateCmd.PersistentFlags().Bool("dry-run", false, "Only log what would be done")
+
+	viper.BindPFlag("migrate.database", dbMigrateCmd.PersistentFlags().Lookup("database"))
+	viper.BindPFlag("migrate.dry-run", dbMigrateCmd.PersistentFlags().Lookup("dry-run"))
+}
@@ -1,13 +1,20 @@
 package cli
 
 import (
+	"encoding/base64"
 	"errors"
 	"github.com/acoustid/acoustid/pkg/export"
+	"github.com/acoustid/acoustid/pkg/metrics"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/log/zapadapter"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/ed25519"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
 )
 
 func BuildDatabaseConfig(logger *zap.Logger, prefix string) (*pgx.ConnConfig, error) {
@@ -39,14 +46,53 @@ func BuildStorageConfig(logger *zap.Logger) (*export.StorageConfig, error) {
 	return &config, nil
 }
 
+func BuildExportTablesConfig(logger *zap.Logger) map[string]export.TableConfig {
+	tables := make(map[string]export.TableConfig)
+	for name := range viper.GetStringMap("export.tables") {
+		prefix := "export.tables." + name + "."
+		var config export.TableConfig
+		config.Query = viper.GetString(prefix + "query")
+		config.Mode = viper.GetString(prefix + "mode")
+		config.Schedule = viper.GetString(prefix + "schedule")
+		config.FingerprintEncoding = viper.GetString(prefix + "fingerprint-encoding")
+		config.Format = viper.GetString(prefix + "format")
+		tables[name] = config
+	}
+	return tables
+}
+
+func BuildExportConfig(logger *zap.Logger) (export.ExportConfig, error) {
+	var config export.ExportConfig
+	config.MaxDays = viper.GetInt("export.max-days")
+	config.Hourly = viper.GetBool("export.hourly")
+	config.Workers = viper.GetInt("export.workers")
+	config.MaxAttempts = viper.GetInt("export.max-attempts")
+	config.RetryDelay = viper.GetDuration("export.retry-delay")
+	config.Tables = BuildExportTablesConfig(logger)
+
+	signingKey := viper.GetString("export.signing-key")
+	if signingKey != "" {
+		var err error
+		config.SigningKey, err = export.ParsePrivateKey(signingKey)
+		if err != nil {
+			logger.Error("Invalid signing key", zap.Error(err))
+			return config, err
+		}
+		publicKey := config.SigningKey.Public().(ed25519.PublicKey)
+		logger.Info("Signing exported files", zap.String("public_key", base64.StdEncoding.EncodeToString(publicKey)))
+	}
+
+	return config, nil
+}
+
 var dataCmd = &cobra.Command{
 	Use:   "data",
 	Short: "Commands for working with public data files",
 }
 
-var dataProxyCmd = &cobra.Command{
-	Use:   "proxy",
-	Short: "Run HTTP proxy for serving public data files",
+var dataExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export database to a remote location",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		logger := zap.L()
 		defer logger.Sync()
@@ -55,64 +101,142 @@ var dataProxyCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		logger.Info("xx", zap.String("storage", storage.Host))
 
-		return export.RunProxy(logger, storage)
+		db, err := BuildDatabaseConfig(logger, "database.fingerprint.")
+		if err != nil {
+			return err
+		}
+
+		config, err := BuildExportConfig(logger)
+		if err != nil {
+			return err
+		}
+
+		metricsAddr := viper.GetString("export.metrics-addr")
+		if metricsAddr != "" {
+			go func() {
+				mux := http.NewServeMux()
+				mux.Handle("/metrics", metrics.DefaultRegistry)
+				err := http.ListenAndServe(metricsAddr, mux)
+				if err != nil {
+					logger.Error("Failed to serve metrics", zap.Error(err))
+				}
+			}()
+		}
+
+		err = export.ExportAll(logger, *storage, db, config)
+
+		pushgatewayURL := viper.GetString("export.pushgateway-url")
+		if pushgatewayURL != "" {
+			pushErr := metrics.DefaultRegistry.Push(pushgatewayURL, "acoustid-data-export")
+			if pushErr != nil {
+				logger.Error("Failed to push metrics", zap.Error(pushErr))
+			}
+		}
+
+		return err
 	},
 }
 
-var dataExportCmd = &cobra.Command{
-	Use:   "export",
-	Short: "Export database to a remote location",
+func verifyFile(publicKey []byte, path string) error {
+	signature, err := ioutil.ReadFile(export.SignatureFileName(path))
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return export.VerifySignature(publicKey, file, signature)
+}
+
+var dataVerifyCmd = &cobra.Command{
+	Use:   "verify FILE...",
+	Short: "Verify signatures of downloaded data files",
+	Args:  cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		logger := zap.L()
 		defer logger.Sync()
 
-		storage, err := BuildStorageConfig(logger)
+		publicKey, err := export.ParsePublicKey(viper.GetString("export.public-key"))
 		if err != nil {
+			logger.Error("Invalid public key", zap.Error(err))
 			return err
 		}
 
-		db, err := BuildDatabaseConfig(logger, "database.fingerprint.")
-		if err != nil {
-			return err
+		failed := false
+		for _, path := range args {
+			err := verifyFile(publicKey, path)
+			if err != nil {
+				logger.Error("Verification failed", zap.String("path", path), zap.Error(err))
+				failed = true
+				continue
+			}
+			logger.Info("Signature is valid", zap.String("path", path))
 		}
-
-		maxDays := viper.GetInt("export.max-days")
-
-		return export.ExportAll(logger, *storage, db, maxDays)
+		if failed {
+			return errors.New("some files failed verification")
+		}
+		return nil
 	},
 }
 
 func init() {
 	dataCmd.AddCommand(dataExportCmd)
-	dataCmd.AddCommand(dataProxyCmd)
+	dataCmd.AddCommand(dataVerifyCmd)
 
 	dataExportCmd.Flags().Int("max-days", 30, "Maximum number of days to export")
 
 	viper.BindPFlag("export.max-days", dataExportCmd.Flags().Lookup("max-days"))
 
-	dataExportCmd.Flags().String("storage-host", "", "URL of the WebDAV server where data files are stored")
-	dataExportCmd.Flags().Int("storage-port", 22, "")
-	dataExportCmd.Flags().String("storage-path", "", "")
-	dataExportCmd.Flags().String("storage-user", "", "Username")
-	dataExportCmd.Flags().String("storage-password", "", "Password")
+	dataExportCmd.Flags().Bool("hourly", true, "Export hourly files for the current day")
+
+	viper.BindPFlag("export.hourly", dataExportCmd.Flags().Lookup("hourly"))
+
+	dataExportCmd.PersistentFlags().Int("workers", 4, "Number of files to export in parallel")
+	dataExportCmd.Flags().Int("max-attempts", 3, "Maximum number of attempts to export a file")
+	dataExportCmd.Flags().Duration("retry-delay", 10*time.Second, "Delay before retrying a failed export, doubled after each attempt")
+
+	viper.BindPFlag("export.workers", dataExportCmd.PersistentFlags().Lookup("workers"))
+	viper.BindPFlag("export.max-attempts", dataExportCmd.Flags().Lookup("max-attempts"))
+	viper.BindPFlag("export.retry-delay", dataExportCmd.Flags().Lookup("retry-delay"))
+
+	dataExportCmd.Flags().String("metrics-addr", "", "Address for serving Prometheus metrics while the export is running")
+	dataExportCmd.Flags().String("pushgateway-url", "", "URL of the Prometheus Pushgateway to push metrics to after the export")
+
+	viper.BindPFlag("export.metrics-addr", dataExportCmd.Flags().Lookup("metrics-addr"))
+	viper.BindPFlag("export.pushgateway-url", dataExportCmd.Flags().Lookup("pushgateway-url"))
+
+	dataExportCmd.PersistentFlags().String("signing-key", "", "Base64-encoded ed25519 private key (or seed) for signing exported files")
+
+	viper.BindPFlag("export.signing-key", dataExportCmd.PersistentFlags().Lookup("signing-key"))
+
+	dataVerifyCmd.Flags().String("public-key", "", "Base64-encoded ed25519 public key")
+
+	viper.BindPFlag("export.public-key", dataVerifyCmd.Flags().Lookup("public-key"))
+
+	dataExportCmd.PersistentFlags().String("storage-host", "", "URL of the WebDAV server where data files are stored")
+	dataExportCmd.PersistentFlags().Int("storage-port", 22, "")
+	dataExportCmd.PersistentFlags().String("storage-path", "", "")
+	dataExportCmd.PersistentFlags().String("storage-user", "", "Username")
+	dataExportCmd.PersistentFlags().String("storage-password", "", "Password")
 
-	viper.BindPFlag("export.storage.host", dataExportCmd.Flags().Lookup("storage-host"))
-	viper.BindPFlag("export.storage.port", dataExportCmd.Flags().Lookup("storage-port"))
-	viper.BindPFlag("export.storage.path", dataExportCmd.Flags().Lookup("storage-path"))
+	viper.BindPFlag("export.storage.host", dataExportCmd.PersistentFlags().Lookup("storage-host"))
+	viper.BindPFlag("export.storage.port", dataExportCmd.PersistentFlags().Lookup("storage-port"))
+	viper.BindPFlag("export.storage.path", dataExportCmd.PersistentFlags().Lookup("storage-path"))
 	viper.BindPFlag("export.storage.username", dataExportCmd.Flags().Lookup("storage-username"))
-	viper.BindPFlag("export.storage.password", dataExportCmd.Flags().Lookup("storage-password"))
-
-	dataExportCmd.Flags().String("database-host", "127.0.0.1", "PostgreSQL host")
-	dataExportCmd.Flags().Int("database-port", 5432, "PostgreSQL port")
-	dataExportCmd.Flags().String("database-name", "", "PostgreSQL name")
-	dataExportCmd.Flags().String("database-username", "", "PostgreSQL username")
-	dataExportCmd.Flags().String("database-password", "", "PostgreSQL password")
-
-	viper.BindPFlag("database.fingerprint.host", dataExportCmd.Flags().Lookup("database-host"))
-	viper.BindPFlag("database.fingerprint.port", dataExportCmd.Flags().Lookup("database-port"))
-	viper.BindPFlag("database.fingerprint.name", dataExportCmd.Flags().Lookup("database-name"))
-	viper.BindPFlag("database.fingerprint.username", dataExportCmd.Flags().Lookup("database-username"))
-	viper.BindPFlag("database.fingerprint.password", dataExportCmd.Flags().Lookup("database-password"))
+	viper.BindPFlag("export.storage.password", dataExportCmd.PersistentFlags().Lookup("storage-password"))
+
+	dataExportCmd.PersistentFlags().String("database-host", "127.0.0.1", "PostgreSQL host")
+	dataExportCmd.PersistentFlags().Int("database-port", 5432, "PostgreSQL port")
+	dataExportCmd.PersistentFlags().String("database-name", "", "PostgreSQL name")
+	dataExportCmd.PersistentFlags().String("database-username", "", "PostgreSQL username")
+	dataExportCmd.PersistentFlags().String("database-password", "", "PostgreSQL password")
+
+	viper.BindPFlag("database.fingerprint.host", dataExportCmd.PersistentFlags().Lookup("database-host"))
+	viper.BindPFlag("database.fingerprint.port", dataExportCmd.PersistentFlags().Lookup("database-port"))
+	viper.BindPFlag("database.fingerprint.name", dataExportCmd.PersistentFlags().Lookup("database-name"))
+	viper.BindPFlag("database.fingerprint.username", dataExportCmd.PersistentFlags().Lookup("database-username"))
+	viper.BindPFlag("database.fingerprint.password", dataExportCmd.PersistentFlags().Lookup("database-password"))
 }
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"github.com/acoustid/acoustid/pkg/export"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"time"
+)
+
+func parseDay(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+var dataImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import published data files into a local database",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		db, err := BuildDatabaseConfig(logger, "import.database.")
+		if err != nil {
+			return err
+		}
+
+		var config export.ImportConfig
+		config.From, err = parseDay(viper.GetString("import.from"))
+		if err != nil {
+			logger.Error("Invalid first day", zap.Error(err))
+			return err
+		}
+		config.To, err = parseDay(viper.GetString("import.to"))
+		if err != nil {
+			logger.Error("Invalid last day", zap.Error(err))
+			return err
+		}
+
+		var source export.ImportSource
+		url := viper.GetString("import.url")
+		if url != "" {
+			source = export.NewHTTPImportSource(url, viper.GetString("import.api-key"))
+		} else {
+			storageConfig, err := BuildStorageConfig(logger)
+			if err != nil {
+				return err
+			}
+			storage, err := export.NewStorageClient(logger, *storageConfig)
+			if err != nil {
+				return err
+			}
+			defer storage.Close()
+			source = export.NewStorageImportSource(storage)
+		}
+
+		return export.ImportAll(logger, source, db, config)
+	},
+}
+
+func init() {
+	dataCmd.AddCommand(dataImportCmd)
+
+	dataImportCmd.Flags().String("from", "", "First day to import (YYYY-MM-DD), defaults to the day after the last imported day")
+	dataImportCmd.Flags().String("to", "", "Last day to import (YYYY-MM-DD), defaults to yesterday")
+	dataImportCmd.Flags().String("url", "", "URL of the data proxy, the export storage is used if not set")
+	dataImportCmd.Flags().String("api-key", "", "API key for the data proxy")
+
+	viper.BindPFlag("import.from", dataImportCmd.Flags().Lookup("from"))
+	viper.BindPFlag("import.to", dataImportCmd.Flags().Lookup("to"))
+	viper.BindPFlag("import.url", dataImportCmd.Flags().Lookup("url"))
+	viper.BindPFlag("import.api-key", dataImportCmd.Flags().Lookup("api-key"))
+
+	dataImportCmd.Flags().String("database-host", "127.0.0.1", "PostgreSQL host")
+	dataImportCmd.Flags().Int("database-port", 5432, "PostgreSQL port")
+	dataImportCmd.Flags().String("database-name", "", "PostgreSQL name")
+	dataImportCmd.Flags().String("database-user", "", "PostgreSQL username")
+	dataImportCmd.Flags().String("database-password", "", "PostgreSQL password")
+
+	viper.BindPFlag("import.database.host", dataImportCmd.Flags().Lookup("database-host"))
+	viper.BindPFlag("import.database.port", dataImportCmd.Flags().Lookup("database-port"))
+	viper.BindPFlag("import.database.name", dataImportCmd.Flags().Lookup("database-name"))
+	viper.BindPFlag("import.database.user", dataImportCmd.Flags().Lookup("database-user"))
+	viper.BindPFlag("import.database.password", dataImportCmd.Flags().Lookup("database-password"))
+}
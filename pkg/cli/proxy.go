@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"errors"
+	"github.com/acoustid/acoustid/pkg/export"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"time"
+)
+
+var dataProxyCmd = &cobra.Command{
+	Use:   "proxy",
+	Short: "Run HTTP proxy for serving public data files",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		storage, err := BuildStorageConfig(logger)
+		if err != nil {
+			return err
+		}
+
+		var config export.ProxyConfig
+		config.Addr = viper.GetString("proxy.addr")
+		config.PathPrefix = viper.GetString("proxy.path-prefix")
+		config.ReadHeaderTimeout = viper.GetDuration("proxy.read-header-timeout")
+		config.ReadTimeout = viper.GetDuration("proxy.read-timeout")
+		config.WriteTimeout = viper.GetDuration("proxy.write-timeout")
+		config.IdleTimeout = viper.GetDuration("proxy.idle-timeout")
+		config.ShutdownDelay = viper.GetDuration("proxy.shutdown-delay")
+		config.ShutdownTimeout = viper.GetDuration("proxy.shutdown-timeout")
+		config.TLSCertFile = viper.GetString("proxy.tls.cert-file")
+		config.TLSKeyFile = viper.GetString("proxy.tls.key-file")
+		if (config.TLSCertFile == "") != (config.TLSKeyFile == "") {
+			return errors.New("both TLS certificate and key files are needed")
+		}
+		config.StorageSessions = viper.GetInt("proxy.storage-sessions")
+		config.Cache.Dir = viper.GetString("proxy.cache.dir")
+		config.Cache.MaxSize = viper.GetInt64("proxy.cache.max-size-mb") * 1024 * 1024
+		config.Cache.ListingTTL = viper.GetDuration("proxy.cache.listing-ttl")
+
+		config.Auth.Enabled = viper.GetBool("proxy.auth.enabled")
+		config.Auth.Required = viper.GetBool("proxy.auth.required")
+		config.Auth.KeyLimit.RequestsPerMinute = viper.GetFloat64("proxy.auth.key-rate-limit")
+		config.Auth.KeyLimit.Burst = viper.GetInt("proxy.auth.key-burst")
+		config.Auth.IPLimit.RequestsPerMinute = viper.GetFloat64("proxy.auth.ip-rate-limit")
+		config.Auth.IPLimit.Burst = viper.GetInt("proxy.auth.ip-burst")
+		config.Auth.TrustedProxies = viper.GetInt("proxy.auth.trusted-proxies")
+		config.Auth.CacheTTL = viper.GetDuration("proxy.auth.cache-ttl")
+		config.Stats.Enabled = viper.GetBool("proxy.stats.enabled")
+		config.Stats.FlushInterval = viper.GetDuration("proxy.stats.flush-interval")
+
+		db, err := BuildDatabaseConfig(logger, "database.app.")
+		if err != nil {
+			return err
+		}
+
+		return export.RunProxy(logger, storage, db, config)
+	},
+}
+
+func init() {
+	dataCmd.AddCommand(dataProxyCmd)
+
+	dataProxyCmd.Flags().String("addr", ":8080", "Address to listen on")
+	dataProxyCmd.Flags().String("path-prefix", "", "Path prefix of the data endpoints, e.g. /data")
+	dataProxyCmd.Flags().Duration("read-header-timeout", 10*time.Second, "Maximum time for reading request headers")
+	dataProxyCmd.Flags().Duration("read-timeout", 30*time.Second, "Maximum time for reading the entire request")
+	dataProxyCmd.Flags().Duration("write-timeout", 0, "Maximum time for writing the response, 0 for no limit")
+	dataProxyCmd.Flags().Duration("idle-timeout", 2*time.Minute, "Maximum time to wait for the next request on a keep-alive connection")
+	dataProxyCmd.Flags().Duration("shutdown-delay", 0, "Time to keep accepting requests after SIGTERM, while /readyz reports the proxy as not ready")
+	dataProxyCmd.Flags().Duration("shutdown-timeout", 30*time.Second, "Maximum time to wait for active requests to finish after SIGTERM")
+	dataProxyCmd.Flags().String("tls-cert-file", "", "TLS certificate file, plain HTTP is served if not set")
+	dataProxyCmd.Flags().String("tls-key-file", "", "TLS private key file")
+
+	viper.BindPFlag("proxy.addr", dataProxyCmd.Flags().Lookup("addr"))
+	viper.BindPFlag("proxy.path-prefix", dataProxyCmd.Flags().Lookup("path-prefix"))
+	viper.BindPFlag("proxy.read-header-timeout", dataProxyCmd.Flags().Lookup("read-header-timeout"))
+	viper.BindPFlag("proxy.read-timeout", dataProxyCmd.Flags().Lookup("read-timeout"))
+	viper.BindPFlag("proxy.write-timeout", dataProxyCmd.Flags().Lookup("write-timeout"))
+	viper.BindPFlag("proxy.idle-timeout", dataProxyCmd.Flags().Lookup("idle-timeout"))
+	viper.BindPFlag("proxy.shutdown-delay", dataProxyCmd.Flags().Lookup("shutdown-delay"))
+	viper.BindPFlag("proxy.shutdown-timeout", dataProxyCmd.Flags().Lookup("shutdown-timeout"))
+	viper.BindPFlag("proxy.tls.cert-file", dataProxyCmd.Flags().Lookup("tls-cert-file"))
+	viper.BindPFlag("proxy.tls.key-file", dataProxyCmd.Flags().Lookup("tls-key-file"))
+
+	dataProxyCmd.Flags().Int("storage-sessions", 4, "Number of SFTP sessions for serving files in parallel")
+
+	viper.BindPFlag("proxy.storage-sessions", dataProxyCmd.Flags().Lookup("storage-sessions"))
+
+	dataProxyCmd.Flags().String("cache-dir", "", "Directory for caching published files, files are not cached if empty")
+	dataProxyCmd.Flags().Int64("cache-max-size-mb", 1024, "Maximum total size of cached files in megabytes")
+	dataProxyCmd.Flags().Duration("listing-ttl", time.Minute, "How long are directory listings cached, 0 to disable")
+
+	viper.BindPFlag("proxy.cache.dir", dataProxyCmd.Flags().Lookup("cache-dir"))
+	viper.BindPFlag("proxy.cache.max-size-mb", dataProxyCmd.Flags().Lookup("cache-max-size-mb"))
+	viper.BindPFlag("proxy.cache.listing-ttl", dataProxyCmd.Flags().Lookup("listing-ttl"))
+
+	dataProxyCmd.Flags().Bool("auth", false, "Check API keys against the app database")
+	dataProxyCmd.Flags().Bool("require-api-key", false, "Reject requests without an API key")
+	dataProxyCmd.Flags().Float64("key-rate-limit", 0, "Maximum number of requests per minute for each API key, 0 for no limit")
+	dataProxyCmd.Flags().Int("key-burst", 10, "Number of requests an API key can make at once")
+	dataProxyCmd.Flags().Float64("ip-rate-limit", 0, "Maximum number of requests per minute for each IP address without an API key, 0 for no limit")
+	dataProxyCmd.Flags().Int("ip-burst", 10, "Number of requests an IP address can make at once")
+	dataProxyCmd.Flags().Int("trusted-proxies", 0, "Number of reverse proxies in front of the data proxy, whose X-Forwarded-For entries are trusted")
+	dataProxyCmd.Flags().Duration("api-key-cache-ttl", 5*time.Minute, "How long are results of API key checks cached")
+
+	viper.BindPFlag("proxy.auth.enabled", dataProxyCmd.Flags().Lookup("auth"))
+	viper.BindPFlag("proxy.auth.required", dataProxyCmd.Flags().Lookup("require-api-key"))
+	viper.BindPFlag("proxy.auth.key-rate-limit", dataProxyCmd.Flags().Lookup("key-rate-limit"))
+	viper.BindPFlag("proxy.auth.key-burst", dataProxyCmd.Flags().Lookup("key-burst"))
+	viper.BindPFlag("proxy.auth.ip-rate-limit", dataProxyCmd.Flags().Lookup("ip-rate-limit"))
+	viper.BindPFlag("proxy.auth.ip-burst", dataProxyCmd.Flags().Lookup("ip-burst"))
+	viper.BindPFlag("proxy.auth.trusted-proxies", dataProxyCmd.Flags().Lookup("trusted-proxies"))
+	viper.BindPFlag("proxy.auth.cache-ttl", dataProxyCmd.Flags().Lookup("api-key-cache-ttl"))
+
+	dataProxyCmd.Flags().Bool("stats", false, "Store daily download counts of data files in the app database")
+	dataProxyCmd.Flags().Duration("stats-flush-interval", time.Minute, "How often are download counts written to the database")
+
+	viper.BindPFlag("proxy.stats.enabled", dataProxyCmd.Flags().Lookup("stats"))
+	viper.BindPFlag("proxy.stats.flush-interval", dataProxyCmd.Flags().Lookup("stats-flush-interval"))
+}
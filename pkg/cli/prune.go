@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/acoustid/acoustid/pkg/export"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+var dataPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Roll up old daily files into monthly files and delete expired files",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := zap.L()
+		defer logger.Sync()
+
+		storage, err := BuildStorageConfig(logger)
+		if err != nil {
+			return err
+		}
+
+		db, err := BuildDatabaseConfig(logger, "database.fingerprint.")
+		if err != nil {
+			return err
+		}
+
+		var config export.PruneConfig
+		config.KeepDays = viper.GetInt("prune.keep-days")
+		config.MaxDays = viper.GetInt("prune.max-days")
+		config.ExportMaxDays = viper.GetInt("export.max-days")
+		config.DryRun = viper.GetBool("prune.dry-run")
+
+		signingKey := viper.GetString("export.signing-key")
+		if signingKey != "" {
+			config.SigningKey, err = export.ParsePrivateKey(signingKey)
+			if err != nil {
+				logger.Error("Invalid signing key", zap.Error(err))
+				return err
+			}
+		}
+
+		return export.PruneAll(logger, *storage, db, config)
+	},
+}
+
+func init() {
+	dataCmd.AddCommand(dataPruneCmd)
+
+	dataPruneCmd.Flags().Int("keep-days", 90, "Number of days for which daily files are kept before they are rolled up into monthly files")
+	dataPruneCmd.Flags().Int("max-days", 0, "Number of days after which files are deleted, 0 to keep files forever")
+	dataPruneCmd.Flags().Bool("dry-run", false, "Only log what would be done")
+
+	viper.BindPFlag("prune.keep-days", dataPruneCmd.Flags().Lookup("keep-days"))
+	viper.BindPFlag("prune.max-days", dataPruneCmd.Flags().Lookup("max-days"))
+	viper.BindPFlag("prune.dry-run", dataPruneCmd.Flags().Lookup("dry-run"))
+}
@@ -0,0 +1,257 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+type ProxyStatsConfig struct {
+	// Store daily download counts in the app database.
+	Enabled bool
+	// How often are download counts written to the database.
+	FlushInterval time.Duration
+}
+
+// accessLogHandler logs every request with the client and the size of the response.
+type accessLogHandler struct {
+	logger         *zap.Logger
+	trustedProxies int
+	next           http.Handler
+}
+
+func (h *accessLogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	startTime := time.Now()
+	rw := &instrumentedResponseWriter{ResponseWriter: w}
+	h.next.ServeHTTP(rw, r)
+	if rw.status == 0 {
+		rw.status = http.StatusOK
+	}
+	h.logger.Info("Request",
+		zap.String("method", r.Method),
+		zap.String("path", r.URL.Path),
+		zap.Int("status", rw.status),
+		zap.Int64("bytes", rw.bytes),
+		zap.Duration("duration", time.Since(startTime)),
+		zap.String("client", requestClientIP(r, h.trustedProxies)),
+		zap.String("user_agent", r.UserAgent()))
+}
+
+type downloadKey struct {
+	date string
+	path string
+}
+
+type downloadCount struct {
+	count int
+	bytes int64
+}
+
+// DownloadStats is the number of downloads of a file on one day.
+type DownloadStats struct {
+	Date  string `json:"date"`
+	Path  string `json:"path"`
+	Count int    `json:"count"`
+	Bytes int64  `json:"bytes"`
+}
+
+// downloadStats aggregates downloads of data files in memory, until they are written to the database.
+type downloadStats struct {
+	now     func() time.Time
+	mu      sync.Mutex
+	pending map[downloadKey]downloadCount
+}
+
+func newDownloadStats() *downloadStats {
+	return &downloadStats{now: time.Now, pending: make(map[downloadKey]downloadCount)}
+}
+
+// Record adds a download of a file. Partial downloads only add the number of bytes,
+// so that resumed downloads are not counted twice.
+func (s *downloadStats) Record(path string, bytes int64, complete bool) {
+	if s == nil {
+		return
+	}
+	key := downloadKey{date: s.now().UTC().Format("2006-01-02"), path: cleanStoragePath(path)}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := s.pending[key]
+	if complete {
+		c.count++
+	}
+	c.bytes += bytes
+	s.pending[key] = c
+}
+
+func (s *downloadStats) take() map[downloadKey]downloadCount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := s.pending
+	s.pending = make(map[downloadKey]downloadCount)
+	return pending
+}
+
+func (s *downloadStats) restore(counts map[downloadKey]downloadCount) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, c := range counts {
+		p := s.pending[key]
+		p.count += c.count
+		p.bytes += c.bytes
+		s.pending[key] = p
+	}
+}
+
+const updateDownloadStatsQuery = `
+INSERT INTO stats_data_downloads (date, path, count, bytes) VALUES ($1::date, $2, $3, $4)
+ON CONFLICT (date, path) DO UPDATE SET
+  count = stats_data_downloads.count + EXCLUDED.count,
+  bytes = stats_data_downloads.bytes + EXCLUDED.bytes
+`
+
+// Flush writes the aggregated counts to the database. If that fails, the counts are kept for the next attempt.
+func (s *downloadStats) Flush(ctx context.Context, db *connPool) error {
+	counts := s.take()
+	if len(counts) == 0 {
+		return nil
+	}
+	err := writeDownloadStats(ctx, db, counts)
+	if err != nil {
+		s.restore(counts)
+		return err
+	}
+	return nil
+}
+
+func writeDownloadStats(ctx context.Context, db *connPool, counts map[downloadKey]downloadCount) error {
+	conn, err := db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Release(conn)
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// rows are always updated in the same order, so that multiple proxies don't deadlock
+	keys := make([]downloadKey, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].date != keys[j].date {
+			return keys[i].date < keys[j].date
+		}
+		return keys[i].path < keys[j].path
+	})
+
+	for _, key := range keys {
+		c := counts[key]
+		_, err = tx.Exec(ctx, updateDownloadStatsQuery, key.date, key.path, c.count, c.bytes)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// RunFlush writes the counts to the database periodically, until the context is cancelled.
+func (s *downloadStats) RunFlush(ctx context.Context, logger *zap.Logger, db *connPool, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.Flush(ctx, db)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				logger.Error("Failed to write download stats", zap.Error(err))
+			}
+		}
+	}
+}
+
+const selectDownloadStatsQuery = `
+SELECT date::text, path, count, bytes
+FROM stats_data_downloads
+WHERE date >= $1::date AND date <= $2::date
+ORDER BY date, path
+`
+
+func readDownloadStats(ctx context.Context, db *connPool, from, to time.Time) ([]DownloadStats, error) {
+	conn, err := db.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Release(conn)
+
+	rows, err := conn.Query(ctx, selectDownloadStatsQuery, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []DownloadStats{}
+	for rows.Next() {
+		var s DownloadStats
+		err = rows.Scan(&s.Date, &s.Path, &s.Count, &s.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
+
+// statsHandler serves daily download counts, for the last 30 days by default.
+type statsHandler struct {
+	db     *connPool
+	logger *zap.Logger
+}
+
+func (h *statsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var from, to time.Time
+	if r.URL.Query().Get("from") == "" && r.URL.Query().Get("to") == "" {
+		now := time.Now().UTC()
+		to = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		from = to.AddDate(0, 0, -29)
+	} else {
+		var err error
+		from, to, err = parseDownloadRange(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	stats, err := readDownloadStats(r.Context(), h.db, from, to)
+	if err != nil {
+		h.logger.Error("Failed to read download stats", zap.Error(err))
+		http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, stats)
+}
+
+// isCompleteDownload returns true if the response contains the whole file, either without a range,
+// or with a single range from the beginning to the end of the file.
+func isCompleteDownload(header http.Header, status int) bool {
+	if status == http.StatusOK {
+		return true
+	}
+	if status != http.StatusPartialContent {
+		return false
+	}
+	var start, end, size int64
+	_, err := fmt.Sscanf(header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &size)
+	return err == nil && start == 0 && end == size-1
+}
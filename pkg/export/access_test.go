@@ -0,0 +1,95 @@
+package export
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDownloadStats(t *testing.T) {
+	stats := newDownloadStats()
+	now := time.Date(2020, 3, 2, 23, 59, 0, 0, time.UTC)
+	stats.now = func() time.Time { return now }
+
+	stats.Record("/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", 100, true)
+	stats.Record("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", 50, false)
+	now = now.Add(time.Minute)
+	stats.Record("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", 100, true)
+
+	counts := stats.take()
+	assert.Equal(t, map[downloadKey]downloadCount{
+		{date: "2020-03-02", path: "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz"}: {count: 1, bytes: 150},
+		{date: "2020-03-03", path: "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz"}: {count: 1, bytes: 100},
+	}, counts)
+	assert.Empty(t, stats.take())
+
+	stats.Record("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", 100, true)
+	stats.restore(counts)
+	assert.Equal(t, map[downloadKey]downloadCount{
+		{date: "2020-03-02", path: "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz"}: {count: 1, bytes: 150},
+		{date: "2020-03-03", path: "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz"}: {count: 2, bytes: 200},
+	}, stats.take())
+
+	var disabled *downloadStats
+	disabled.Record("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", 100, true)
+}
+
+func TestFileHandler_Stats(t *testing.T) {
+	storage := newMemStorage()
+	storage.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", []byte("0123456789"))
+	stats := newDownloadStats()
+	handler := &fileHandler{storage: storage, stats: stats, logger: zap.NewNop(), fallback: http.NotFoundHandler()}
+
+	tests := []struct {
+		method string
+		rng    string
+		status int
+	}{
+		{"GET", "", http.StatusOK},
+		{"GET", "bytes=0-", http.StatusPartialContent},
+		{"GET", "bytes=5-", http.StatusPartialContent},
+		{"GET", "bytes=0-0", http.StatusPartialContent},
+		{"GET", "bytes=0-4", http.StatusPartialContent},
+		{"GET", "bytes=0-9", http.StatusPartialContent},
+		{"HEAD", "", http.StatusOK},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(test.method, "/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", nil)
+		if test.rng != "" {
+			req.Header.Set("Range", test.rng)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, test.status, rec.Code, test.rng)
+	}
+
+	counts := stats.take()
+	require.Len(t, counts, 1)
+	for _, c := range counts {
+		assert.Equal(t, downloadCount{count: 3, bytes: 41}, c)
+	}
+}
+
+func TestAccessLogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(&buf), zapcore.InfoLevel)
+	handler := &accessLogHandler{logger: zap.New(core), next: http.NotFoundHandler()}
+
+	req := httptest.NewRequest("GET", "/missing", nil)
+	req.Header.Set("User-Agent", "test")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	assert.Contains(t, buf.String(), `"path":"/missing"`)
+	assert.Contains(t, buf.String(), `"status":404`)
+	assert.Contains(t, buf.String(), `"client":"192.0.2.1"`)
+	assert.Contains(t, buf.String(), `"user_agent":"test"`)
+}
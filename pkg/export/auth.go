@@ -0,0 +1,298 @@
+package export
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type RateLimit struct {
+	// Number of requests per minute, zero means no limit.
+	RequestsPerMinute float64
+	// Number of requests that can be made at once, after the client was idle.
+	Burst int
+}
+
+type ProxyAuthConfig struct {
+	// Check API keys against the app database.
+	Enabled bool
+	// Reject requests without an API key.
+	Required bool
+	// Limits for requests with a valid API key, applied per key.
+	KeyLimit RateLimit
+	// Limits for requests without an API key, applied per client IP address.
+	IPLimit RateLimit
+	// Number of reverse proxies in front of the data proxy. If set, the client IP address is taken from
+	// the X-Forwarded-For header, from the entry added by the outermost trusted proxy.
+	TrustedProxies int
+	// How long are results of API key checks cached.
+	CacheTTL time.Duration
+}
+
+// APIKeyStore checks if an API key is valid.
+type APIKeyStore interface {
+	CheckAPIKey(ctx context.Context, key string) (bool, error)
+}
+
+// Both user and application API keys from the app database can be used.
+const checkAPIKeyQuery = `
+SELECT EXISTS (
+  SELECT 1 FROM account WHERE apikey = $1
+  UNION ALL
+  SELECT 1 FROM application WHERE apikey = $1 AND active
+)
+`
+
+type dbAPIKeyStore struct {
+	db *connPool
+}
+
+func (s *dbAPIKeyStore) CheckAPIKey(ctx context.Context, key string) (bool, error) {
+	conn, err := s.db.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer s.db.Release(conn)
+
+	var valid bool
+	err = conn.QueryRow(ctx, checkAPIKeyQuery, key).Scan(&valid)
+	if err != nil {
+		return false, err
+	}
+	return valid, nil
+}
+
+// cachedAPIKeyStore remembers valid API keys, so that the database is not queried on every request.
+// Invalid keys are not cached, they can be anything and would fill the cache, requests with them are
+// rate limited instead.
+type cachedAPIKeyStore struct {
+	store APIKeyStore
+	ttl   time.Duration
+	now   func() time.Time
+	mu    sync.Mutex
+	// expiration times of valid keys
+	valid map[string]time.Time
+}
+
+func newCachedAPIKeyStore(store APIKeyStore, ttl time.Duration) *cachedAPIKeyStore {
+	return &cachedAPIKeyStore{store: store, ttl: ttl, now: time.Now, valid: make(map[string]time.Time)}
+}
+
+func (s *cachedAPIKeyStore) CheckAPIKey(ctx context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	expires, exists := s.valid[key]
+	s.mu.Unlock()
+	if exists && s.now().Before(expires) {
+		return true, nil
+	}
+
+	valid, err := s.store.CheckAPIKey(ctx, key)
+	if err != nil || !valid {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if len(s.valid) >= listingCacheSweepSize {
+		for k, e := range s.valid {
+			if !now.Before(e) {
+				delete(s.valid, k)
+			}
+		}
+	}
+	s.valid[key] = now.Add(s.ttl)
+	return true, nil
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// rateLimiter implements the token bucket algorithm, with one bucket per client.
+type rateLimiter struct {
+	limit   RateLimit
+	now     func() time.Time
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return &rateLimiter{limit: limit, now: time.Now, buckets: make(map[string]*tokenBucket)}
+}
+
+// Allow takes a token from the client's bucket. If the bucket is empty, it returns false
+// and the time after which the next request will be allowed.
+func (l *rateLimiter) Allow(client string) (bool, time.Duration) {
+	return l.take(client, true)
+}
+
+// Check is like Allow, but doesn't take the token.
+func (l *rateLimiter) Check(client string) (bool, time.Duration) {
+	return l.take(client, false)
+}
+
+func (l *rateLimiter) take(client string, consume bool) (bool, time.Duration) {
+	if l.limit.RequestsPerMinute <= 0 {
+		return true, 0
+	}
+	rate := l.limit.RequestsPerMinute / 60
+	burst := float64(l.limit.Burst)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if len(l.buckets) >= listingCacheSweepSize {
+		// buckets that would be full again are the same as no bucket
+		for k, b := range l.buckets {
+			if b.tokens+now.Sub(b.updated).Seconds()*rate >= burst {
+				delete(l.buckets, k)
+			}
+		}
+	}
+
+	bucket, exists := l.buckets[client]
+	if !exists {
+		bucket = &tokenBucket{tokens: burst, updated: now}
+		l.buckets[client] = bucket
+	}
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.updated).Seconds()*rate)
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		wait := time.Duration((1 - bucket.tokens) / rate * float64(time.Second))
+		return false, wait
+	}
+	if consume {
+		bucket.tokens--
+	}
+	return true, 0
+}
+
+// requestAPIKey returns the API key from the "Authorization: Bearer" header or the "apikey" query parameter.
+func requestAPIKey(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	return r.URL.Query().Get("apikey")
+}
+
+// requestClientIP returns the IP address of the client. Each proxy appends the address it received the request
+// from to X-Forwarded-For, so only the last trustedProxies entries can be trusted, the ones before them can
+// be sent by the client.
+func requestClientIP(r *http.Request, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var entries []string
+		for _, value := range r.Header.Values("X-Forwarded-For") {
+			for _, entry := range strings.Split(value, ",") {
+				if entry = strings.TrimSpace(entry); entry != "" {
+					entries = append(entries, entry)
+				}
+			}
+		}
+		if len(entries) >= trustedProxies {
+			return entries[len(entries)-trustedProxies]
+		}
+		if len(entries) > 0 {
+			return entries[0]
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// authHandler checks API keys and applies rate limits before passing requests to the next handler.
+type authHandler struct {
+	config     ProxyAuthConfig
+	keys       APIKeyStore
+	keyLimiter *rateLimiter
+	ipLimiter  *rateLimiter
+	logger     *zap.Logger
+	next       http.Handler
+}
+
+func newAuthHandler(logger *zap.Logger, config ProxyAuthConfig, keys APIKeyStore, next http.Handler) *authHandler {
+	return &authHandler{
+		config:     config,
+		keys:       keys,
+		keyLimiter: newRateLimiter(config.KeyLimit),
+		ipLimiter:  newRateLimiter(config.IPLimit),
+		logger:     logger,
+		next:       next,
+	}
+}
+
+func tooManyRequests(w http.ResponseWriter, wait time.Duration) {
+	proxyRejectedRequestsTotal.Inc("rate_limited")
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(wait.Seconds())))))
+	http.Error(w, "429 Too Many Requests", http.StatusTooManyRequests)
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := ""
+	if h.config.Enabled {
+		key = requestAPIKey(r)
+	}
+	clientIP := requestClientIP(r, h.config.TrustedProxies)
+
+	if key != "" {
+		// requests with invalid keys count against the limit of the IP address, and once it's reached,
+		// keys from that address are not checked, so that guessing keys can't overload the database
+		allowed, wait := h.ipLimiter.Check(clientIP)
+		if !allowed {
+			tooManyRequests(w, wait)
+			return
+		}
+		valid, err := h.keys.CheckAPIKey(r.Context(), key)
+		if err != nil {
+			h.logger.Error("Failed to check API key", zap.Error(err))
+			http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		if !valid {
+			h.ipLimiter.Allow(clientIP)
+			proxyRejectedRequestsTotal.Inc("invalid_api_key")
+			http.Error(w, "401 Unauthorized: invalid API key", http.StatusUnauthorized)
+			return
+		}
+	} else if h.config.Enabled && h.config.Required {
+		proxyRejectedRequestsTotal.Inc("missing_api_key")
+		w.Header().Set("WWW-Authenticate", `Bearer realm="acoustid"`)
+		http.Error(w, "401 Unauthorized: missing API key", http.StatusUnauthorized)
+		return
+	}
+
+	var allowed bool
+	var wait time.Duration
+	if key != "" {
+		allowed, wait = h.keyLimiter.Allow(key)
+	} else {
+		allowed, wait = h.ipLimiter.Allow(clientIP)
+	}
+	if !allowed {
+		tooManyRequests(w, wait)
+		return
+	}
+
+	h.next.ServeHTTP(w, r)
+}
+
+// newAPIKeyStore returns a cached store of API keys from the app database.
+func newAPIKeyStore(db *connPool, cacheTTL time.Duration) *cachedAPIKeyStore {
+	return newCachedAPIKeyStore(&dbAPIKeyStore{db: db}, cacheTTL)
+}
@@ -0,0 +1,167 @@
+package export
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type fakeAPIKeyStore struct {
+	keys  map[string]bool
+	calls int
+}
+
+func (s *fakeAPIKeyStore) CheckAPIKey(ctx context.Context, key string) (bool, error) {
+	s.calls++
+	return s.keys[key], nil
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(RateLimit{RequestsPerMinute: 6, Burst: 2})
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		allowed, _ := limiter.Allow("a")
+		assert.True(t, allowed)
+	}
+	allowed, wait := limiter.Allow("a")
+	assert.False(t, allowed)
+	assert.Equal(t, 10*time.Second, wait)
+
+	allowed, _ = limiter.Allow("b")
+	assert.True(t, allowed, "clients have separate buckets")
+
+	now = now.Add(5 * time.Second)
+	allowed, wait = limiter.Allow("a")
+	assert.False(t, allowed)
+	assert.Equal(t, 5*time.Second, wait)
+
+	now = now.Add(5 * time.Second)
+	allowed, _ = limiter.Allow("a")
+	assert.True(t, allowed)
+}
+
+func TestRateLimiter_NoLimit(t *testing.T) {
+	limiter := newRateLimiter(RateLimit{})
+	for i := 0; i < 100; i++ {
+		allowed, _ := limiter.Allow("a")
+		assert.True(t, allowed)
+	}
+}
+
+func TestCachedAPIKeyStore(t *testing.T) {
+	store := &fakeAPIKeyStore{keys: map[string]bool{"good": true}}
+	cached := newCachedAPIKeyStore(store, time.Minute)
+	for i := 0; i < 2; i++ {
+		valid, err := cached.CheckAPIKey(context.Background(), "good")
+		assert.NoError(t, err)
+		assert.True(t, valid)
+		valid, err = cached.CheckAPIKey(context.Background(), "bad")
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	}
+	assert.Equal(t, 3, store.calls, "only valid keys are cached")
+}
+
+func serveAuth(handler http.Handler, remoteAddr string, header http.Header, target string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", target, nil)
+	req.RemoteAddr = remoteAddr
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRequestClientIP(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Add("X-Forwarded-For", "198.51.100.1, 192.0.2.1")
+	req.Header.Add("X-Forwarded-For", "10.0.0.2")
+
+	assert.Equal(t, "10.0.0.1", requestClientIP(req, 0))
+	assert.Equal(t, "10.0.0.2", requestClientIP(req, 1))
+	assert.Equal(t, "192.0.2.1", requestClientIP(req, 2))
+	assert.Equal(t, "198.51.100.1", requestClientIP(req, 5))
+}
+
+func TestAuthHandler(t *testing.T) {
+	store := &fakeAPIKeyStore{keys: map[string]bool{"good": true}}
+	config := ProxyAuthConfig{
+		Enabled:  true,
+		Required: true,
+		KeyLimit: RateLimit{RequestsPerMinute: 60, Burst: 2},
+	}
+	handler := newAuthHandler(zap.NewNop(), config, store, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	rec := serveAuth(handler, "192.0.2.1:1234", nil, "/")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = serveAuth(handler, "192.0.2.1:1234", nil, "/?apikey=bad")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = serveAuth(handler, "192.0.2.1:1234", nil, "/?apikey=good")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serveAuth(handler, "192.0.2.2:1234", http.Header{"Authorization": {"Bearer good"}}, "/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serveAuth(handler, "192.0.2.3:1234", http.Header{"Authorization": {"Bearer good"}}, "/")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+}
+
+func TestAuthHandler_InvalidKeyLimit(t *testing.T) {
+	store := &fakeAPIKeyStore{keys: map[string]bool{"good": true}}
+	config := ProxyAuthConfig{
+		Enabled: true,
+		IPLimit: RateLimit{RequestsPerMinute: 1, Burst: 2},
+	}
+	handler := newAuthHandler(zap.NewNop(), config, store, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for i := 0; i < 2; i++ {
+		rec := serveAuth(handler, "192.0.2.1:1234", nil, "/?apikey=bad")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+	rec := serveAuth(handler, "192.0.2.1:1234", nil, "/?apikey=bad")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, 2, store.calls, "keys are not checked after the limit is reached")
+
+	rec = serveAuth(handler, "192.0.2.2:1234", nil, "/?apikey=good")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = serveAuth(handler, "192.0.2.2:1234", nil, "/?apikey=good")
+	assert.Equal(t, http.StatusOK, rec.Code, "requests with valid keys don't count against the IP limit")
+	rec = serveAuth(handler, "192.0.2.2:1234", nil, "/?apikey=good")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuthHandler_IPLimit(t *testing.T) {
+	config := ProxyAuthConfig{
+		IPLimit:        RateLimit{RequestsPerMinute: 1, Burst: 1},
+		TrustedProxies: 1,
+	}
+	handler := newAuthHandler(zap.NewNop(), config, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	rec := serveAuth(handler, "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"10.0.0.2, 192.0.2.1"}}, "/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serveAuth(handler, "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"192.0.2.1"}}, "/")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+
+	rec = serveAuth(handler, "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"192.0.2.2"}}, "/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serveAuth(handler, "10.0.0.1:1234", http.Header{"X-Forwarded-For": {"192.0.2.3, 192.0.2.2"}}, "/")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code, "entries added by the client are ignored")
+
+	rec = serveAuth(handler, "10.0.0.1:1234", nil, "/?apikey=ignored")
+	assert.Equal(t, http.StatusOK, rec.Code, "API keys are ignored if authentication is disabled")
+}
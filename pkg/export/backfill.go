@@ -0,0 +1,334 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"os"
+	"time"
+)
+
+const backupFileSuffix = ".bak"
+
+type BackfillConfig struct {
+	From time.Time
+	To   time.Time
+	// Names of tables to export, all delta tables if empty.
+	Tables []string
+	// Replace files that already exist.
+	Force bool
+}
+
+// fileBackup is a hard link to the previous version of a replaced file. Files which didn't exist before
+// have no backup, they are deleted when the backups are restored.
+type fileBackup struct {
+	path       string
+	backupPath string
+}
+
+func (ex *exporter) backupFile(path string) (*fileBackup, error) {
+	fileExists, err := CheckFileExists(ex.storage, path)
+	if err != nil {
+		return nil, err
+	}
+	if !fileExists {
+		return &fileBackup{path: path}, nil
+	}
+	backupPath := path + backupFileSuffix
+	err = ex.storage.Remove(backupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	err = ex.storage.Link(path, backupPath)
+	if err != nil {
+		return nil, err
+	}
+	return &fileBackup{path: path, backupPath: backupPath}, nil
+}
+
+// restoreBackups restores the previous versions of files in the reverse order of their replacement.
+func (ex *exporter) restoreBackups(backups []*fileBackup) {
+	for i := len(backups) - 1; i >= 0; i-- {
+		backup := backups[i]
+		if backup.backupPath == "" {
+			ex.logger.Info("Deleting file", zap.String("path", backup.path))
+			err := ex.storage.Remove(backup.path)
+			if err != nil && !os.IsNotExist(err) {
+				ex.logger.Error("Failed to delete file", zap.String("path", backup.path), zap.Error(err))
+			}
+			continue
+		}
+		ex.logger.Info("Restoring file", zap.String("path", backup.path))
+		err := ex.storage.PosixRename(backup.backupPath, backup.path)
+		if err != nil {
+			ex.logger.Error("Failed to restore file", zap.String("path", backup.path), zap.Error(err))
+		}
+	}
+}
+
+func (ex *exporter) deleteBackups(backups []*fileBackup) {
+	for _, backup := range backups {
+		if backup.backupPath == "" {
+			continue
+		}
+		err := ex.storage.Remove(backup.backupPath)
+		if err != nil {
+			ex.logger.Error("Failed to delete backup file", zap.String("path", backup.backupPath), zap.Error(err))
+		}
+	}
+}
+
+// stagedPath returns the temporary name under which a new version of a published file is written.
+func (ex *exporter) stagedPath(path string) string {
+	directory, fileName := ex.storage.Split(path)
+	return ex.storage.Join(directory, "."+fileName+".new")
+}
+
+// replaceFile replaces a file and its signature with the staged versions, keeping the old versions
+// as backups. The signature is replaced first, like in WriteManifest. If there is no staged signature,
+// the old one is deleted, because it doesn't match the new file.
+func (ex *exporter) replaceFile(path string, backups []*fileBackup) ([]*fileBackup, error) {
+	for _, p := range []string{SignatureFileName(path), path} {
+		newPath := ex.stagedPath(p)
+		newExists, err := CheckFileExists(ex.storage, newPath)
+		if err != nil {
+			return backups, err
+		}
+		backup, err := ex.backupFile(p)
+		if err != nil {
+			return backups, err
+		}
+		if !newExists {
+			if backup.backupPath != "" {
+				backups = append(backups, backup)
+				err = ex.storage.Remove(p)
+				if err != nil {
+					return backups, err
+				}
+			}
+			continue
+		}
+		backups = append(backups, backup)
+		err = ex.storage.PosixRename(newPath, p)
+		if err != nil {
+			return backups, err
+		}
+	}
+	return backups, nil
+}
+
+// deleteStagedFiles deletes staged versions of a file and its signature which were not published.
+func (ex *exporter) deleteStagedFiles(path string) error {
+	for _, p := range []string{path, SignatureFileName(path)} {
+		err := ex.storage.Remove(ex.stagedPath(p))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectTables returns indexes of the tables with the given names, or all delta tables if no names are given.
+// Full tables can't be selected, their snapshots are only exported for the current state of the database.
+func (ex *exporter) selectTables(names []string) ([]int, error) {
+	var indexes []int
+	if len(names) == 0 {
+		for i := range ex.tables {
+			if ex.tables[i].delta {
+				indexes = append(indexes, i)
+			}
+		}
+		return indexes, nil
+	}
+	for _, name := range names {
+		found := false
+		for i := range ex.tables {
+			if ex.tables[i].name == name {
+				if !ex.tables[i].delta {
+					return nil, fmt.Errorf("table %q is a full snapshot, it can't be exported for past days", name)
+				}
+				indexes = append(indexes, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown table %q", name)
+		}
+	}
+	return indexes, nil
+}
+
+// BackfillDay exports the selected tables for the given day again and publishes a new manifest.
+// The files, their signatures and the manifest are all written under temporary names first, and then
+// renamed one after another, the manifest last. Replaced files are kept as backups until the manifest
+// is published, and restored if anything fails.
+func (ex *exporter) BackfillDay(startTime time.Time, tables []int, force bool) error {
+	endTime := startTime.AddDate(0, 0, 1)
+	directory := ex.dayDirectory(startTime)
+	manifestPath := ex.storage.Join(directory, ManifestFileName(startTime))
+
+	logger := ex.logger.With(zap.String("day", startTime.Format("2006-01-02")))
+
+	err := EnsureDirExists(ex.storage, directory)
+	if err != nil {
+		logger.Error("Failed to create directory", zap.Error(err))
+		return err
+	}
+
+	oldManifest, err := ReadManifest(ex.storage, manifestPath)
+	if err != nil {
+		logger.Error("Failed to read manifest", zap.Error(err))
+		return err
+	}
+
+	var staged []string
+	defer func() {
+		for _, path := range staged {
+			err := ex.deleteStagedFiles(path)
+			if err != nil {
+				logger.Error("Failed to delete staged file", zap.String("path", path), zap.Error(err))
+			}
+		}
+	}()
+
+	files := make([]*ManifestFile, len(ex.tables))
+	var oldTotalRows, newTotalRows int64
+	for _, i := range tables {
+		table := &ex.tables[i]
+		fileName := table.FileName(startTime)
+		path := ex.storage.Join(directory, fileName)
+		logger := logger.With(zap.String("path", path))
+
+		fileExists, err := CheckFileExists(ex.storage, path)
+		if err != nil {
+			logger.Error("Failed to check if file exists", zap.Error(err))
+			return err
+		}
+		var oldFile *ManifestFile
+		if fileExists {
+			if !force {
+				logger.Info("File already exists, skipping")
+				continue
+			}
+			oldFile = oldManifest.Find(fileName)
+			if oldFile == nil {
+				oldFile, err = ComputeManifestFile(ex.storage, path)
+				if err != nil {
+					logger.Error("Failed to read existing file", zap.Error(err))
+					return err
+				}
+			}
+		}
+
+		query, err := ex.RenderQueryTemplate(table.query, startTime, endTime)
+		if err != nil {
+			logger.Error("Failed to render query template", zap.Error(err))
+			return err
+		}
+
+		err = ex.deleteStagedFiles(path)
+		if err != nil {
+			logger.Error("Failed to delete stale file", zap.Error(err))
+			return err
+		}
+		staged = append(staged, path)
+
+		logger.Info("Exporting file")
+		newPath := ex.stagedPath(path)
+		file, err := ex.ExportQuery(context.Background(), newPath, query, table)
+		if err != nil {
+			logger.Error("Failed to export file", zap.Error(err))
+			return err
+		}
+		file.Name = fileName
+
+		err = ex.SignFile(ex.stagedPath(SignatureFileName(path)), file.SHA256)
+		if err != nil {
+			logger.Error("Failed to write signature", zap.Error(err))
+			return err
+		}
+
+		files[i] = file
+		newTotalRows += file.Rows
+		if oldFile != nil {
+			oldTotalRows += oldFile.Rows
+			logger.Info("Exported new version of file", zap.Int64("old_rows", oldFile.Rows), zap.Int64("new_rows", file.Rows), zap.Int64("diff", file.Rows-oldFile.Rows))
+		} else {
+			logger.Info("Exported new file", zap.Int64("new_rows", file.Rows))
+		}
+	}
+
+	if len(staged) == 0 {
+		return nil
+	}
+
+	manifest, _, err := ex.buildManifest(startTime, files)
+	if err != nil {
+		return err
+	}
+	err = ex.deleteStagedFiles(manifestPath)
+	if err != nil {
+		logger.Error("Failed to delete stale manifest", zap.Error(err))
+		return err
+	}
+	staged = append(staged, manifestPath)
+	err = ex.writeManifest(ex.stagedPath(manifestPath), ex.stagedPath(SignatureFileName(manifestPath)), manifest)
+	if err != nil {
+		return err
+	}
+
+	var backups []*fileBackup
+	published := false
+	defer func() {
+		if !published {
+			ex.restoreBackups(backups)
+		}
+	}()
+	for _, path := range staged {
+		backups, err = ex.replaceFile(path, backups)
+		if err != nil {
+			logger.Error("Failed to replace file", zap.String("path", path), zap.Error(err))
+			return err
+		}
+	}
+	published = true
+	ex.deleteBackups(backups)
+
+	logger.Info("Backfilled day", zap.Int64("old_rows", oldTotalRows), zap.Int64("new_rows", newTotalRows), zap.Int64("diff", newTotalRows-oldTotalRows))
+	return nil
+}
+
+func (ex *exporter) Backfill(now time.Time, config BackfillConfig) error {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	from := time.Date(config.From.Year(), config.From.Month(), config.From.Day(), 0, 0, 0, 0, now.Location())
+	to := time.Date(config.To.Year(), config.To.Month(), config.To.Day(), 0, 0, 0, 0, now.Location())
+	if to.Before(from) {
+		return errors.New("the last day is before the first day")
+	}
+	if !to.Before(today) {
+		return errors.New("only complete days can be exported")
+	}
+
+	tables, err := ex.selectTables(config.Tables)
+	if err != nil {
+		return err
+	}
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		err = ex.BackfillDay(day, tables, config.Force)
+		if err != nil {
+			ex.logger.Error("Failed to backfill day", zap.String("day", day.Format("2006-01-02")), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func BackfillAll(logger *zap.Logger, sc StorageConfig, databaseConfig *pgx.ConnConfig, config ExportConfig, backfillConfig BackfillConfig) error {
+	return withExporter(logger, sc, databaseConfig, config, func(ex *exporter) error {
+		return ex.Backfill(time.Now(), backfillConfig)
+	})
+}
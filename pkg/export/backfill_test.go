@@ -0,0 +1,106 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReplaceFile(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", []byte("old"))
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz.sig", []byte("old signature"))
+	storage.WriteFile("2020/2020-03/.2020-03-01-track-update.jsonl.gz.new", []byte("new"))
+	storage.WriteFile("2020/2020-03/.2020-03-01-track-update.jsonl.gz.sig.new", []byte("new signature"))
+	storage.WriteFile("2020/2020-03/.2020-03-01-meta-update.jsonl.gz.new", []byte("new meta"))
+	storage.WriteFile("2020/2020-03/.2020-03-01-meta-update.jsonl.gz.sig.new", []byte("new meta signature"))
+
+	backups, err := ex.replaceFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", nil)
+	require.NoError(t, err)
+	backups, err = ex.replaceFile("2020/2020-03/2020-03-01-meta-update.jsonl.gz", backups)
+	require.NoError(t, err)
+	require.Len(t, backups, 4)
+
+	data, _ := storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz")
+	assert.Equal(t, "new", string(data))
+	data, _ = storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz.sig")
+	assert.Equal(t, "new signature", string(data))
+	data, _ = storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz.bak")
+	assert.Equal(t, "old", string(data))
+	data, _ = storage.ReadFile("2020/2020-03/2020-03-01-meta-update.jsonl.gz")
+	assert.Equal(t, "new meta", string(data))
+
+	ex.restoreBackups(backups)
+	data, _ = storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz")
+	assert.Equal(t, "old", string(data))
+	data, _ = storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz.sig")
+	assert.Equal(t, "old signature", string(data))
+	assert.Equal(t, []string{
+		"2020/2020-03/2020-03-01-track-update.jsonl.gz",
+		"2020/2020-03/2020-03-01-track-update.jsonl.gz.sig",
+	}, storage.Paths())
+}
+
+func TestReplaceFile_NoSignature(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", []byte("old"))
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz.sig", []byte("old signature"))
+	storage.WriteFile("2020/2020-03/.2020-03-01-track-update.jsonl.gz.new", []byte("new"))
+
+	backups, err := ex.replaceFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"2020/2020-03/2020-03-01-track-update.jsonl.gz",
+		"2020/2020-03/2020-03-01-track-update.jsonl.gz.bak",
+		"2020/2020-03/2020-03-01-track-update.jsonl.gz.sig.bak",
+	}, storage.Paths())
+
+	ex.restoreBackups(backups)
+	data, _ := storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz.sig")
+	assert.Equal(t, "old signature", string(data))
+}
+
+func TestReplaceFile_DeleteBackups(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", []byte("old"))
+	storage.WriteFile("2020/2020-03/.2020-03-01-track-update.jsonl.gz.new", []byte("new"))
+	storage.WriteFile("2020/2020-03/.2020-03-01-track-update.jsonl.gz.sig.new", []byte("new signature"))
+
+	backups, err := ex.replaceFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", nil)
+	require.NoError(t, err)
+	ex.deleteBackups(backups)
+
+	assert.Equal(t, []string{
+		"2020/2020-03/2020-03-01-track-update.jsonl.gz",
+		"2020/2020-03/2020-03-01-track-update.jsonl.gz.sig",
+	}, storage.Paths())
+	data, _ := storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz")
+	assert.Equal(t, "new", string(data))
+}
+
+func TestSelectTables(t *testing.T) {
+	ex := &exporter{config: map[string]TableConfig{"foo-full": {Query: "SELECT 1", Mode: TableModeFull}}}
+	ex.AddTables()
+
+	indexes, err := ex.selectTables(nil)
+	require.NoError(t, err)
+	assert.Len(t, indexes, len(builtinTables))
+
+	indexes, err = ex.selectTables([]string{"meta-update"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, indexes)
+
+	_, err = ex.selectTables([]string{"meta-update", "foo-full"})
+	assert.Error(t, err)
+
+	_, err = ex.selectTables([]string{"bar"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,302 @@
+package export
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const cacheFileSuffix = ".cache"
+
+type ProxyCacheConfig struct {
+	// Directory for cached files, files are not cached on disk if empty.
+	Dir string
+	// Maximum total size of cached files in bytes.
+	MaxSize int64
+	// How long are directory listings and file information cached, zero disables the cache.
+	ListingTTL time.Duration
+}
+
+var errFileTooLarge = errors.New("file is too large to be cached")
+
+type listingCacheEntry struct {
+	expires time.Time
+	info    os.FileInfo
+	infos   []os.FileInfo
+	err     error
+}
+
+// listingCache is a storage which caches results of Stat and ReadDir calls for a limited time.
+// Only "not found" errors are cached, other errors are returned to all callers.
+type listingCache struct {
+	Storage
+	ttl   time.Duration
+	now   func() time.Time
+	mu    sync.Mutex
+	stats map[string]*listingCacheEntry
+	dirs  map[string]*listingCacheEntry
+}
+
+// Above this number of entries, expired entries are removed when a new one is added.
+const listingCacheSweepSize = 10000
+
+func newListingCache(storage Storage, ttl time.Duration) *listingCache {
+	return &listingCache{
+		Storage: storage,
+		ttl:     ttl,
+		now:     time.Now,
+		stats:   make(map[string]*listingCacheEntry),
+		dirs:    make(map[string]*listingCacheEntry),
+	}
+}
+
+func cleanStoragePath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+func (c *listingCache) get(entries map[string]*listingCacheEntry, key string) *listingCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, exists := entries[key]
+	if !exists {
+		return nil
+	}
+	if !c.now().Before(entry.expires) {
+		delete(entries, key)
+		return nil
+	}
+	return entry
+}
+
+func (c *listingCache) put(entries map[string]*listingCacheEntry, key string, entry *listingCacheEntry) {
+	if entry.err != nil && !os.IsNotExist(entry.err) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if len(entries) >= listingCacheSweepSize {
+		for k, e := range entries {
+			if !now.Before(e.expires) {
+				delete(entries, k)
+			}
+		}
+	}
+	entry.expires = now.Add(c.ttl)
+	entries[key] = entry
+}
+
+func (c *listingCache) Stat(path string) (os.FileInfo, error) {
+	key := cleanStoragePath(path)
+	if entry := c.get(c.stats, key); entry != nil {
+		return entry.info, entry.err
+	}
+	info, err := c.Storage.Stat(path)
+	c.put(c.stats, key, &listingCacheEntry{info: info, err: err})
+	return info, err
+}
+
+// forget drops the cached Stat result of a file.
+func (c *listingCache) forget(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.stats, cleanStoragePath(path))
+}
+
+// forgetStat drops the cached Stat result of a file, if the storage caches them.
+func forgetStat(storage Storage, path string) {
+	if c, ok := storage.(*listingCache); ok {
+		c.forget(path)
+	}
+}
+
+func (c *listingCache) ReadDir(path string) ([]os.FileInfo, error) {
+	key := cleanStoragePath(path)
+	if entry := c.get(c.dirs, key); entry != nil {
+		return entry.infos, entry.err
+	}
+	infos, err := c.Storage.ReadDir(path)
+	c.put(c.dirs, key, &listingCacheEntry{infos: infos, err: err})
+	return infos, err
+}
+
+type diskCacheEntry struct {
+	path      string
+	localPath string
+	size      int64
+	modTime   time.Time
+}
+
+// diskCache keeps copies of remote files on the local disk. When the total size of the files exceeds the limit,
+// the least recently used files are deleted. Cached files are identified by their size and modification time,
+// so a file that was replaced in the storage is downloaded again.
+type diskCache struct {
+	dir     string
+	maxSize int64
+	logger  *zap.Logger
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
+	// paths of files which are being copied to the cache
+	filling map[string]bool
+	fills   sync.WaitGroup
+}
+
+// newDiskCache creates a cache in the given directory. Files left in the directory by a previous process are deleted,
+// because there is no way to tell which version of the remote file they are.
+func newDiskCache(logger *zap.Logger, dir string, maxSize int64) (*diskCache, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if strings.HasSuffix(info.Name(), cacheFileSuffix) || strings.HasSuffix(info.Name(), cacheFileSuffix+".tmp") {
+			os.Remove(filepath.Join(dir, info.Name()))
+		}
+	}
+	return &diskCache{
+		dir:     dir,
+		maxSize: maxSize,
+		logger:  logger,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		filling: make(map[string]bool),
+	}, nil
+}
+
+func (c *diskCache) localPath(path string) string {
+	hash := sha256.Sum256([]byte(cleanStoragePath(path)))
+	return filepath.Join(c.dir, hex.EncodeToString(hash[:])+cacheFileSuffix)
+}
+
+func (c *diskCache) remove(elem *list.Element, deleteFile bool) {
+	entry := elem.Value.(*diskCacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, entry.path)
+	c.size -= entry.size
+	if deleteFile {
+		os.Remove(entry.localPath)
+	}
+}
+
+// Open returns the cached copy of a file, or nil if the file is not cached.
+func (c *diskCache) Open(path string, info os.FileInfo) *os.File {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, exists := c.entries[cleanStoragePath(path)]
+	if !exists {
+		return nil
+	}
+	entry := elem.Value.(*diskCacheEntry)
+	if entry.size != info.Size() || !entry.modTime.Equal(info.ModTime()) {
+		c.remove(elem, true)
+		return nil
+	}
+	file, err := os.Open(entry.localPath)
+	if err != nil {
+		c.logger.Warn("Failed to open cached file", zap.String("path", entry.localPath), zap.Error(err))
+		c.remove(elem, true)
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return file
+}
+
+// Add copies a file to the cache and returns the cached copy.
+func (c *diskCache) Add(path string, info os.FileInfo, r io.Reader) (*os.File, error) {
+	if info.Size() > c.maxSize {
+		return nil, errFileTooLarge
+	}
+
+	localPath := c.localPath(path)
+	tempFile, err := ioutil.TempFile(c.dir, filepath.Base(localPath)+".*"+cacheFileSuffix+".tmp")
+	if err != nil {
+		return nil, err
+	}
+	size, err := io.Copy(tempFile, r)
+	if err == nil && size != info.Size() {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+		return nil, err
+	}
+	err = tempFile.Close()
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err = os.Rename(tempFile.Name(), localPath)
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return nil, err
+	}
+
+	key := cleanStoragePath(path)
+	if elem, exists := c.entries[key]; exists {
+		// the file was replaced by the rename above
+		c.remove(elem, false)
+	}
+	c.entries[key] = c.lru.PushFront(&diskCacheEntry{path: key, localPath: localPath, size: size, modTime: info.ModTime()})
+	c.size += size
+
+	for c.size > c.maxSize {
+		elem := c.lru.Back()
+		if elem == nil || elem.Value.(*diskCacheEntry).path == key {
+			break
+		}
+		c.logger.Debug("Evicting cached file", zap.String("path", elem.Value.(*diskCacheEntry).path))
+		c.remove(elem, true)
+	}
+
+	// files that are being served are not affected if they are evicted, the data is only released after they are closed
+	return os.Open(localPath)
+}
+
+// beginFill marks a file as being copied to the cache. It returns false if the file is already being copied,
+// so that concurrent misses download each file only once.
+func (c *diskCache) beginFill(path string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := cleanStoragePath(path)
+	if c.filling[key] {
+		return false
+	}
+	c.filling[key] = true
+	c.fills.Add(1)
+	return true
+}
+
+func (c *diskCache) endFill(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.filling, cleanStoragePath(path))
+	c.fills.Done()
+}
+
+// Size returns the total size of cached files.
+func (c *diskCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
@@ -0,0 +1,238 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// countingStorage counts calls to the read operations of a storage.
+type countingStorage struct {
+	Storage
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newCountingStorage(storage Storage) *countingStorage {
+	return &countingStorage{Storage: storage, calls: make(map[string]int)}
+}
+
+func (s *countingStorage) count(op string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[op]++
+}
+
+func (s *countingStorage) Calls(op string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[op]
+}
+
+func (s *countingStorage) Stat(path string) (os.FileInfo, error) {
+	s.count("stat")
+	return s.Storage.Stat(path)
+}
+
+func (s *countingStorage) ReadDir(path string) ([]os.FileInfo, error) {
+	s.count("readdir")
+	return s.Storage.ReadDir(path)
+}
+
+func (s *countingStorage) Open(path string) (StorageFile, error) {
+	s.count("open")
+	return s.Storage.Open(path)
+}
+
+func newTestDiskCache(t *testing.T, maxSize int64) *diskCache {
+	dir, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	cache, err := newDiskCache(zap.NewNop(), dir, maxSize)
+	require.NoError(t, err)
+	return cache
+}
+
+func TestListingCache(t *testing.T) {
+	mem := newMemStorage()
+	mem.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", []byte("data"))
+	storage := newCountingStorage(mem)
+
+	now := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	cache := newListingCache(storage, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		info, err := cache.Stat("/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz")
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), info.Size())
+		infos, err := cache.ReadDir("2020/2020-03/")
+		require.NoError(t, err)
+		assert.Len(t, infos, 1)
+		_, err = cache.Stat("2020/missing")
+		assert.True(t, os.IsNotExist(err))
+	}
+	assert.Equal(t, 2, storage.Calls("stat"))
+	assert.Equal(t, 1, storage.Calls("readdir"))
+
+	now = now.Add(time.Minute)
+	_, err := cache.Stat("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz")
+	require.NoError(t, err)
+	assert.Equal(t, 3, storage.Calls("stat"))
+}
+
+func readCachedFile(t *testing.T, cache *diskCache, path string, info os.FileInfo) []byte {
+	file := cache.Open(path, info)
+	if file == nil {
+		return nil
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	require.NoError(t, err)
+	return data
+}
+
+func TestDiskCache_Evict(t *testing.T) {
+	cache := newTestDiskCache(t, 10)
+	modTime := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	info := func(size int64) os.FileInfo { return &memFileInfo{size: size, modTime: modTime} }
+
+	for _, name := range []string{"a", "b"} {
+		file, err := cache.Add(name, info(4), bytes.NewReader([]byte(name+name+name+name)))
+		require.NoError(t, err)
+		file.Close()
+	}
+	assert.Equal(t, []byte("aaaa"), readCachedFile(t, cache, "a", info(4)))
+
+	file, err := cache.Add("c", info(4), bytes.NewReader([]byte("cccc")))
+	require.NoError(t, err)
+	file.Close()
+
+	assert.Equal(t, int64(8), cache.Size())
+	assert.Nil(t, readCachedFile(t, cache, "b", info(4)))
+	assert.Equal(t, []byte("aaaa"), readCachedFile(t, cache, "a", info(4)))
+	assert.Equal(t, []byte("cccc"), readCachedFile(t, cache, "c", info(4)))
+	_, err = os.Stat(cache.localPath("b"))
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = cache.Add("d", info(11), bytes.NewReader(make([]byte, 11)))
+	assert.Equal(t, errFileTooLarge, err)
+}
+
+func TestDiskCache_Changed(t *testing.T) {
+	cache := newTestDiskCache(t, 10)
+	oldInfo := &memFileInfo{size: 4, modTime: time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)}
+	newInfo := &memFileInfo{size: 4, modTime: time.Date(2020, 3, 3, 0, 0, 0, 0, time.UTC)}
+
+	file, err := cache.Add("a", oldInfo, bytes.NewReader([]byte("aaaa")))
+	require.NoError(t, err)
+	file.Close()
+
+	assert.Nil(t, readCachedFile(t, cache, "a", newInfo))
+	assert.Equal(t, int64(0), cache.Size())
+
+	_, err = cache.Add("a", newInfo, bytes.NewReader([]byte("aa")))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestDiskCache_Fill(t *testing.T) {
+	cache := newTestDiskCache(t, 10)
+
+	assert.True(t, cache.beginFill("/a"))
+	assert.False(t, cache.beginFill("a"))
+	assert.True(t, cache.beginFill("b"))
+	cache.endFill("a")
+	cache.endFill("b")
+	assert.True(t, cache.beginFill("a"))
+	cache.endFill("a")
+	cache.fills.Wait()
+}
+
+func TestFileHandler(t *testing.T) {
+	mem := newMemStorage()
+	mem.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", []byte("0123456789"))
+	mem.WriteFile("2020/2020-03/2020-03-01-manifest.json", []byte("{}"))
+	storage := newCountingStorage(mem)
+	handler := &fileHandler{storage: storage, cache: newTestDiskCache(t, 100), logger: zap.NewNop(), fallback: http.NotFoundHandler()}
+
+	path := "/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz"
+	var etag string
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "0123456789", rec.Body.String())
+		assert.Equal(t, "Mon, 02 Mar 2020 00:25:00 GMT", rec.Header().Get("Last-Modified"))
+		etag = rec.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+		handler.cache.fills.Wait()
+	}
+	// the first request is served from the storage, while the file is copied to the cache
+	assert.Equal(t, 2, storage.Calls("open"))
+
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set("Range", "bytes=2-4")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, "234", rec.Body.String())
+	assert.Equal(t, "bytes 2-4/10", rec.Header().Get("Content-Range"))
+
+	req = httptest.NewRequest("GET", path, nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	for i := 0; i < 2; i++ {
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/2020/2020-03/2020-03-01-manifest.json", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "{}", rec.Body.String())
+	}
+	assert.Equal(t, 4, storage.Calls("open"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/2020/2020-03/missing.jsonl.gz", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestIsImmutableFile(t *testing.T) {
+	assert.True(t, isImmutableFile("/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz"))
+	assert.True(t, isImmutableFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz.sig"))
+	assert.True(t, isImmutableFile("2020/2020-03/2020-03-fingerprint-update.jsonl.gz"))
+	assert.False(t, isImmutableFile("2020/2020-03/2020-03-01-manifest.json"))
+	assert.False(t, isImmutableFile("hourly/2020-03-02/2020-03-02-00-fingerprint-update.jsonl.gz"))
+}
+
+func TestFileHandler_StaleListing(t *testing.T) {
+	mem := newMemStorage()
+	mem.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", []byte("0123456789"))
+	storage := newListingCache(mem, time.Minute)
+	handler := &fileHandler{storage: storage, cache: newTestDiskCache(t, 100), logger: zap.NewNop(), fallback: http.NotFoundHandler()}
+
+	path := "/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz"
+	_, err := storage.Stat(path)
+	require.NoError(t, err)
+
+	// the file is replaced by a backfill while its size is still cached
+	mem.WriteFile(path, []byte("01234"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "01234", rec.Body.String())
+
+	info, err := storage.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), info.Size())
+}
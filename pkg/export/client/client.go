@@ -0,0 +1,370 @@
+// Package client implements discovery and download of data files published by the data proxy.
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/acoustid/acoustid/pkg/export"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrNotFound = errors.New("not found")
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// Entry is a file or a directory in a directory listing.
+type Entry struct {
+	Name  string
+	IsDir bool
+}
+
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// DefaultTimeout is the timeout of requests made by the default HTTP client. Data files are downloaded
+// in a single request, so it allows for large files on slow connections.
+const DefaultTimeout = 30 * time.Minute
+
+func NewClient(baseURL string) *Client {
+	return &Client{baseURL: strings.TrimSuffix(baseURL, "/"), httpClient: &http.Client{Timeout: DefaultTimeout}}
+}
+
+// WithHTTPClient sets the HTTP client used for all requests.
+func (c *Client) WithHTTPClient(httpClient *http.Client) *Client {
+	c.httpClient = httpClient
+	return c
+}
+
+// WithAPIKey sets the API key sent with all requests, for data proxies which require one.
+func (c *Client) WithAPIKey(apiKey string) *Client {
+	c.apiKey = apiKey
+	return c
+}
+
+func (c *Client) url(path string) string {
+	return c.baseURL + "/" + strings.TrimPrefix(path, "/")
+}
+
+func (c *Client) get(ctx context.Context, path string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, c.url(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the partially downloaded file may be already complete, download checks its size
+		if header.Get("Range") != "" {
+			return resp, nil
+		}
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	resp.Body.Close()
+	return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, path)
+}
+
+// List returns the contents of a directory.
+func (c *Client) List(ctx context.Context, path string) ([]Entry, error) {
+	if path != "" && !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+	resp, err := c.get(ctx, path+"?format=json", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var listing export.Listing
+	err = json.NewDecoder(resp.Body).Decode(&listing)
+	if err != nil {
+		return nil, fmt.Errorf("invalid listing of %q: %w", path, err)
+	}
+	entries := make([]Entry, 0, len(listing.Entries))
+	for _, entry := range listing.Entries {
+		entries = append(entries, Entry{Name: entry.Name, IsDir: entry.IsDir})
+	}
+	return entries, nil
+}
+
+// Years returns the years for which data files are available.
+func (c *Client) Years(ctx context.Context) ([]int, error) {
+	entries, err := c.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var years []int
+	for _, entry := range entries {
+		year, err := strconv.Atoi(entry.Name)
+		if err == nil && entry.IsDir && len(entry.Name) == 4 {
+			years = append(years, year)
+		}
+	}
+	return years, nil
+}
+
+// Months returns the first days of months of the given year for which data files are available.
+func (c *Client) Months(ctx context.Context, year int) ([]time.Time, error) {
+	entries, err := c.List(ctx, strconv.Itoa(year))
+	if err != nil {
+		return nil, err
+	}
+	var months []time.Time
+	for _, entry := range entries {
+		month, err := time.Parse("2006-01", entry.Name)
+		if err == nil && entry.IsDir {
+			months = append(months, month)
+		}
+	}
+	return months, nil
+}
+
+// Days returns the days of the given month for which data files are available.
+func (c *Client) Days(ctx context.Context, month time.Time) ([]time.Time, error) {
+	entries, err := c.List(ctx, monthDirectory(month))
+	if err != nil {
+		return nil, err
+	}
+	var days []time.Time
+	seen := make(map[time.Time]bool)
+	for _, entry := range entries {
+		if entry.IsDir || len(entry.Name) < 11 {
+			continue
+		}
+		day, err := time.Parse("2006-01-02", entry.Name[:10])
+		if err != nil || seen[day] {
+			continue
+		}
+		seen[day] = true
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func monthDirectory(day time.Time) string {
+	return day.Format("2006") + "/" + day.Format("2006-01")
+}
+
+// FilePath returns the path of a daily data file.
+func FilePath(day time.Time, name string) string {
+	return monthDirectory(day) + "/" + name
+}
+
+// DayFiles returns names of all data files of the given day, excluding manifests and signatures.
+func (c *Client) DayFiles(ctx context.Context, day time.Time) ([]string, error) {
+	entries, err := c.List(ctx, monthDirectory(day))
+	if err != nil {
+		return nil, err
+	}
+	prefix := day.Format("2006-01-02") + "-"
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir || !strings.HasPrefix(entry.Name, prefix) || !strings.HasSuffix(entry.Name, ".gz") {
+			continue
+		}
+		names = append(names, entry.Name)
+	}
+	return names, nil
+}
+
+// Manifest returns the manifest of the given day, or nil if it is not published.
+func (c *Client) Manifest(ctx context.Context, day time.Time) (*export.Manifest, error) {
+	resp, err := c.get(ctx, FilePath(day, export.ManifestFileName(day)), nil)
+	if err != nil {
+		if err == ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var manifest export.Manifest
+	err = json.NewDecoder(resp.Body).Decode(&manifest)
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// Open returns a stream of a remote file.
+func (c *Client) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	resp, err := c.get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Download saves a remote file to a local path. The file is first downloaded to a temporary file with the ".part"
+// suffix, and if the temporary file already exists, the download is resumed from where it stopped.
+// If the expected file information is given, the size and SHA-256 checksum of the file are verified.
+func (c *Client) Download(ctx context.Context, path string, localPath string, expected *export.ManifestFile) error {
+	return c.download(ctx, path, 0, -1, localPath, expected)
+}
+
+// download is like Download, but saves only size bytes of the remote file starting at start,
+// or the whole file if size is negative.
+func (c *Client) download(ctx context.Context, path string, start, size int64, localPath string, expected *export.ManifestFile) error {
+	partPath := localPath + ".part"
+
+	file, err := os.OpenFile(partPath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	offset, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	if size < 0 || offset < size {
+		header := http.Header{}
+		if size >= 0 {
+			header.Set("Range", fmt.Sprintf("bytes=%d-%d", start+offset, start+size-1))
+		} else if offset > 0 {
+			header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+		resp, err := c.get(ctx, path, header)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusRequestedRangeNotSatisfiable:
+			// the partially downloaded file is complete only if it has the size of the remote file
+			var total int64
+			_, err = fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes */%d", &total)
+			if err != nil || size >= 0 || total != offset {
+				resp.Body.Close()
+				file.Close()
+				err = os.Remove(partPath)
+				if err != nil {
+					return err
+				}
+				if size >= 0 {
+					return fmt.Errorf("server didn't return the requested range of %s", path)
+				}
+				// the remote file was replaced, so the download starts again
+				return c.download(ctx, path, start, size, localPath, expected)
+			}
+		case http.StatusOK:
+			if size >= 0 {
+				return fmt.Errorf("server didn't return the requested range of %s", path)
+			}
+			err = file.Truncate(0)
+			if err != nil {
+				return err
+			}
+			_, err = file.Seek(0, io.SeekStart)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, resp.Body)
+		case http.StatusPartialContent:
+			var rangeStart int64
+			_, err = fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-", &rangeStart)
+			if err != nil || rangeStart != start+offset {
+				return fmt.Errorf("server returned range %q instead of starting at %d for %s", resp.Header.Get("Content-Range"), start+offset, path)
+			}
+			_, err = io.Copy(file, resp.Body)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if expected != nil {
+		err = verifyFile(file, expected)
+		if err != nil {
+			file.Close()
+			os.Remove(partPath)
+			return err
+		}
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(partPath, localPath)
+}
+
+func verifyFile(file *os.File, expected *export.ManifestFile) error {
+	_, err := file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return err
+	}
+	if size != expected.Size || hex.EncodeToString(hash.Sum(nil)) != expected.SHA256 {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
+// DownloadDay saves all data files of the given day to a local directory and returns their paths.
+// Files are verified against the manifest of the day, if one is published.
+func (c *Client) DownloadDay(ctx context.Context, day time.Time, directory string) ([]string, error) {
+	manifest, err := c.Manifest(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if manifest != nil {
+		for _, file := range manifest.Files {
+			names = append(names, file.Name)
+		}
+	} else {
+		names, err = c.DayFiles(ctx, day)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var paths []string
+	for _, name := range names {
+		localPath := filepath.Join(directory, name)
+		file := manifest.Find(name)
+		if file != nil && file.Monthly != "" {
+			// the daily file was rolled up into a monthly file
+			err = c.download(ctx, FilePath(day, file.Monthly), file.Offset, file.Size, localPath, file)
+		} else {
+			err = c.Download(ctx, FilePath(day, name), localPath, file)
+		}
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, localPath)
+	}
+	return paths, nil
+}
@@ -0,0 +1,301 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/acoustid/acoustid/pkg/export"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFingerprintRows = `{"id":1,"fingerprint":[-587455133,-591649759,-574868448],"length":120,"created":"2020-03-01T10:00:00+00:00"}
+{"id":2,"fingerprint":"AQAAAwkjrUmSJQpUHfkBpxQ","length":60,"created":"2020-03-01T11:00:00.5+00:00"}
+`
+
+func gzipData(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	_, err := gzipWriter.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func writeTestFile(t *testing.T, root, path string, data []byte) {
+	fullPath := filepath.Join(root, filepath.FromSlash(path))
+	require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+	require.NoError(t, ioutil.WriteFile(fullPath, data, 0644))
+}
+
+func manifestFile(name string, data []byte) export.ManifestFile {
+	sum := sha256.Sum256(data)
+	return export.ManifestFile{Name: name, Size: int64(len(data)), SHA256: hex.EncodeToString(sum[:])}
+}
+
+// newFileServer serves files from a directory, with JSON directory listings like the data proxy.
+func newFileServer(root string) http.Handler {
+	files := http.FileServer(http.Dir(root))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") != "json" {
+			files.ServeHTTP(w, r)
+			return
+		}
+		infos, err := ioutil.ReadDir(filepath.Join(root, filepath.FromSlash(r.URL.Path)))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		listing := export.Listing{Path: r.URL.Path, Entries: []export.ListingEntry{}}
+		for _, info := range infos {
+			listing.Entries = append(listing.Entries, export.ListingEntry{Name: info.Name(), IsDir: info.IsDir(), Size: info.Size()})
+		}
+		json.NewEncoder(w).Encode(listing)
+	})
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, []byte) {
+	root, err := ioutil.TempDir("", "client")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(root) })
+
+	data := gzipData(t, testFingerprintRows)
+	writeTestFile(t, root, "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", data)
+	writeTestFile(t, root, "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz.sig", []byte("signature"))
+	writeTestFile(t, root, "2020/2020-03/2020-03-02-fingerprint-update.jsonl.gz", data)
+	writeTestFile(t, root, "2020/2020-02/2020-02-29-fingerprint-update.jsonl.gz", data)
+	writeTestFile(t, root, "hourly/2020-03-03/2020-03-03-00-fingerprint-update.jsonl.gz", data)
+
+	manifest := &export.Manifest{Date: "2020-03-01", Files: []export.ManifestFile{manifestFile("2020-03-01-fingerprint-update.jsonl.gz", data)}}
+	manifestData, err := export.EncodeManifest(manifest)
+	require.NoError(t, err)
+	writeTestFile(t, root, "2020/2020-03/2020-03-01-manifest.json", manifestData)
+
+	server := httptest.NewServer(newFileServer(root))
+	t.Cleanup(server.Close)
+	return server, data
+}
+
+func TestClient_Listing(t *testing.T) {
+	server, _ := newTestServer(t)
+	client := NewClient(server.URL)
+	ctx := context.Background()
+
+	years, err := client.Years(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2020}, years)
+
+	months, err := client.Months(ctx, 2020)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)}, months)
+
+	days, err := client.Days(ctx, months[1])
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)}, days)
+
+	files, err := client.DayFiles(ctx, days[0])
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2020-03-01-fingerprint-update.jsonl.gz"}, files)
+
+	manifest, err := client.Manifest(ctx, days[0])
+	require.NoError(t, err)
+	require.NotNil(t, manifest)
+	assert.Equal(t, "2020-03-01", manifest.Date)
+
+	manifest, err = client.Manifest(ctx, days[1])
+	require.NoError(t, err)
+	assert.Nil(t, manifest)
+}
+
+func TestClient_DownloadResume(t *testing.T) {
+	server, data := newTestServer(t)
+	client := NewClient(server.URL)
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "download")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	localPath := filepath.Join(dir, "2020-03-01-fingerprint-update.jsonl.gz")
+	require.NoError(t, ioutil.WriteFile(localPath+".part", data[:10], 0644))
+
+	expected := manifestFile("2020-03-01-fingerprint-update.jsonl.gz", data)
+	err = client.Download(ctx, "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", localPath, &expected)
+	require.NoError(t, err)
+
+	downloaded, err := ioutil.ReadFile(localPath)
+	require.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+	_, err = os.Stat(localPath + ".part")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestClient_DownloadResumeReplaced(t *testing.T) {
+	server, data := newTestServer(t)
+	client := NewClient(server.URL)
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "download")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the partial file is longer than the remote file, which was replaced since the download started
+	localPath := filepath.Join(dir, "2020-03-01-fingerprint-update.jsonl.gz")
+	require.NoError(t, ioutil.WriteFile(localPath+".part", append(append([]byte(nil), data...), "extra"...), 0644))
+
+	err = client.Download(ctx, "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", localPath, nil)
+	require.NoError(t, err)
+	downloaded, err := ioutil.ReadFile(localPath)
+	require.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+
+	// a complete partial file is only renamed
+	require.NoError(t, ioutil.WriteFile(localPath+".part", data, 0644))
+	err = client.Download(ctx, "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", localPath, nil)
+	require.NoError(t, err)
+	downloaded, err = ioutil.ReadFile(localPath)
+	require.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+}
+
+func TestClient_DownloadWrongRange(t *testing.T) {
+	data := []byte("0123456789")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Range", "bytes 0-9/10")
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(data)
+	}))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	dir, err := ioutil.TempDir("", "download")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	localPath := filepath.Join(dir, "2020-03-01-fingerprint-update.jsonl.gz")
+	require.NoError(t, ioutil.WriteFile(localPath+".part", data[:4], 0644))
+
+	err = client.Download(context.Background(), "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", localPath, nil)
+	assert.Error(t, err)
+	part, err := ioutil.ReadFile(localPath + ".part")
+	require.NoError(t, err)
+	assert.Equal(t, data[:4], part, "data from the wrong range is not appended")
+}
+
+func TestClient_DownloadChecksumMismatch(t *testing.T) {
+	server, data := newTestServer(t)
+	client := NewClient(server.URL)
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "download")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	localPath := filepath.Join(dir, "2020-03-01-fingerprint-update.jsonl.gz")
+	expected := manifestFile("2020-03-01-fingerprint-update.jsonl.gz", append([]byte("x"), data[1:]...))
+	err = client.Download(ctx, "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", localPath, &expected)
+	assert.Equal(t, ErrChecksumMismatch, err)
+
+	_, err = os.Stat(localPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestClient_DownloadDay(t *testing.T) {
+	server, _ := newTestServer(t)
+	client := NewClient(server.URL)
+
+	dir, err := ioutil.TempDir("", "download")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	paths, err := client.DownloadDay(context.Background(), time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC), dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "2020-03-02-fingerprint-update.jsonl.gz")}, paths)
+}
+
+func TestClient_DownloadDayRolledUp(t *testing.T) {
+	root, err := ioutil.TempDir("", "client")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	other := gzipData(t, "{}\n")
+	data := gzipData(t, testFingerprintRows)
+	writeTestFile(t, root, "2020/2020-01/2020-01-fingerprint-update.jsonl.gz", append(append([]byte(nil), other...), data...))
+
+	file := manifestFile("2020-01-15-fingerprint-update.jsonl.gz", data)
+	file.Monthly = "2020-01-fingerprint-update.jsonl.gz"
+	file.Offset = int64(len(other))
+	manifestData, err := export.EncodeManifest(&export.Manifest{Date: "2020-01-15", Files: []export.ManifestFile{file}})
+	require.NoError(t, err)
+	writeTestFile(t, root, "2020/2020-01/2020-01-15-manifest.json", manifestData)
+
+	server := httptest.NewServer(newFileServer(root))
+	defer server.Close()
+	client := NewClient(server.URL)
+
+	dir, err := ioutil.TempDir("", "download")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	paths, err := client.DownloadDay(context.Background(), time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC), dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "2020-01-15-fingerprint-update.jsonl.gz")}, paths)
+	downloaded, err := ioutil.ReadFile(paths[0])
+	require.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+}
+
+func TestClient_APIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "401 Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"path":"/","entries":[{"name":"2020","is_dir":true}]}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL).Years(context.Background())
+	assert.Error(t, err)
+
+	years, err := NewClient(server.URL).WithAPIKey("secret").Years(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{2020}, years)
+}
+
+func TestRecordReader(t *testing.T) {
+	reader, err := NewRecordReader(bytes.NewReader(gzipData(t, testFingerprintRows)), &export.ManifestFile{Name: "2020-03-01-fingerprint-update.jsonl.gz"})
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var fingerprints []Fingerprint
+	for {
+		record, err := NewRecord("fingerprint-update")
+		require.NoError(t, err)
+		err = reader.Read(record)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		fingerprints = append(fingerprints, *record.(*Fingerprint))
+	}
+
+	require.Len(t, fingerprints, 2)
+	assert.Equal(t, FingerprintHashes{-587455133, -591649759, -574868448}, fingerprints[0].Fingerprint)
+	assert.Equal(t, 120, fingerprints[0].Length)
+	assert.Equal(t, time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC), fingerprints[0].Created.UTC())
+	assert.Equal(t, fingerprints[0].Fingerprint, fingerprints[1].Fingerprint)
+	assert.Equal(t, 60, fingerprints[1].Length)
+}
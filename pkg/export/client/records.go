@@ -0,0 +1,197 @@
+package client
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/acoustid/acoustid/pkg/chromaprint"
+	"github.com/acoustid/acoustid/pkg/export"
+	"io"
+	"time"
+)
+
+// FingerprintHashes is a fingerprint, which can be exported either as an array of integers
+// or as a compressed Chromaprint string.
+type FingerprintHashes []int32
+
+func (h *FingerprintHashes) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var str string
+		err := json.Unmarshal(data, &str)
+		if err != nil {
+			return err
+		}
+		fp, err := chromaprint.ParseFingerprintString(str)
+		if err != nil {
+			return err
+		}
+		hashes := make(FingerprintHashes, len(fp.Hashes))
+		for i, hash := range fp.Hashes {
+			hashes[i] = int32(hash)
+		}
+		*h = hashes
+		return nil
+	}
+	return json.Unmarshal(data, (*[]int32)(h))
+}
+
+type Fingerprint struct {
+	ID          int               `json:"id"`
+	Fingerprint FingerprintHashes `json:"fingerprint"`
+	Length      int               `json:"length"`
+	Created     time.Time         `json:"created"`
+}
+
+type Meta struct {
+	ID          int       `json:"id"`
+	Track       *string   `json:"track"`
+	Artist      *string   `json:"artist"`
+	Album       *string   `json:"album"`
+	AlbumArtist *string   `json:"album_artist"`
+	TrackNo     *int      `json:"track_no"`
+	DiscNo      *int      `json:"disc_no"`
+	Year        *int      `json:"year"`
+	Created     time.Time `json:"created"`
+}
+
+type Track struct {
+	ID      int        `json:"id"`
+	GID     string     `json:"gid"`
+	NewID   *int       `json:"new_id"`
+	Created time.Time  `json:"created"`
+	Updated *time.Time `json:"updated"`
+}
+
+type TrackFingerprint struct {
+	ID              int        `json:"id"`
+	TrackID         int        `json:"track_id"`
+	FingerprintID   int        `json:"fingerprint_id"`
+	SubmissionCount int        `json:"submission_count"`
+	Created         time.Time  `json:"created"`
+	Updated         *time.Time `json:"updated"`
+}
+
+type TrackMBID struct {
+	ID              int        `json:"id"`
+	TrackID         int        `json:"track_id"`
+	MBID            string     `json:"mbid"`
+	SubmissionCount int        `json:"submission_count"`
+	Disabled        bool       `json:"disabled"`
+	Created         time.Time  `json:"created"`
+	Updated         *time.Time `json:"updated"`
+}
+
+type TrackPUID struct {
+	ID              int        `json:"id"`
+	TrackID         int        `json:"track_id"`
+	PUID            string     `json:"puid"`
+	SubmissionCount int        `json:"submission_count"`
+	Created         time.Time  `json:"created"`
+	Updated         *time.Time `json:"updated"`
+}
+
+type TrackMeta struct {
+	ID              int        `json:"id"`
+	TrackID         int        `json:"track_id"`
+	MetaID          int        `json:"meta_id"`
+	SubmissionCount int        `json:"submission_count"`
+	Created         time.Time  `json:"created"`
+	Updated         *time.Time `json:"updated"`
+}
+
+type ForeignIDVendor struct {
+	ID      int       `json:"id"`
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+}
+
+type ForeignID struct {
+	ID       int       `json:"id"`
+	VendorID int       `json:"vendor_id"`
+	Name     string    `json:"name"`
+	Created  time.Time `json:"created"`
+}
+
+type TrackForeignID struct {
+	ID              int        `json:"id"`
+	TrackID         int        `json:"track_id"`
+	ForeignIDID     int        `json:"foreignid_id"`
+	SubmissionCount int        `json:"submission_count"`
+	Created         time.Time  `json:"created"`
+	Updated         *time.Time `json:"updated"`
+}
+
+// Change is a deletion or a merge of a row.
+type Change struct {
+	ID        int64     `json:"id"`
+	TableName string    `json:"table_name"`
+	Operation string    `json:"operation"`
+	RecordID  int       `json:"record_id"`
+	NewID     *int      `json:"new_id"`
+	Created   time.Time `json:"created"`
+}
+
+// NewRecord returns a pointer to a new record for the exported table, e.g. "fingerprint-update".
+func NewRecord(table string) (interface{}, error) {
+	switch table {
+	case "fingerprint-update":
+		return &Fingerprint{}, nil
+	case "meta-update":
+		return &Meta{}, nil
+	case "track-update":
+		return &Track{}, nil
+	case "track_fingerprint-update":
+		return &TrackFingerprint{}, nil
+	case "track_mbid-update":
+		return &TrackMBID{}, nil
+	case "track_puid-update":
+		return &TrackPUID{}, nil
+	case "track_meta-update":
+		return &TrackMeta{}, nil
+	case "foreignid_vendor-update":
+		return &ForeignIDVendor{}, nil
+	case "foreignid-update":
+		return &ForeignID{}, nil
+	case "track_foreignid-update":
+		return &TrackForeignID{}, nil
+	case "changes-update":
+		return &Change{}, nil
+	}
+	return nil, fmt.Errorf("unknown table %q", table)
+}
+
+// RecordReader decodes records from a gzipped data file.
+type RecordReader struct {
+	gzipReader *gzip.Reader
+	rows       *export.RowReader
+}
+
+// NewRecordReader returns a reader for a data file. The manifest entry of the file is used to detect
+// the format of the file and which CSV columns contain JSON values.
+func NewRecordReader(r io.Reader, file *export.ManifestFile) (*RecordReader, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordReader{
+		gzipReader: gzipReader,
+		rows:       export.NewRowReader(gzipReader, export.FormatFromFileName(file.Name), file.JSONColumns),
+	}, nil
+}
+
+// Read decodes the next record into v. Returns io.EOF if there are no more records.
+func (rr *RecordReader) Read(v interface{}) error {
+	data, err := rr.rows.Next()
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return errors.New("empty row")
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (rr *RecordReader) Close() error {
+	return rr.gzipReader.Close()
+}
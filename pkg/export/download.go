@@ -0,0 +1,179 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Maximum number of days that can be downloaded in one request.
+const maxDownloadDays = 366
+
+type rangeFile struct {
+	// path of the file which contains the data, for rolled up files it's the monthly file
+	path string
+	// path of the daily file, under which downloads are counted
+	dailyPath string
+	file      ManifestFile
+	info      os.FileInfo
+}
+
+// statRangeFiles stats the files of a range and checks that the data of rolled up days is within their monthly files.
+func statRangeFiles(storage Storage, files []rangeFile) error {
+	for i := range files {
+		// the sizes are sent before the files are opened, so they can't come from the listing cache
+		forgetStat(storage, files[i].path)
+		info, err := storage.Stat(files[i].path)
+		if err != nil {
+			return err
+		}
+		if files[i].file.Monthly != "" && files[i].file.Offset+files[i].file.Size > info.Size() {
+			return fmt.Errorf("file %s is shorter than the data of %s in its manifest", files[i].path, files[i].file.Name)
+		}
+		files[i].info = info
+	}
+	return nil
+}
+
+// size returns the number of bytes streamed for the file, the whole daily file or the day's part of the monthly file.
+func (f rangeFile) size() int64 {
+	if f.file.Monthly != "" {
+		return f.file.Size
+	}
+	return f.info.Size()
+}
+
+// findRangeFiles returns the daily files of a table for all days in the range. Every day must have
+// a published manifest which lists a file of the table.
+func findRangeFiles(storage Storage, table string, from, to time.Time) ([]rangeFile, error) {
+	var files []rangeFile
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		directory := storage.Join(day.Format("2006"), day.Format("2006-01"))
+		manifest, err := ReadManifest(storage, storage.Join(directory, ManifestFileName(day)))
+		if err != nil {
+			return nil, err
+		}
+		if manifest == nil {
+			return nil, fmt.Errorf("day %s is not available", day.Format("2006-01-02"))
+		}
+		found := false
+		for _, file := range manifest.Files {
+			if ExportFileTable(file.Name) == table {
+				files = append(files, rangeFile{path: storage.Join(directory, file.DataFileName()), dailyPath: storage.Join(directory, file.Name), file: file})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("table %s is not available for day %s", table, day.Format("2006-01-02"))
+		}
+	}
+	return files, nil
+}
+
+func parseDownloadRange(r *http.Request) (time.Time, time.Time, error) {
+	from, err := time.Parse("2006-01-02", r.URL.Query().Get("from"))
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid or missing 'from' parameter, expected YYYY-MM-DD")
+	}
+	to, err := time.Parse("2006-01-02", r.URL.Query().Get("to"))
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid or missing 'to' parameter, expected YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, errors.New("'to' is before 'from'")
+	}
+	if to.Sub(from) >= maxDownloadDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("at most %d days can be downloaded at once", maxDownloadDays)
+	}
+	return from, to, nil
+}
+
+// rangeHandler streams daily files of one table for a range of days as a single gzip stream.
+// The path of the request is the table name, e.g. "/fingerprint-update?from=2020-03-01&to=2020-03-07".
+type rangeHandler struct {
+	storage Storage
+	files   *fileHandler
+	logger  *zap.Logger
+}
+
+func (h *rangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	table := strings.Trim(r.URL.Path, "/")
+	if table == "" || strings.Contains(table, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	from, to, err := parseDownloadRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	files, err := findRangeFiles(h.storage, table, from, to)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	// CSV and TSV files have headers, so they can't be simply concatenated
+	format := FormatFromFileName(files[0].file.Name)
+	for _, file := range files[1:] {
+		if FormatFromFileName(file.file.Name) != format || format != FormatJSONL {
+			http.Error(w, "only JSONL files of the same format can be downloaded as a range", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Content-Length must match the bytes that are streamed, so it's computed from the files, not the manifests
+	err = statRangeFiles(h.storage, files)
+	if err != nil {
+		h.logger.Error("Failed to stat files", zap.String("table", table), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var size int64
+	for _, file := range files {
+		size += file.size()
+	}
+	suffix := strings.TrimPrefix(files[0].file.Name, from.Format("2006-01-02")+"-")
+	fileName := fmt.Sprintf("%s-%s-%s", from.Format("2006-01-02"), to.Format("2006-01-02"), suffix)
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	for _, file := range files {
+		n, err := h.copyFile(w, file)
+		h.files.stats.Record(file.dailyPath, n, err == nil)
+		if err != nil {
+			// the response is already started, the client will notice the short body
+			h.logger.Error("Failed to stream file", zap.String("path", file.path), zap.Error(err))
+			return
+		}
+	}
+}
+
+func (h *rangeHandler) copyFile(w io.Writer, f rangeFile) (int64, error) {
+	file, err := h.files.open(f.path, f.info)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	if f.file.Monthly != "" {
+		_, err = file.Seek(f.file.Offset, io.SeekStart)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return io.CopyN(w, file, f.size())
+}
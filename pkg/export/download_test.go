@@ -0,0 +1,133 @@
+package export
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newRangeTestHandler(t *testing.T) *rangeHandler {
+	storage := newMemStorage()
+	for i, day := range []string{"2020-02-28", "2020-02-29", "2020-03-01"} {
+		date, err := time.Parse("2006-01-02", day)
+		require.NoError(t, err)
+		directory := date.Format("2006") + "/" + date.Format("2006-01") + "/"
+		data := gzipData(t, `{"id":`+string(rune('1'+i))+"}\n")
+		name := day + "-fingerprint-update.jsonl.gz"
+		storage.WriteFile(directory+name, data)
+		csvName := day + "-meta-update.csv.gz"
+		csvData := gzipData(t, "id\n1\n")
+		storage.WriteFile(directory+csvName, csvData)
+		manifest, err := EncodeManifest(&Manifest{Date: day, Files: []ManifestFile{
+			{Name: name, Size: int64(len(data))},
+			{Name: csvName, Size: int64(len(csvData))},
+		}})
+		require.NoError(t, err)
+		storage.WriteFile(directory+ManifestFileName(date), manifest)
+	}
+	files := &fileHandler{storage: storage, logger: zap.NewNop(), fallback: http.NotFoundHandler()}
+	return &rangeHandler{storage: storage, files: files, logger: zap.NewNop()}
+}
+
+func TestRangeHandler(t *testing.T) {
+	handler := newRangeTestHandler(t)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/fingerprint-update?from=2020-02-28&to=2020-03-01", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/gzip", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="2020-02-28-2020-03-01-fingerprint-update.jsonl.gz"`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, rec.Header().Get("Content-Length"), strconv.Itoa(rec.Body.Len()))
+
+	reader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n", string(data))
+}
+
+func TestRangeHandler_RolledUp(t *testing.T) {
+	storage := newMemStorage()
+	var monthly []byte
+	for i, day := range []string{"2020-01-30", "2020-01-31"} {
+		data := gzipData(t, `{"id":`+string(rune('1'+i))+"}\n")
+		manifest, err := EncodeManifest(&Manifest{Date: day, Files: []ManifestFile{
+			{Name: day + "-fingerprint-update.jsonl.gz", Size: int64(len(data)), Monthly: "2020-01-fingerprint-update.jsonl.gz", Offset: int64(len(monthly))},
+		}})
+		require.NoError(t, err)
+		storage.WriteFile("2020/2020-01/"+day+"-manifest.json", manifest)
+		monthly = append(monthly, data...)
+	}
+	storage.WriteFile("2020/2020-01/2020-01-fingerprint-update.jsonl.gz", append(monthly, gzipData(t, "{\"id\":3}\n")...))
+	stats := newDownloadStats()
+	stats.now = func() time.Time { return time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC) }
+	files := &fileHandler{storage: storage, stats: stats, logger: zap.NewNop(), fallback: http.NotFoundHandler()}
+	handler := &rangeHandler{storage: storage, files: files, logger: zap.NewNop()}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/fingerprint-update?from=2020-01-31&to=2020-01-31", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, rec.Header().Get("Content-Length"), strconv.Itoa(rec.Body.Len()))
+	assert.Equal(t, "{\"id\":2}\n", gunzipData(t, rec.Body.Bytes()))
+
+	// the download is counted for the daily file, not the monthly file which contains it
+	assert.Equal(t, map[downloadKey]downloadCount{
+		{date: "2020-03-02", path: "2020/2020-01/2020-01-31-fingerprint-update.jsonl.gz"}: {count: 1, bytes: int64(rec.Body.Len())},
+	}, stats.take())
+}
+
+func TestRangeHandler_SizeMismatch(t *testing.T) {
+	handler := newRangeTestHandler(t)
+	storage := handler.storage.(*memStorage)
+
+	// the file was replaced after the manifest was written
+	storage.WriteFile("2020/2020-02/2020-02-29-fingerprint-update.jsonl.gz", gzipData(t, "{\"id\":2,\"name\":\"replaced\"}\n"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/fingerprint-update?from=2020-02-28&to=2020-03-01", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, rec.Header().Get("Content-Length"), strconv.Itoa(rec.Body.Len()))
+
+	manifest, err := EncodeManifest(&Manifest{Date: "2020-02-28", Files: []ManifestFile{
+		{Name: "2020-02-28-fingerprint-update.jsonl.gz", Size: 1000, Monthly: "2020-02-fingerprint-update.jsonl.gz", Offset: 10},
+	}})
+	require.NoError(t, err)
+	storage.WriteFile("2020/2020-02/2020-02-28-manifest.json", manifest)
+	storage.WriteFile("2020/2020-02/2020-02-fingerprint-update.jsonl.gz", gzipData(t, "{\"id\":1}\n"))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/fingerprint-update?from=2020-02-28&to=2020-03-01", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code, "the data of a rolled up day is not in the monthly file")
+}
+
+func TestRangeHandler_Errors(t *testing.T) {
+	handler := newRangeTestHandler(t)
+
+	tests := []struct {
+		target string
+		status int
+	}{
+		{"/fingerprint-update?from=2020-02-27&to=2020-03-01", http.StatusNotFound},
+		{"/track-update?from=2020-02-28&to=2020-03-01", http.StatusNotFound},
+		{"/fingerprint-update?from=2020-03-01&to=2020-02-28", http.StatusBadRequest},
+		{"/fingerprint-update?from=2020-03-01", http.StatusBadRequest},
+		{"/fingerprint-update?from=2019-01-01&to=2020-03-01", http.StatusBadRequest},
+		{"/meta-update?from=2020-02-28&to=2020-03-01", http.StatusBadRequest},
+		{"/?from=2020-02-28&to=2020-03-01", http.StatusNotFound},
+	}
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", test.target, nil))
+		assert.Equal(t, test.status, rec.Code, test.target)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/meta-update?from=2020-03-01&to=2020-03-01", nil))
+	assert.Equal(t, http.StatusOK, rec.Code, "a single CSV file can be downloaded")
+}
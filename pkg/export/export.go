@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"github.com/jackc/pgx/v4"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/ed25519"
+	"io"
 	"math/rand"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
@@ -18,21 +21,163 @@ import (
 const bufferSize = 1024 * 16
 
 type exporterTableInfo struct {
-	name  string
-	query string
-	delta bool
+	name                string
+	query               string
+	delta               bool
+	hourly              bool
+	fingerprintEncoding string
+	format              string
+}
+
+func (t *exporterTableInfo) fileName(prefix string) string {
+	extension := "." + t.format + ".gz"
+	if t.fingerprintEncoding == FingerprintEncodingCompressed {
+		extension = ".compressed" + extension
+	}
+	return fmt.Sprintf("%s-%s%s", prefix, t.name, extension)
+}
+
+func (t *exporterTableInfo) FileName(startTime time.Time) string {
+	return t.fileName(startTime.Format("2006-01-02"))
+}
+
+const (
+	TableModeDelta = "delta"
+	TableModeFull  = "full"
+)
+
+const (
+	ScheduleDaily  = "daily"
+	ScheduleHourly = "hourly"
+)
+
+// TableConfig overrides settings of a built-in table, or defines a new table if the name is not built-in.
+// Delta tables export rows changed during each day. Full tables export a snapshot of the whole table
+// once a day. Delta tables are also exported hourly, unless disabled globally or by the schedule.
+type TableConfig struct {
+	Query               string
+	Mode                string
+	Schedule            string
+	FingerprintEncoding string
+	Format              string
+}
+
+func (c *TableConfig) Validate() error {
+	switch c.FingerprintEncoding {
+	case "", FingerprintEncodingArray, FingerprintEncodingCompressed:
+	default:
+		return fmt.Errorf("unknown fingerprint encoding %q", c.FingerprintEncoding)
+	}
+	switch c.Mode {
+	case "", TableModeDelta, TableModeFull:
+	default:
+		return fmt.Errorf("unknown mode %q", c.Mode)
+	}
+	switch c.Schedule {
+	case "", ScheduleDaily:
+	case ScheduleHourly:
+		if c.Mode == TableModeFull {
+			return errors.New("full tables can't be exported hourly")
+		}
+	default:
+		return fmt.Errorf("unknown schedule %q", c.Schedule)
+	}
+	if c.Format != "" {
+		return ValidateFormat(c.Format)
+	}
+	return nil
+}
+
+type ExportConfig struct {
+	MaxDays     int
+	Hourly      bool
+	Workers     int
+	MaxAttempts int
+	RetryDelay  time.Duration
+	SigningKey  ed25519.PrivateKey
+	Tables      map[string]TableConfig
 }
 
 type exporter struct {
-	logger  *zap.Logger
-	db      *pgx.Conn
-	storage Storage
-	tables  []exporterTableInfo
-	maxDays int
+	logger      *zap.Logger
+	db          *connPool
+	storage     Storage
+	tables      []exporterTableInfo
+	maxDays     int
+	hourly      bool
+	workers     int
+	maxAttempts int
+	retryDelay  time.Duration
+	signingKey  ed25519.PrivateKey
+	config      map[string]TableConfig
 }
 
 func (ex *exporter) AddTable(name string, query string, delta bool) {
-	ex.tables = append(ex.tables, exporterTableInfo{name: name, query: query, delta: delta})
+	table := exporterTableInfo{name: name, query: query, delta: delta, format: FormatJSONL}
+	schedule := ""
+	if config, exists := ex.config[name]; exists {
+		if config.Query != "" {
+			table.query = config.Query
+		}
+		if config.Mode != "" {
+			table.delta = config.Mode == TableModeDelta
+		}
+		schedule = config.Schedule
+		table.fingerprintEncoding = config.FingerprintEncoding
+		if config.Format != "" {
+			table.format = config.Format
+		}
+	}
+	switch schedule {
+	case ScheduleHourly:
+		table.hourly = true
+	case ScheduleDaily:
+		table.hourly = false
+	default:
+		table.hourly = ex.hourly && table.delta
+	}
+	ex.tables = append(ex.tables, table)
+}
+
+// AddTables adds the built-in tables, followed by the tables defined only in the configuration.
+func (ex *exporter) AddTables() {
+	for _, table := range builtinTables {
+		ex.AddTable(table.name, table.query, table.delta)
+	}
+	var names []string
+	for name := range ex.config {
+		if !isBuiltinTable(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ex.AddTable(name, "", true)
+	}
+}
+
+// ValidateTables checks that query templates of all tables can be rendered and that the queries are valid.
+func (ex *exporter) ValidateTables(ctx context.Context) error {
+	conn, err := ex.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer ex.db.Release(conn)
+
+	endTime := time.Now().Truncate(time.Hour)
+	startTime := endTime.AddDate(0, 0, -1)
+	for i := range ex.tables {
+		table := &ex.tables[i]
+		query, err := ex.RenderQueryTemplate(table.query, startTime, endTime)
+		if err != nil {
+			return fmt.Errorf("invalid query template of table %s: %v", table.name, err)
+		}
+		_, err = conn.Exec(ctx, "EXPLAIN "+query)
+		if err != nil {
+			return fmt.Errorf("invalid query of table %s: %v", table.name, err)
+		}
+	}
+	return nil
 }
 
 func (ex *exporter) RenderQueryTemplate(queryTmpl string, startTime, endTime time.Time) (string, error) {
@@ -60,14 +205,85 @@ func (ex *exporter) makeTempPath(path string) string {
 	return ex.storage.Join(directory, fmt.Sprintf(".%s.%d.tmp", fileName, rand.Int()))
 }
 
-func (ex *exporter) ExportQuery(ctx context.Context, path string, query string) error {
+func copyJSONQuery(query string, stripNulls bool) string {
+	row := "row_to_json(r)"
+	if stripNulls {
+		row = "json_strip_nulls(row_to_json(r))"
+	}
+	// Use CSV format with quote and delimiter characters that can't appear in JSON, to make sure
+	// the JSON data is not escaped by COPY.
+	return fmt.Sprintf("COPY (SELECT %s FROM (%s) r) TO STDOUT WITH (FORMAT csv, QUOTE E'\\x01', DELIMITER E'\\x02')", row, query)
+}
+
+// jsonColumnTypesQuery selects the types whose values are written as JSON numbers, booleans, arrays or objects.
+const jsonColumnTypesQuery = `
+SELECT oid::bigint FROM pg_type
+WHERE oid::bigint = ANY($1) AND (typcategory IN ('A', 'B', 'N') OR typname IN ('json', 'jsonb'))
+`
+
+// QueryColumns returns the names of the columns of a query, and the names of the columns with JSON values.
+func (ex *exporter) QueryColumns(ctx context.Context, conn *pgx.Conn, query string) ([]string, []string, error) {
+	rows, err := conn.Query(ctx, fmt.Sprintf("SELECT * FROM (%s) r LIMIT 0", query))
+	if err != nil {
+		return nil, nil, err
+	}
+	fields := rows.FieldDescriptions()
+	for rows.Next() {
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, nil, rows.Err()
+	}
+
+	var columns []string
+	var types []int64
+	for _, field := range fields {
+		columns = append(columns, string(field.Name))
+		types = append(types, int64(field.DataTypeOID))
+	}
+
+	rows, err = conn.Query(ctx, jsonColumnTypesQuery, types)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	jsonTypes := make(map[int64]bool)
+	for rows.Next() {
+		var oid int64
+		err = rows.Scan(&oid)
+		if err != nil {
+			return nil, nil, err
+		}
+		jsonTypes[oid] = true
+	}
+	if rows.Err() != nil {
+		return nil, nil, rows.Err()
+	}
+
+	var jsonColumns []string
+	for i, column := range columns {
+		if jsonTypes[types[i]] {
+			jsonColumns = append(jsonColumns, column)
+		}
+	}
+	return columns, jsonColumns, nil
+}
+
+func (ex *exporter) ExportQuery(ctx context.Context, path string, query string, table *exporterTableInfo) (*ManifestFile, error) {
 	logger := ex.logger.With(zap.String("path", path))
 
+	conn, err := ex.db.Acquire(ctx)
+	if err != nil {
+		logger.Error("Failed to connect to the database", zap.Error(err))
+		return nil, err
+	}
+	defer ex.db.Release(conn)
+
 	tempPath := ex.makeTempPath(path)
 	file, err := ex.storage.Create(tempPath)
 	if err != nil {
 		logger.Error("Failed to create temporary file", zap.Error(err))
-		return err
+		return nil, err
 	}
 
 	fileClosed := false
@@ -92,52 +308,217 @@ func (ex *exporter) ExportQuery(ctx context.Context, path string, query string)
 
 	defer cleanupFile()
 
-	bufferedFile := bufio.NewWriterSize(file, bufferSize)
+	checksumFile := newChecksumWriter(file)
+	bufferedFile := bufio.NewWriterSize(checksumFile, bufferSize)
 	gzipFile := gzip.NewWriter(bufferedFile)
 
-	copyQuery := fmt.Sprintf("COPY (SELECT json_strip_nulls(row_to_json(r)) FROM (%s) r) TO STDOUT", query)
-	_, err = ex.db.PgConn().CopyTo(ctx, gzipFile, copyQuery)
+	var output io.Writer = gzipFile
+	var transformWriter *rowTransformWriter
+	var jsonColumns []string
+	if table.format != FormatJSONL || table.fingerprintEncoding == FingerprintEncodingCompressed {
+		var rows rowWriter
+		if table.format == FormatJSONL {
+			rows = newJSONRowWriter(gzipFile)
+		} else {
+			var columns []string
+			columns, jsonColumns, err = ex.QueryColumns(ctx, conn, query)
+			if err != nil {
+				logger.Error("Failed to get query columns", zap.Error(err))
+				return nil, err
+			}
+			if table.fingerprintEncoding == FingerprintEncodingCompressed {
+				// compressed fingerprints are strings
+				for i, column := range jsonColumns {
+					if column == "fingerprint" {
+						jsonColumns = append(jsonColumns[:i], jsonColumns[i+1:]...)
+						break
+					}
+				}
+			}
+			rows, err = newCSVRowWriter(gzipFile, formatDelimiter(table.format), columns)
+			if err != nil {
+				logger.Error("Failed to write header", zap.Error(err))
+				return nil, err
+			}
+		}
+		var transform rowTransform
+		if table.fingerprintEncoding == FingerprintEncodingCompressed {
+			transform = compressFingerprintField
+		}
+		transformWriter = newRowTransformWriter(rows, transform)
+		output = transformWriter
+	}
+
+	tag, err := conn.PgConn().CopyTo(ctx, output, copyJSONQuery(query, table.format == FormatJSONL))
 	if err != nil {
 		logger.Error("Failed to export file", zap.Error(err))
-		return err
+		return nil, err
+	}
+
+	if transformWriter != nil {
+		err = transformWriter.Close()
+		if err != nil {
+			logger.Error("Failed to transform rows", zap.Error(err))
+			return nil, err
+		}
 	}
 
 	err = gzipFile.Close()
 	if err != nil {
 		logger.Error("Failed to close gzip file", zap.Error(err))
-		return err
+		return nil, err
 	}
 
 	err = bufferedFile.Flush()
 	if err != nil {
 		logger.Error("Failed to flush buffers", zap.Error(err))
-		return err
+		return nil, err
 	}
 
 	err = file.Close()
 	if err != nil {
 		logger.Error("Failed to close file", zap.Error(err))
-		return err
+		return nil, err
 	}
 	fileClosed = true
 
 	err = ex.storage.Rename(tempPath, path)
 	if err != nil {
 		logger.Error("Failed to rename exported file", zap.String("path", path), zap.Error(err))
-		return err
+		return nil, err
 	}
 	fileRenamed = true
 
+	_, fileName := ex.storage.Split(path)
+	return &ManifestFile{
+		Name:        fileName,
+		Size:        checksumFile.Size(),
+		SHA256:      checksumFile.Sum(),
+		Rows:        tag.RowsAffected(),
+		JSONColumns: jsonColumns,
+	}, nil
+}
+
+func (ex *exporter) WriteFile(path string, data []byte) error {
+	logger := ex.logger.With(zap.String("path", path))
+
+	tempPath := ex.makeTempPath(path)
+	file, err := ex.storage.Create(tempPath)
+	if err != nil {
+		logger.Error("Failed to create temporary file", zap.Error(err))
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		logger.Error("Failed to write file", zap.Error(err))
+		file.Close()
+		ex.storage.Remove(tempPath)
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		logger.Error("Failed to close file", zap.Error(err))
+		ex.storage.Remove(tempPath)
+		return err
+	}
+
+	err = ex.storage.PosixRename(tempPath, path)
+	if err != nil {
+		logger.Error("Failed to rename file", zap.Error(err))
+		ex.storage.Remove(tempPath)
+		return err
+	}
+
 	return nil
 }
 
-func (ex *exporter) ExportTableFull(now time.Time, name string, query string) error {
-	return errors.New("not implemented")
+// WriteManifest writes the manifest and its signature. The signature is written first, so that
+// clients never see a manifest without a valid signature.
+func (ex *exporter) WriteManifest(path string, manifest *Manifest) error {
+	return ex.writeManifest(path, SignatureFileName(path), manifest)
 }
 
-func (ex *exporter) DeleteTempFiles(name string, startTime time.Time) error {
-	fileName := fmt.Sprintf("%s-%s.jsonl.gz", startTime.Format("2006-01-02"), name)
-	directory := ex.storage.Join(startTime.Format("2006"), startTime.Format("2006-01"))
+func (ex *exporter) writeManifest(path string, signaturePath string, manifest *Manifest) error {
+	logger := ex.logger.With(zap.String("path", path))
+
+	data, err := EncodeManifest(manifest)
+	if err != nil {
+		logger.Error("Failed to encode manifest", zap.Error(err))
+		return err
+	}
+
+	if ex.signingKey != nil {
+		signature, err := SignData(ex.signingKey, data)
+		if err != nil {
+			logger.Error("Failed to sign manifest", zap.Error(err))
+			return err
+		}
+		err = ex.WriteFile(signaturePath, signature)
+		if err != nil {
+			logger.Error("Failed to write manifest signature", zap.Error(err))
+			return err
+		}
+	}
+
+	err = ex.WriteFile(path, data)
+	if err != nil {
+		logger.Error("Failed to write manifest", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (ex *exporter) SignFile(path string, checksum string) error {
+	if ex.signingKey == nil {
+		return nil
+	}
+	signature, err := SignChecksum(ex.signingKey, checksum)
+	if err != nil {
+		ex.logger.Error("Failed to sign file", zap.String("path", path), zap.Error(err))
+		return err
+	}
+	return ex.WriteFile(SignatureFileName(path), signature)
+}
+
+// signExistingFile writes the signature of a file if it's missing, e.g. because the file was exported
+// before the signing key was configured, or the export failed before the signature was written.
+func (ex *exporter) signExistingFile(path string) error {
+	if ex.signingKey == nil {
+		return nil
+	}
+	signatureExists, err := CheckFileExists(ex.storage, SignatureFileName(path))
+	if err != nil || signatureExists {
+		return err
+	}
+	file, err := ComputeManifestFile(ex.storage, path)
+	if err != nil {
+		return err
+	}
+	ex.logger.Info("Signing existing file", zap.String("path", path))
+	return ex.SignFile(path, file.SHA256)
+}
+
+// ExportFile exports the file of a table for the given day.
+func (ex *exporter) ExportFile(table *exporterTableInfo, startTime, endTime time.Time) (*ManifestFile, error) {
+	if table.delta {
+		return ex.ExportDeltaFile(table, startTime, endTime)
+	}
+	return ex.ExportFullFile(table, startTime, endTime)
+}
+
+// ExportFullFile exports a snapshot of the whole table, as of the end of the given day.
+func (ex *exporter) ExportFullFile(table *exporterTableInfo, startTime, endTime time.Time) (*ManifestFile, error) {
+	return ex.exportFile(table, ex.dayDirectory(startTime), table.FileName(startTime), startTime, endTime, false, false)
+}
+
+func (ex *exporter) dayDirectory(startTime time.Time) string {
+	return ex.storage.Join(startTime.Format("2006"), startTime.Format("2006-01"))
+}
+
+func (ex *exporter) DeleteTempFiles(directory string, fileName string) error {
 	files, err := ex.storage.ReadDir(directory)
 	if err != nil {
 		ex.logger.Error("Failed to list files", zap.Error(err))
@@ -155,91 +536,334 @@ func (ex *exporter) DeleteTempFiles(name string, startTime time.Time) error {
 	return nil
 }
 
-func (ex *exporter) ExportDeltaFile(name string, queryTmpl string, startTime, endTime time.Time) error {
-	fileName := fmt.Sprintf("%s-%s.jsonl.gz", startTime.Format("2006-01-02"), name)
-	directory := ex.storage.Join(startTime.Format("2006"), startTime.Format("2006-01"))
+func (ex *exporter) ExportDeltaFile(table *exporterTableInfo, startTime, endTime time.Time) (*ManifestFile, error) {
+	exportedFile, err := ex.exportFile(table, ex.dayDirectory(startTime), table.FileName(startTime), startTime, endTime, table.hourly, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.hourly {
+		err = ex.DeleteHourlyFiles(table, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return exportedFile, nil
+}
+
+// exportFile exports a file, unless it already exists. If consolidate is set, the file is created from hourly files
+// if possible. If sortByID is set, rows are sorted by their id, as hourly files need to be for consolidation.
+func (ex *exporter) exportFile(table *exporterTableInfo, directory string, fileName string, startTime, endTime time.Time, consolidate bool, sortByID bool) (*ManifestFile, error) {
 	path := ex.storage.Join(directory, fileName)
 
-	logger := ex.logger.With(zap.String("name", name), zap.String("path", path))
+	logger := ex.logger.With(zap.String("name", table.name), zap.String("path", path))
 	defer logger.Sync()
 
+	var exportedFile *ManifestFile
+
 	fileExists, err := CheckFileExists(ex.storage, path)
 	if err != nil {
 		logger.Error("Failed to check if file exists", zap.Error(err))
-		return err
+		exportFailuresTotal.Inc(table.name)
+		return nil, err
 	}
 	if fileExists {
 		logger.Debug("File already exists")
+		err = ex.signExistingFile(path)
+		if err != nil {
+			logger.Error("Failed to write signature", zap.Error(err))
+			exportFailuresTotal.Inc(table.name)
+			return nil, err
+		}
 	} else {
 		logger.Info("Exporting file")
 
+		exportStartTime := time.Now()
+
 		err = EnsureDirExists(ex.storage, directory)
 		if err != nil {
 			logger.Error("Failed to create parent directory", zap.Error(err))
-			return err
+			exportFailuresTotal.Inc(table.name)
+			return nil, err
+		}
+
+		if consolidate {
+			exportedFile, err = ex.ConsolidateHourlyFiles(table, path, startTime, endTime)
+			if err != nil {
+				logger.Error("Failed to consolidate hourly files", zap.Error(err))
+				exportFailuresTotal.Inc(table.name)
+				return nil, err
+			}
 		}
 
-		query, err := ex.RenderQueryTemplate(queryTmpl, startTime, endTime)
+		if exportedFile == nil {
+			query, err := ex.RenderQueryTemplate(table.query, startTime, endTime)
+			if err != nil {
+				logger.Error("Failed to render query template", zap.Error(err))
+				exportFailuresTotal.Inc(table.name)
+				return nil, err
+			}
+			if sortByID {
+				// rows without an id are sorted last, consolidation falls back to the database for them
+				query = fmt.Sprintf("SELECT * FROM (%s) r ORDER BY to_jsonb(r)->'id'", query)
+			}
+
+			exportedFile, err = ex.ExportQuery(context.Background(), path, query, table)
+			if err != nil {
+				logger.Error("Failed to export file", zap.Error(err))
+				exportFailuresTotal.Inc(table.name)
+				return nil, err
+			}
+		}
+
+		err = ex.SignFile(path, exportedFile.SHA256)
 		if err != nil {
-			logger.Error("Failed to render query template", zap.Error(err))
-			return err
+			logger.Error("Failed to write signature", zap.Error(err))
+			exportFailuresTotal.Inc(table.name)
+			return nil, err
 		}
 
-		err = ex.ExportQuery(context.Background(), path, query)
+		recordExportedFile(table, exportedFile, exportStartTime)
+	}
+
+	err = ex.DeleteTempFiles(directory, fileName)
+	if err != nil {
+		logger.Error("Failed to delete temporary file", zap.Error(err))
+		return nil, err
+	}
+
+	return exportedFile, nil
+}
+
+func (ex *exporter) ExportDay(startTime, endTime time.Time) error {
+	files := make([]*ManifestFile, len(ex.tables))
+	for i := range ex.tables {
+		table := &ex.tables[i]
+		file, err := ex.ExportFile(table, startTime, endTime)
 		if err != nil {
-			logger.Error("Failed to export file", zap.Error(err))
 			return err
 		}
+		files[i] = file
 	}
+	return ex.UpdateManifest(startTime, files)
+}
+
+// UpdateManifest writes the manifest for the given day. The exported files are passed in the same order as
+// tables, nil entries mean that the file already existed and its details need to be read from the previous
+// manifest or from the file itself.
+func (ex *exporter) UpdateManifest(startTime time.Time, exportedFiles []*ManifestFile) error {
+	directory := ex.dayDirectory(startTime)
+	manifestPath := ex.storage.Join(directory, ManifestFileName(startTime))
+
+	logger := ex.logger.With(zap.String("path", manifestPath))
+	defer logger.Sync()
 
-	err = ex.DeleteTempFiles(name, startTime)
+	manifest, changed, err := ex.buildManifest(startTime, exportedFiles)
 	if err != nil {
-		logger.Error("Failed to delete temporary file", zap.Error(err))
 		return err
 	}
 
-	return nil
+	if !changed {
+		logger.Debug("Manifest is up to date")
+		return nil
+	}
+
+	logger.Info("Writing manifest")
+
+	err = ex.WriteManifest(manifestPath, manifest)
+	if err != nil {
+		return err
+	}
+
+	return ex.DeleteTempFiles(directory, ManifestFileName(startTime))
 }
 
-func (ex *exporter) Run() error {
-	now := time.Now()
+// buildManifest builds the manifest for the given day from the exported files and the files listed
+// in the current manifest, and reports whether it differs from the current manifest.
+func (ex *exporter) buildManifest(startTime time.Time, exportedFiles []*ManifestFile) (*Manifest, bool, error) {
+	directory := ex.dayDirectory(startTime)
+	manifestPath := ex.storage.Join(directory, ManifestFileName(startTime))
+
+	logger := ex.logger.With(zap.String("path", manifestPath))
+
+	oldManifest, err := ReadManifest(ex.storage, manifestPath)
+	if err != nil {
+		logger.Error("Failed to read manifest", zap.Error(err))
+		return nil, false, err
+	}
+
+	manifest := &Manifest{Date: startTime.Format("2006-01-02")}
+	changed := oldManifest == nil
+
+	for i := range ex.tables {
+		table := &ex.tables[i]
+		file := exportedFiles[i]
+		if file == nil {
+			fileName := table.FileName(startTime)
+			file = oldManifest.Find(fileName)
+			if file == nil && !table.delta {
+				// full tables are only exported for the last day
+				fileExists, err := CheckFileExists(ex.storage, ex.storage.Join(directory, fileName))
+				if err != nil {
+					logger.Error("Failed to check if file exists", zap.String("file", fileName), zap.Error(err))
+					return nil, false, err
+				}
+				if !fileExists {
+					continue
+				}
+			}
+			if file == nil {
+				file, err = ComputeManifestFile(ex.storage, ex.storage.Join(directory, fileName))
+				if err != nil {
+					logger.Error("Failed to compute manifest entry", zap.String("file", fileName), zap.Error(err))
+					return nil, false, err
+				}
+				changed = true
+			}
+		} else {
+			changed = true
+		}
+		if table.fingerprintEncoding != "" && table.fingerprintEncoding != FingerprintEncodingArray {
+			file.FingerprintEncoding = table.fingerprintEncoding
+		}
+		manifest.Files = append(manifest.Files, *file)
+	}
+
+	return manifest, changed, nil
+}
+
+type dayExport struct {
+	startTime time.Time
+	endTime   time.Time
+	files     []*ManifestFile
+	errors    []error
+}
+
+func (d *dayExport) Failed() bool {
+	for _, err := range d.errors {
+		if err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// exportJobs returns the days which should be exported and the jobs for their files, followed by
+// the jobs for hourly files of the current day.
+func (ex *exporter) exportJobs(now time.Time) ([]*dayExport, []exportJob) {
+	var days []*dayExport
+	var jobs []exportJob
+
 	endTime := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	for i := 0; i < ex.maxDays; i++ {
 		startTime := endTime.AddDate(0, 0, -1)
-		for _, table := range ex.tables {
-			if !table.delta {
+		day := &dayExport{
+			startTime: startTime,
+			endTime:   endTime,
+			files:     make([]*ManifestFile, len(ex.tables)),
+			errors:    make([]error, len(ex.tables)),
+		}
+		days = append(days, day)
+		for j := range ex.tables {
+			j := j
+			table := &ex.tables[j]
+			if !table.delta && i > 0 {
+				// full snapshots can only be exported for the last day
 				continue
 			}
-			err := ex.ExportDeltaFile(table.name, table.query, startTime, endTime)
-			if err != nil {
-				return err
-			}
+			jobs = append(jobs, exportJob{
+				name: ex.storage.Join(ex.dayDirectory(startTime), table.FileName(startTime)),
+				run: func() error {
+					file, err := ex.ExportFile(table, day.startTime, day.endTime)
+					day.files[j], day.errors[j] = file, err
+					return err
+				},
+			})
 		}
 		endTime = startTime
 	}
-	return nil
+
+	// tables can be exported hourly even if hourly exports are disabled globally
+	jobs = append(jobs, ex.hourlyJobs(now)...)
+	return days, jobs
 }
 
-func ExportAll(logger *zap.Logger, sc StorageConfig, databaseConfig *pgx.ConnConfig, maxDays int) error {
+func (ex *exporter) Run() error {
+	now := time.Now()
+	days, jobs := ex.exportJobs(now)
+
+	summary := &ExportSummary{}
+	ex.RunJobs(jobs, summary)
+
+	for _, day := range days {
+		name := ex.storage.Join(ex.dayDirectory(day.startTime), ManifestFileName(day.startTime))
+		if day.Failed() {
+			summary.Failed = append(summary.Failed, ExportFailure{Name: name, Err: errors.New("some files of the day failed to export")})
+			continue
+		}
+		err := ex.retry(name, func() error { return ex.UpdateManifest(day.startTime, day.files) })
+		summary.Add(name, err)
+	}
+
+	summary.Log(ex.logger)
+	err := summary.Err()
+	if err == nil {
+		recordSuccessfulRun(ex.tables)
+	}
+	return err
+}
+
+// withExporter sets up an exporter with all configured tables and calls the function with it.
+func withExporter(logger *zap.Logger, sc StorageConfig, databaseConfig *pgx.ConnConfig, config ExportConfig, fn func(ex *exporter) error) error {
+	for name, tableConfig := range config.Tables {
+		err := tableConfig.Validate()
+		if err == nil && tableConfig.Query == "" && !isBuiltinTable(name) {
+			err = errors.New("missing query")
+		}
+		if err != nil {
+			logger.Error("Invalid table configuration", zap.String("name", name), zap.Error(err))
+			return err
+		}
+	}
+
 	storage, err := NewStorageClient(logger, sc)
 	if err != nil {
 		return err
 	}
 	defer storage.Close()
 
-	db, err := pgx.ConnectConfig(context.Background(), databaseConfig)
+	db := newConnPool(databaseConfig, config.Workers)
+	defer db.Close()
+
+	ex := &exporter{
+		db:          db,
+		storage:     storage,
+		logger:      logger,
+		maxDays:     config.MaxDays,
+		hourly:      config.Hourly,
+		workers:     config.Workers,
+		maxAttempts: config.MaxAttempts,
+		retryDelay:  config.RetryDelay,
+		signingKey:  config.SigningKey,
+		config:      config.Tables,
+	}
+	ex.AddTables()
+
+	err = ex.ValidateTables(context.Background())
 	if err != nil {
+		logger.Error("Invalid table", zap.Error(err))
 		return err
 	}
-	defer db.Close(context.Background())
-
-	ex := &exporter{db: db, storage: storage, logger: logger, maxDays: maxDays}
-	ex.AddTable("fingerprint-update", ExportFingerprintUpdateQuery, true)
-	ex.AddTable("meta-update", ExportMetaUpdateQuery, true)
-	ex.AddTable("track-update", ExportTrackUpdateQuery, true)
-	ex.AddTable("track_fingerprint-update", ExportTrackFingerprintUpdateQuery, true)
-	ex.AddTable("track_mbid-update", ExportTrackMbidUpdateQuery, true)
-	ex.AddTable("track_puid-update", ExportTrackPuidUpdateQuery, true)
-	ex.AddTable("track_meta-update", ExportTrackMetaUpdateQuery, true)
-	return ex.Run()
+
+	// Concurrent runs would delete each other's temporary files.
+	return withExportLock(logger, databaseConfig, func() error {
+		return fn(ex)
+	})
+}
+
+func ExportAll(logger *zap.Logger, sc StorageConfig, databaseConfig *pgx.ConnConfig, config ExportConfig) error {
+	return withExporter(logger, sc, databaseConfig, config, func(ex *exporter) error {
+		return ex.Run()
+	})
 }
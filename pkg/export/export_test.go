@@ -0,0 +1,99 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/acoustid/acoustid/pkg/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTableConfig_Validate(t *testing.T) {
+	valid := []TableConfig{
+		{},
+		{Mode: TableModeFull, Schedule: ScheduleDaily, Format: FormatCSV},
+		{Mode: TableModeDelta, Schedule: ScheduleHourly, FingerprintEncoding: FingerprintEncodingCompressed},
+	}
+	for _, config := range valid {
+		assert.NoError(t, config.Validate(), "%+v", config)
+	}
+	invalid := []TableConfig{
+		{Mode: "incremental"},
+		{Schedule: "weekly"},
+		{Mode: TableModeFull, Schedule: ScheduleHourly},
+		{Format: "xml"},
+	}
+	for _, config := range invalid {
+		assert.Error(t, config.Validate(), "%+v", config)
+	}
+}
+
+func TestAddTables(t *testing.T) {
+	ex := &exporter{
+		hourly: true,
+		config: map[string]TableConfig{
+			"track-update":   {Schedule: ScheduleDaily},
+			"meta-update":    {Format: FormatCSV},
+			"foo-full":       {Query: "SELECT * FROM foo", Mode: TableModeFull},
+			"bar-update":     {Query: "SELECT * FROM bar WHERE created >= '{{.StartTime}}'"},
+			"changes-update": {Query: "SELECT 1"},
+		},
+	}
+	ex.AddTables()
+
+	tables := make(map[string]exporterTableInfo)
+	var names []string
+	for _, table := range ex.tables {
+		tables[table.name] = table
+		names = append(names, table.name)
+	}
+	require.Len(t, names, len(builtinTables)+2)
+	assert.Equal(t, []string{"bar-update", "foo-full"}, names[len(builtinTables):])
+
+	assert.True(t, tables["fingerprint-update"].hourly)
+	assert.False(t, tables["track-update"].hourly)
+	assert.Equal(t, FormatCSV, tables["meta-update"].format)
+	assert.Equal(t, "SELECT 1", tables["changes-update"].query)
+	assert.False(t, tables["foo-full"].delta)
+	assert.False(t, tables["foo-full"].hourly)
+	assert.True(t, tables["bar-update"].delta)
+	assert.True(t, tables["bar-update"].hourly)
+}
+
+func TestExportJobs_HourlySchedule(t *testing.T) {
+	ex := &exporter{
+		storage: newMemStorage(),
+		maxDays: 1,
+		config: map[string]TableConfig{
+			"track-update": {Schedule: ScheduleHourly},
+		},
+	}
+	ex.AddTables()
+
+	now := time.Date(2020, 3, 2, 1, 30, 0, 0, time.UTC)
+	days, jobs := ex.exportJobs(now)
+	require.Len(t, days, 1)
+	var names []string
+	for _, job := range jobs {
+		names = append(names, job.name)
+	}
+	assert.Contains(t, names, "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz")
+	assert.Contains(t, names, "hourly/2020-03-02/2020-03-02-00-track-update.jsonl.gz")
+	assert.NotContains(t, names, "hourly/2020-03-02/2020-03-02-00-fingerprint-update.jsonl.gz", "hourly exports are disabled for other tables")
+}
+
+func TestRun_LastSuccess(t *testing.T) {
+	ex := &exporter{storage: newMemStorage(), logger: zap.NewNop(), workers: 1}
+	ex.tables = []exporterTableInfo{{name: "last-success-update", delta: true, format: FormatJSONL}}
+
+	// nothing to export is a success, the gauge is set even though no file was exported
+	require.NoError(t, ex.Run())
+
+	var buf bytes.Buffer
+	_, err := metrics.DefaultRegistry.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `acoustid_export_last_success_timestamp_seconds{table="last-success-update"}`)
+}
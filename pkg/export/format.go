@@ -0,0 +1,228 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	FormatJSONL = "jsonl"
+	FormatCSV   = "csv"
+	FormatTSV   = "tsv"
+)
+
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatJSONL, FormatCSV, FormatTSV:
+		return nil
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+func formatDelimiter(format string) byte {
+	if format == FormatTSV {
+		return '\t'
+	}
+	return ','
+}
+
+// FormatFromFileName returns the format of an exported file, based on its extension.
+func FormatFromFileName(name string) string {
+	name = strings.TrimSuffix(name, ".gz")
+	switch {
+	case strings.HasSuffix(name, "."+FormatCSV):
+		return FormatCSV
+	case strings.HasSuffix(name, "."+FormatTSV):
+		return FormatTSV
+	}
+	return FormatJSONL
+}
+
+type rowWriter interface {
+	WriteRow(r row) error
+}
+
+type jsonRowWriter struct {
+	w   io.Writer
+	buf []byte
+}
+
+func newJSONRowWriter(w io.Writer) *jsonRowWriter {
+	return &jsonRowWriter{w: w}
+}
+
+func (jw *jsonRowWriter) WriteRow(r row) error {
+	jw.buf = append(r.AppendJSON(jw.buf[:0]), '\n')
+	_, err := jw.w.Write(jw.buf)
+	return err
+}
+
+// csvRowWriter writes rows in the CSV format, using the same conventions as PostgreSQL.
+// NULL values are written as empty unquoted fields, while empty strings are quoted.
+type csvRowWriter struct {
+	w         io.Writer
+	delimiter byte
+	columns   []string
+	buf       []byte
+}
+
+func newCSVRowWriter(w io.Writer, delimiter byte, columns []string) (*csvRowWriter, error) {
+	cw := &csvRowWriter{w: w, delimiter: delimiter, columns: columns}
+	for i, column := range columns {
+		if i > 0 {
+			cw.buf = append(cw.buf, delimiter)
+		}
+		cw.buf = cw.appendField(cw.buf, column)
+	}
+	cw.buf = append(cw.buf, '\n')
+	_, err := cw.w.Write(cw.buf)
+	if err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (cw *csvRowWriter) appendField(buf []byte, value string) []byte {
+	needsQuotes := value == "" || strings.IndexByte(value, cw.delimiter) != -1 || strings.ContainsAny(value, "\"\r\n")
+	if !needsQuotes {
+		return append(buf, value...)
+	}
+	buf = append(buf, '"')
+	buf = append(buf, strings.Replace(value, "\"", "\"\"", -1)...)
+	return append(buf, '"')
+}
+
+func (cw *csvRowWriter) WriteRow(r row) error {
+	cw.buf = cw.buf[:0]
+	for i, column := range cw.columns {
+		if i > 0 {
+			cw.buf = append(cw.buf, cw.delimiter)
+		}
+		value, ok := r.Get(column)
+		if !ok || bytes.Equal(value, []byte("null")) {
+			continue
+		}
+		if len(value) > 0 && value[0] == '"' {
+			var str string
+			err := json.Unmarshal(value, &str)
+			if err != nil {
+				return err
+			}
+			cw.buf = cw.appendField(cw.buf, str)
+		} else {
+			cw.buf = cw.appendField(cw.buf, string(value))
+		}
+	}
+	cw.buf = append(cw.buf, '\n')
+	_, err := cw.w.Write(cw.buf)
+	return err
+}
+
+func countCSVRows(r io.Reader, format string) (int64, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = rune(formatDelimiter(format))
+	reader.ReuseRecord = true
+	var rows int64
+	for {
+		_, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		rows++
+	}
+	if rows > 0 {
+		// header
+		rows--
+	}
+	return rows, nil
+}
+
+// RowReader reads rows of an uncompressed exported file and returns them as JSON objects, regardless of the format.
+// Values in CSV and TSV files are read as strings, except in the JSON columns listed in the manifest entry of
+// the file, where valid JSON values are kept as they are. Empty fields are read as NULL values, since the CSV
+// reader does not distinguish them from quoted empty strings.
+type RowReader struct {
+	scanner     *bufio.Scanner
+	csvReader   *csv.Reader
+	columns     []string
+	jsonColumns map[string]bool
+}
+
+func NewRowReader(r io.Reader, format string, jsonColumns []string) *RowReader {
+	if format == FormatJSONL {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, 64*1024*1024)
+		return &RowReader{scanner: scanner}
+	}
+	csvReader := csv.NewReader(r)
+	csvReader.Comma = rune(formatDelimiter(format))
+	rr := &RowReader{csvReader: csvReader, jsonColumns: make(map[string]bool, len(jsonColumns))}
+	for _, column := range jsonColumns {
+		rr.jsonColumns[column] = true
+	}
+	return rr
+}
+
+// Next returns the next row, or io.EOF if there are no more rows.
+func (rr *RowReader) Next() ([]byte, error) {
+	if rr.scanner != nil {
+		if !rr.scanner.Scan() {
+			if rr.scanner.Err() != nil {
+				return nil, rr.scanner.Err()
+			}
+			return nil, io.EOF
+		}
+		return rr.scanner.Bytes(), nil
+	}
+	r, err := rr.readRow()
+	if err != nil {
+		return nil, err
+	}
+	return r.AppendJSON(nil), nil
+}
+
+func (rr *RowReader) readRow() (row, error) {
+	if rr.scanner != nil {
+		data, err := rr.Next()
+		if err != nil {
+			return nil, err
+		}
+		return parseRow(data)
+	}
+	if rr.columns == nil {
+		columns, err := rr.csvReader.Read()
+		if err != nil {
+			return nil, err
+		}
+		rr.columns = columns
+	}
+	record, err := rr.csvReader.Read()
+	if err != nil {
+		return nil, err
+	}
+	r := make(row, len(rr.columns))
+	for i, column := range rr.columns {
+		r[i].Name = column
+		value := record[i]
+		switch {
+		case value == "":
+			r[i].Value = json.RawMessage("null")
+		case rr.jsonColumns[column] && value[0] != '"' && json.Valid([]byte(value)):
+			r[i].Value = json.RawMessage(value)
+		default:
+			r[i].Value, err = json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r, nil
+}
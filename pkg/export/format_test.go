@@ -0,0 +1,140 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFormatColumns = []string{"id", "name", "fingerprint", "disabled", "created"}
+
+var testFormatRows = []string{
+	`{"id":1,"name":"simple","fingerprint":[1,2,3],"disabled":true,"created":"2020-03-01T10:00:00+00:00"}`,
+	`{"id":2,"name":"with \"quotes\", commas\tand tabs","fingerprint":[],"disabled":false,"created":"2020-03-01T11:00:00+00:00"}`,
+	`{"id":3,"name":"multiple\nlines","fingerprint":null,"disabled":null,"created":"2020-03-01T12:00:00+00:00"}`,
+	`{"id":4,"name":"","fingerprint":[-1],"disabled":null,"created":"2020-03-01T13:00:00+00:00"}`,
+	`{"id":5,"name":null,"fingerprint":null,"disabled":null,"created":"2020-03-01T14:00:00+00:00"}`,
+	`{"id":6,"name":"1999","fingerprint":[],"disabled":false,"created":"2020-03-01T15:00:00+00:00"}`,
+}
+
+func exportTestRows(t *testing.T, format string) string {
+	var buf bytes.Buffer
+	var w rowWriter
+	if format == FormatJSONL {
+		w = newJSONRowWriter(&buf)
+	} else {
+		var err error
+		w, err = newCSVRowWriter(&buf, formatDelimiter(format), testFormatColumns)
+		require.NoError(t, err)
+	}
+	tw := newRowTransformWriter(w, nil)
+	_, err := tw.Write([]byte(strings.Join(testFormatRows, "\n") + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	return buf.String()
+}
+
+// parseJSONLRows converts the exported rows to a common representation, with NULL values represented by nil.
+func parseJSONLRows(t *testing.T, data string) [][]*string {
+	var result [][]*string
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\n"), "\n") {
+		r, err := parseRow([]byte(line))
+		require.NoError(t, err)
+		var values []*string
+		for _, column := range testFormatColumns {
+			value, ok := r.Get(column)
+			if !ok || string(value) == "null" {
+				values = append(values, nil)
+				continue
+			}
+			str := string(value)
+			if value[0] == '"' {
+				require.NoError(t, json.Unmarshal(value, &str))
+			}
+			values = append(values, &str)
+		}
+		result = append(result, values)
+	}
+	return result
+}
+
+func parseCSVRows(t *testing.T, data string, delimiter byte) [][]*string {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comma = rune(delimiter)
+	records, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+	assert.Equal(t, testFormatColumns, records[0])
+	var result [][]*string
+	for _, record := range records[1:] {
+		var values []*string
+		for i := range record {
+			value := record[i]
+			values = append(values, &value)
+		}
+		result = append(result, values)
+	}
+	return result
+}
+
+func TestFormats_SameContent(t *testing.T) {
+	expected := parseJSONLRows(t, exportTestRows(t, FormatJSONL))
+	require.Len(t, expected, len(testFormatRows))
+
+	for _, format := range []string{FormatCSV, FormatTSV} {
+		t.Run(format, func(t *testing.T) {
+			actual := parseCSVRows(t, exportTestRows(t, format), formatDelimiter(format))
+			require.Len(t, actual, len(expected))
+			for i := range expected {
+				for j := range expected[i] {
+					// encoding/csv can't distinguish NULL from empty strings, that is checked separately
+					if expected[i][j] == nil {
+						assert.Equal(t, "", *actual[i][j], "row %d, column %s", i, testFormatColumns[j])
+					} else {
+						assert.Equal(t, *expected[i][j], *actual[i][j], "row %d, column %s", i, testFormatColumns[j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFormats_JSONL(t *testing.T) {
+	assert.Equal(t, strings.Join(testFormatRows, "\n")+"\n", exportTestRows(t, FormatJSONL))
+}
+
+func TestFormats_CSV(t *testing.T) {
+	lines := strings.SplitAfter(exportTestRows(t, FormatCSV), "\n")
+	assert.Equal(t, "id,name,fingerprint,disabled,created\n", lines[0])
+	assert.Equal(t, "1,simple,\"[1,2,3]\",true,2020-03-01T10:00:00+00:00\n", lines[1])
+	assert.Equal(t, "2,\"with \"\"quotes\"\", commas\tand tabs\",[],false,2020-03-01T11:00:00+00:00\n", lines[2])
+	assert.Equal(t, "3,\"multiple\n", lines[3])
+	assert.Equal(t, "lines\",,,2020-03-01T12:00:00+00:00\n", lines[4])
+	assert.Equal(t, "4,\"\",[-1],,2020-03-01T13:00:00+00:00\n", lines[5])
+	assert.Equal(t, "5,,,,2020-03-01T14:00:00+00:00\n", lines[6])
+}
+
+func TestFormats_TSV(t *testing.T) {
+	lines := strings.SplitAfter(exportTestRows(t, FormatTSV), "\n")
+	assert.Equal(t, "id\tname\tfingerprint\tdisabled\tcreated\n", lines[0])
+	assert.Equal(t, "1\tsimple\t[1,2,3]\ttrue\t2020-03-01T10:00:00+00:00\n", lines[1])
+	assert.Equal(t, "2\t\"with \"\"quotes\"\", commas\tand tabs\"\t[]\tfalse\t2020-03-01T11:00:00+00:00\n", lines[2])
+	assert.Equal(t, "4\t\"\"\t[-1]\t\t2020-03-01T13:00:00+00:00\n", lines[5])
+}
+
+func TestCountCSVRows(t *testing.T) {
+	rows, err := countCSVRows(strings.NewReader(exportTestRows(t, FormatCSV)), FormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(testFormatRows)), rows)
+}
+
+func TestFormatFromFileName(t *testing.T) {
+	assert.Equal(t, FormatJSONL, FormatFromFileName("2020-03-01-fingerprint-update.jsonl.gz"))
+	assert.Equal(t, FormatCSV, FormatFromFileName("2020-03-01-fingerprint-update.compressed.csv.gz"))
+	assert.Equal(t, FormatTSV, FormatFromFileName("2020-03-01-meta-update.tsv.gz"))
+}
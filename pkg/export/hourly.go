@@ -0,0 +1,350 @@
+package export
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"strconv"
+	"time"
+)
+
+const hourlyDirectoryName = "hourly"
+
+// HourlyFileName returns the name of the hourly file. The hour is in UTC, so that the hour repeated
+// at the end of daylight saving time gets its own file.
+func (t *exporterTableInfo) HourlyFileName(startTime time.Time) string {
+	return t.fileName(startTime.UTC().Format("2006-01-02-15"))
+}
+
+func (ex *exporter) hourlyDirectory(startTime time.Time) string {
+	return ex.storage.Join(hourlyDirectoryName, startTime.Format("2006-01-02"))
+}
+
+func (ex *exporter) ExportHourlyFile(table *exporterTableInfo, startTime time.Time) (*ManifestFile, error) {
+	endTime := startTime.Add(time.Hour)
+	return ex.exportFile(table, ex.hourlyDirectory(startTime), table.HourlyFileName(startTime), startTime, endTime, false, true)
+}
+
+// hourlyJobs returns jobs for exporting hourly delta files for all completed hours of the current day.
+func (ex *exporter) hourlyJobs(now time.Time) []exportJob {
+	var jobs []exportJob
+	dayStartTime := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for startTime := dayStartTime; !startTime.Add(time.Hour).After(now); startTime = startTime.Add(time.Hour) {
+		for i := range ex.tables {
+			table := &ex.tables[i]
+			if !table.hourly {
+				continue
+			}
+			startTime := startTime
+			jobs = append(jobs, exportJob{
+				name: ex.storage.Join(ex.hourlyDirectory(startTime), table.HourlyFileName(startTime)),
+				run: func() error {
+					_, err := ex.ExportHourlyFile(table, startTime)
+					return err
+				},
+			})
+		}
+	}
+	return jobs
+}
+
+func (ex *exporter) listHourlyFiles(table *exporterTableInfo, startTime, endTime time.Time) (existing, missing []time.Time, err error) {
+	directory := ex.hourlyDirectory(startTime)
+	files, err := ex.storage.ReadDir(directory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	fileNames := make(map[string]bool, len(files))
+	for _, file := range files {
+		fileNames[file.Name()] = true
+	}
+	for hourStartTime := startTime; hourStartTime.Before(endTime); hourStartTime = hourStartTime.Add(time.Hour) {
+		if fileNames[table.HourlyFileName(hourStartTime)] {
+			existing = append(existing, hourStartTime)
+		} else {
+			missing = append(missing, hourStartTime)
+		}
+	}
+	return existing, missing, nil
+}
+
+var errHourlyFileNotSorted = errors.New("hourly file is not sorted by id")
+
+// hourlyFileReader reads the rows of an hourly file, which are sorted by id.
+type hourlyFileReader struct {
+	file     StorageFile
+	gzipFile *gzip.Reader
+	reader   *bufio.Reader
+	line     []byte
+	id       int64
+	done     bool
+}
+
+func (ex *exporter) openHourlyFile(path string) (*hourlyFileReader, error) {
+	file, err := ex.storage.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	gzipFile, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	r := &hourlyFileReader{file: file, gzipFile: gzipFile, reader: bufio.NewReaderSize(gzipFile, bufferSize)}
+	err = r.next()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// next reads the next row. Returns errHourlyFileNotSorted if the row doesn't have a greater integer id than the previous one.
+func (r *hourlyFileReader) next() error {
+	line, err := r.reader.ReadBytes('\n')
+	if err == io.EOF && len(line) == 0 {
+		r.line = nil
+		r.done = true
+		return nil
+	}
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if line[len(line)-1] != '\n' {
+		line = append(line, '\n')
+	}
+	id, err := strconv.ParseInt(rowID(line), 10, 64)
+	if err != nil || (r.line != nil && id <= r.id) {
+		return errHourlyFileNotSorted
+	}
+	r.line = line
+	r.id = id
+	return nil
+}
+
+func (r *hourlyFileReader) Close() error {
+	r.gzipFile.Close()
+	return r.file.Close()
+}
+
+// mergeHourlyFiles writes the rows of hourly files sorted by id, and returns the number of rows. Records which were
+// updated in several hours are only written once, in the version from the last hour.
+func mergeHourlyFiles(w io.Writer, readers []*hourlyFileReader) (int64, error) {
+	var rows int64
+	for {
+		var last *hourlyFileReader
+		for _, r := range readers {
+			if !r.done && (last == nil || r.id <= last.id) {
+				last = r
+			}
+		}
+		if last == nil {
+			return rows, nil
+		}
+		_, err := w.Write(last.line)
+		if err != nil {
+			return rows, err
+		}
+		rows++
+		id := last.id
+		for _, r := range readers {
+			if !r.done && r.id == id {
+				err = r.next()
+				if err != nil {
+					return rows, err
+				}
+			}
+		}
+	}
+}
+
+// rowID returns the id of a JSON row, or an empty string if it has none.
+func rowID(line []byte) string {
+	var row struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if json.Unmarshal(line, &row) != nil {
+		return ""
+	}
+	return string(row.ID)
+}
+
+// ConsolidateHourlyFiles creates a daily file from the hourly files of the day. Records which were updated
+// in several hours are only included once, in their last version, like in a daily file exported from the database.
+// The hourly files are sorted by id, so they are merged without keeping the ids of the whole day in memory.
+// Returns nil if there are no hourly files for the day, or they are not sorted, and the daily file needs to be
+// exported from the database.
+func (ex *exporter) ConsolidateHourlyFiles(table *exporterTableInfo, path string, startTime, endTime time.Time) (*ManifestFile, error) {
+	if table.format != FormatJSONL {
+		// CSV and TSV files have headers, so they can't be simply concatenated
+		return nil, nil
+	}
+
+	logger := ex.logger.With(zap.String("name", table.name), zap.String("path", path))
+
+	existing, missing, err := ex.listHourlyFiles(table, startTime, endTime)
+	if err != nil {
+		logger.Error("Failed to list hourly files", zap.Error(err))
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, nil
+	}
+
+	for _, hourStartTime := range missing {
+		_, err := ex.ExportHourlyFile(table, hourStartTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Info("Consolidating hourly files")
+
+	var hourlyPaths []string
+	for hourStartTime := startTime; hourStartTime.Before(endTime); hourStartTime = hourStartTime.Add(time.Hour) {
+		hourlyPaths = append(hourlyPaths, ex.storage.Join(ex.hourlyDirectory(hourStartTime), table.HourlyFileName(hourStartTime)))
+	}
+
+	var readers []*hourlyFileReader
+	defer func() {
+		for _, r := range readers {
+			r.Close()
+		}
+	}()
+	for _, hourlyPath := range hourlyPaths {
+		r, err := ex.openHourlyFile(hourlyPath)
+		if err == errHourlyFileNotSorted {
+			logger.Info("Hourly file is not sorted by id, exporting the daily file from the database", zap.String("hourly_path", hourlyPath))
+			return nil, nil
+		}
+		if err != nil {
+			logger.Error("Failed to read hourly file", zap.String("hourly_path", hourlyPath), zap.Error(err))
+			return nil, err
+		}
+		readers = append(readers, r)
+	}
+
+	tempPath := ex.makeTempPath(path)
+	file, err := ex.storage.Create(tempPath)
+	if err != nil {
+		logger.Error("Failed to create temporary file", zap.Error(err))
+		return nil, err
+	}
+
+	checksumFile := newChecksumWriter(file)
+	bufferedFile := bufio.NewWriterSize(checksumFile, bufferSize)
+	gzipFile := gzip.NewWriter(bufferedFile)
+	rows, err := mergeHourlyFiles(gzipFile, readers)
+	if err == errHourlyFileNotSorted {
+		logger.Info("Hourly files are not sorted by id, exporting the daily file from the database")
+		file.Close()
+		ex.storage.Remove(tempPath)
+		return nil, nil
+	}
+	if err != nil {
+		logger.Error("Failed to merge hourly files", zap.Error(err))
+		file.Close()
+		ex.storage.Remove(tempPath)
+		return nil, err
+	}
+
+	err = gzipFile.Close()
+	if err == nil {
+		err = bufferedFile.Flush()
+	}
+	if err != nil {
+		logger.Error("Failed to write file", zap.Error(err))
+		file.Close()
+		ex.storage.Remove(tempPath)
+		return nil, err
+	}
+
+	err = file.Close()
+	if err != nil {
+		logger.Error("Failed to close file", zap.Error(err))
+		ex.storage.Remove(tempPath)
+		return nil, err
+	}
+
+	err = ex.storage.Rename(tempPath, path)
+	if err != nil {
+		logger.Error("Failed to rename consolidated file", zap.Error(err))
+		ex.storage.Remove(tempPath)
+		return nil, err
+	}
+
+	_, fileName := ex.storage.Split(path)
+	return &ManifestFile{
+		Name:   fileName,
+		Size:   checksumFile.Size(),
+		SHA256: checksumFile.Sum(),
+		Rows:   rows,
+	}, nil
+}
+
+// appendGzipFileRange copies the raw gzip data of size bytes starting at offset, or of the whole file
+// if size is negative, to the writer and returns the number of lines in the data.
+func (ex *exporter) appendGzipFileRange(w io.Writer, path string, offset, size int64) (int64, error) {
+	var file io.ReadCloser
+	var err error
+	if size < 0 {
+		file, err = ex.storage.Open(path)
+	} else {
+		file, err = openFileRange(ex.storage, path, offset, size)
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	gzipFile, err := gzip.NewReader(io.TeeReader(file, w))
+	if err != nil {
+		return 0, err
+	}
+	return countLines(gzipFile)
+}
+
+func (ex *exporter) DeleteHourlyFiles(table *exporterTableInfo, startTime, endTime time.Time) error {
+	directory := ex.hourlyDirectory(startTime)
+	existing, _, err := ex.listHourlyFiles(table, startTime, endTime)
+	if err != nil {
+		ex.logger.Error("Failed to list hourly files", zap.String("directory", directory), zap.Error(err))
+		return err
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+
+	for _, hourStartTime := range existing {
+		path := ex.storage.Join(directory, table.HourlyFileName(hourStartTime))
+		for _, p := range []string{SignatureFileName(path), path} {
+			err := ex.storage.Remove(p)
+			if err != nil && !os.IsNotExist(err) {
+				ex.logger.Error("Failed to delete hourly file", zap.String("path", p), zap.Error(err))
+				return err
+			}
+		}
+	}
+
+	files, err := ex.storage.ReadDir(directory)
+	if err != nil {
+		ex.logger.Error("Failed to list hourly files", zap.String("directory", directory), zap.Error(err))
+		return err
+	}
+	if len(files) == 0 {
+		err = ex.storage.Remove(directory)
+		if err != nil {
+			ex.logger.Error("Failed to delete hourly directory", zap.String("directory", directory), zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
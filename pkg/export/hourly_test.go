@@ -0,0 +1,143 @@
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func gunzipData(t *testing.T, data []byte) string {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestConsolidateHourlyFiles(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop(), hourly: true}
+	table := &exporterTableInfo{name: "track-update", delta: true, format: FormatJSONL}
+
+	startTime := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(0, 0, 1)
+
+	var expected string
+	for i := 0; i < 24; i++ {
+		hourStartTime := startTime.Add(time.Duration(i) * time.Hour)
+		line := fmt.Sprintf("{\"id\":%d}\n", i)
+		expected += line
+		storage.WriteFile(ex.storage.Join(ex.hourlyDirectory(hourStartTime), table.HourlyFileName(hourStartTime)), gzipData(t, line))
+	}
+	storage.WriteFile("hourly/2020-03-01/2020-03-01-00-meta-update.jsonl.gz", gzipData(t, "{}\n"))
+
+	path := ex.storage.Join(ex.dayDirectory(startTime), table.FileName(startTime))
+	require.NoError(t, storage.MkdirAll(ex.dayDirectory(startTime)))
+
+	file, err := ex.ConsolidateHourlyFiles(table, path, startTime, endTime)
+	require.NoError(t, err)
+	require.NotNil(t, file)
+	assert.Equal(t, "2020-03-01-track-update.jsonl.gz", file.Name)
+	assert.Equal(t, int64(24), file.Rows)
+
+	data, ok := storage.ReadFile(path)
+	require.True(t, ok)
+	assert.Equal(t, int64(len(data)), file.Size)
+	assert.Equal(t, expected, gunzipData(t, data))
+
+	computed, err := ComputeManifestFile(storage, path)
+	require.NoError(t, err)
+	assert.Equal(t, file, computed)
+
+	require.NoError(t, ex.DeleteHourlyFiles(table, startTime, endTime))
+	assert.Equal(t, []string{"2020/2020-03/2020-03-01-track-update.jsonl.gz", "hourly/2020-03-01/2020-03-01-00-meta-update.jsonl.gz"}, storage.Paths())
+}
+
+func TestConsolidateHourlyFiles_Duplicates(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop(), hourly: true}
+	table := &exporterTableInfo{name: "track-update", delta: true, format: FormatJSONL}
+
+	startTime := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(0, 0, 1)
+	hourlyFiles := map[int]string{
+		0: "{\"id\":1,\"new_id\":null}\n{\"id\":2,\"new_id\":null}\n",
+		5: "{\"id\":1,\"new_id\":3}\n{\"id\":3,\"new_id\":null}\n",
+		9: "{\"id\":1,\"new_id\":4}\n",
+	}
+	for i := 0; i < 24; i++ {
+		hourStartTime := startTime.Add(time.Duration(i) * time.Hour)
+		storage.WriteFile(ex.storage.Join(ex.hourlyDirectory(hourStartTime), table.HourlyFileName(hourStartTime)), gzipData(t, hourlyFiles[i]))
+	}
+
+	path := ex.storage.Join(ex.dayDirectory(startTime), table.FileName(startTime))
+	require.NoError(t, storage.MkdirAll(ex.dayDirectory(startTime)))
+	file, err := ex.ConsolidateHourlyFiles(table, path, startTime, endTime)
+	require.NoError(t, err)
+	require.NotNil(t, file)
+	assert.Equal(t, int64(3), file.Rows)
+
+	data, ok := storage.ReadFile(path)
+	require.True(t, ok)
+	assert.Equal(t, "{\"id\":1,\"new_id\":4}\n{\"id\":2,\"new_id\":null}\n{\"id\":3,\"new_id\":null}\n", gunzipData(t, data))
+
+	computed, err := ComputeManifestFile(storage, path)
+	require.NoError(t, err)
+	assert.Equal(t, file, computed)
+}
+
+func TestConsolidateHourlyFiles_NotSorted(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop(), hourly: true}
+	table := &exporterTableInfo{name: "track-update", delta: true, format: FormatJSONL}
+
+	startTime := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(0, 0, 1)
+	for i := 0; i < 24; i++ {
+		hourStartTime := startTime.Add(time.Duration(i) * time.Hour)
+		data := ""
+		if i == 5 {
+			data = "{\"id\":2}\n{\"id\":1}\n"
+		}
+		storage.WriteFile(ex.storage.Join(ex.hourlyDirectory(hourStartTime), table.HourlyFileName(hourStartTime)), gzipData(t, data))
+	}
+
+	path := ex.storage.Join(ex.dayDirectory(startTime), table.FileName(startTime))
+	require.NoError(t, storage.MkdirAll(ex.dayDirectory(startTime)))
+	file, err := ex.ConsolidateHourlyFiles(table, path, startTime, endTime)
+	require.NoError(t, err)
+	assert.Nil(t, file)
+	_, ok := storage.ReadFile(path)
+	assert.False(t, ok)
+}
+
+func TestHourlyFileName_DST(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Prague")
+	require.NoError(t, err)
+	table := &exporterTableInfo{name: "track-update", format: FormatJSONL}
+
+	// the hour from 2:00 to 3:00 is repeated when daylight saving time ends
+	startTime := time.Date(2020, 10, 25, 0, 0, 0, 0, time.UTC).In(location)
+	require.Equal(t, startTime.Format("15"), startTime.Add(time.Hour).Format("15"))
+	assert.Equal(t, "2020-10-25-00-track-update.jsonl.gz", table.HourlyFileName(startTime))
+	assert.Equal(t, "2020-10-25-01-track-update.jsonl.gz", table.HourlyFileName(startTime.Add(time.Hour)))
+}
+
+func TestConsolidateHourlyFiles_NoHourlyFiles(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop(), hourly: true}
+	table := &exporterTableInfo{name: "track-update", delta: true, format: FormatJSONL}
+
+	startTime := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	file, err := ex.ConsolidateHourlyFiles(table, "2020/2020-03/2020-03-01-track-update.jsonl.gz", startTime, startTime.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Nil(t, file)
+}
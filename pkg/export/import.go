@@ -0,0 +1,542 @@
+package export
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// ImportSource provides read access to published data files.
+type ImportSource interface {
+	Open(path string) (io.ReadCloser, error)
+	// OpenRange opens size bytes of a file, starting at offset.
+	OpenRange(path string, offset, size int64) (io.ReadCloser, error)
+}
+
+type storageImportSource struct {
+	storage Storage
+}
+
+func NewStorageImportSource(storage Storage) ImportSource {
+	return &storageImportSource{storage: storage}
+}
+
+func (s *storageImportSource) Open(path string) (io.ReadCloser, error) {
+	return s.storage.Open(path)
+}
+
+func (s *storageImportSource) OpenRange(path string, offset, size int64) (io.ReadCloser, error) {
+	return openFileRange(s.storage, path, offset, size)
+}
+
+type httpImportSource struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// Each data file is downloaded in a single request, so the timeout needs to allow for large files
+// on slow connections, it only stops imports from a source which stalled.
+const httpImportTimeout = 30 * time.Minute
+
+// NewHTTPImportSource returns a source reading data files from the data proxy. The API key is optional.
+func NewHTTPImportSource(baseURL string, apiKey string) ImportSource {
+	return &httpImportSource{baseURL: strings.TrimSuffix(baseURL, "/"), apiKey: apiKey, client: &http.Client{Timeout: httpImportTimeout}}
+}
+
+func (s *httpImportSource) get(path string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, s.baseURL+"/"+strings.TrimPrefix(path, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, os.ErrNotExist
+	}
+	resp.Body.Close()
+	return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, path)
+}
+
+func (s *httpImportSource) Open(path string) (io.ReadCloser, error) {
+	resp, err := s.get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *httpImportSource) OpenRange(path string, offset, size int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+size-1))
+	resp, err := s.get(path, header)
+	if err != nil {
+		return nil, err
+	}
+	expected := fmt.Sprintf("bytes %d-%d/", offset, offset+size-1)
+	if resp.StatusCode != http.StatusPartialContent || !strings.HasPrefix(resp.Header.Get("Content-Range"), expected) {
+		resp.Body.Close()
+		return nil, fmt.Errorf("server didn't return the requested range of %s", path)
+	}
+	return resp.Body, nil
+}
+
+// importFileTables maps exported files to the database tables they are imported into.
+var importFileTables = map[string]string{
+	"track-update":             "track",
+	"meta-update":              "meta",
+	"fingerprint-update":       "fingerprint",
+	"track_fingerprint-update": "fingerprint",
+	"track_mbid-update":        "track_mbid",
+	"track_puid-update":        "track_puid",
+	"track_meta-update":        "track_meta",
+	"foreignid_vendor-update":  "foreignid_vendor",
+	"foreignid-update":         "foreignid",
+	"track_foreignid-update":   "track_foreignid",
+	"changes-update":           "change_log",
+}
+
+// importTableOrder lists the tables in the order they need to be updated to satisfy foreign keys.
+var importTableOrder = []string{"track", "meta", "fingerprint", "track_mbid", "track_puid", "track_meta", "foreignid_vendor", "foreignid", "track_foreignid"}
+
+// importDeleteTables lists the tables in which rows can be deleted by change events.
+var importDeleteTables = map[string]bool{
+	"track":           true,
+	"fingerprint":     true,
+	"track_mbid":      true,
+	"track_puid":      true,
+	"track_meta":      true,
+	"track_foreignid": true,
+}
+
+const createImportLogTableQuery = `
+CREATE TABLE IF NOT EXISTS data_import_log (
+    day date NOT NULL PRIMARY KEY,
+    imported timestamp with time zone DEFAULT now() NOT NULL
+)
+`
+
+const createImportStagingTableQuery = `
+CREATE TEMPORARY TABLE import_staging (
+    seq bigserial NOT NULL,
+    table_name text NOT NULL,
+    data jsonb NOT NULL
+) ON COMMIT DROP
+`
+
+const copyImportStagingQuery = `COPY import_staging (table_name, data) FROM STDIN WITH (FORMAT csv, QUOTE E'\x01', DELIMITER E'\x02')`
+
+const tableColumnsQuery = `
+SELECT a.attname, coalesce(pg_get_expr(d.adbin, d.adrelid), '')
+FROM pg_attribute a
+LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum
+`
+
+// ExportFileTable returns the name of the exported table from the name of a daily file.
+func ExportFileTable(name string) string {
+	if len(name) > 11 && name[10] == '-' {
+		name = name[11:]
+	}
+	if i := strings.IndexByte(name, '.'); i != -1 {
+		name = name[:i]
+	}
+	return name
+}
+
+type ImportConfig struct {
+	// First day to import, defaults to the day after the last imported day.
+	From time.Time
+	// Last day to import, defaults to yesterday.
+	To time.Time
+}
+
+type importer struct {
+	logger *zap.Logger
+	source ImportSource
+	conn   *pgx.Conn
+}
+
+func (im *importer) lastImportedDay(ctx context.Context) (time.Time, bool, error) {
+	var day *time.Time
+	err := im.conn.QueryRow(ctx, "SELECT max(day) FROM data_import_log").Scan(&day)
+	if err != nil || day == nil {
+		return time.Time{}, false, err
+	}
+	return *day, true, nil
+}
+
+func (im *importer) isDayImported(ctx context.Context, day time.Time) (bool, error) {
+	var imported bool
+	err := im.conn.QueryRow(ctx, "SELECT exists(SELECT 1 FROM data_import_log WHERE day = $1)", day.Format("2006-01-02")).Scan(&imported)
+	return imported, err
+}
+
+func (im *importer) readManifest(day time.Time) (*Manifest, error) {
+	file, err := im.source.Open(dayDirectoryPath(day) + "/" + ManifestFileName(day))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	var manifest Manifest
+	err = json.NewDecoder(file).Decode(&manifest)
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// dayDirectoryPath returns the path of the directory with daily files, relative to the storage root.
+func dayDirectoryPath(day time.Time) string {
+	return day.Format("2006") + "/" + day.Format("2006-01")
+}
+
+// ImportDay applies all files of one day in a single transaction.
+func (im *importer) ImportDay(ctx context.Context, day time.Time, manifest *Manifest) error {
+	tx, err := im.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, createImportStagingTableQuery)
+	if err != nil {
+		return err
+	}
+
+	for i := range manifest.Files {
+		file := &manifest.Files[i]
+		table, ok := importFileTables[ExportFileTable(file.Name)]
+		if !ok {
+			im.logger.Warn("Skipping unknown file", zap.String("name", file.Name))
+			continue
+		}
+		err = im.stageFile(ctx, tx, dayDirectoryPath(day), file, table)
+		if err != nil {
+			im.logger.Error("Failed to load file", zap.String("name", file.Name), zap.Error(err))
+			return err
+		}
+	}
+
+	for _, table := range importTableOrder {
+		err = im.applyTable(ctx, tx, table)
+		if err != nil {
+			im.logger.Error("Failed to update table", zap.String("table", table), zap.Error(err))
+			return err
+		}
+	}
+
+	err = im.applyChanges(ctx, tx)
+	if err != nil {
+		im.logger.Error("Failed to apply changes", zap.Error(err))
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO data_import_log (day) VALUES ($1)", day.Format("2006-01-02"))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// stageFile downloads a data file, verifies its checksum and loads the rows into the staging table.
+// Files which were rolled up are read from their part of the monthly file.
+func (im *importer) stageFile(ctx context.Context, tx pgx.Tx, directory string, file *ManifestFile, table string) error {
+	var reader io.ReadCloser
+	var err error
+	if file.Monthly != "" {
+		reader, err = im.source.OpenRange(directory+"/"+file.Monthly, file.Offset, file.Size)
+	} else {
+		reader, err = im.source.Open(directory + "/" + file.Name)
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	pr, pw := io.Pipe()
+	go func() {
+		checksum := newChecksumWriter(ioutil.Discard)
+		err := writeImportRows(pw, io.TeeReader(reader, checksum), table, file)
+		if err == nil {
+			_, err = io.Copy(ioutil.Discard, io.TeeReader(reader, checksum))
+		}
+		if err == nil && (checksum.Size() != file.Size || checksum.Sum() != file.SHA256) {
+			err = fmt.Errorf("checksum mismatch for %s", file.Name)
+		}
+		pw.CloseWithError(err)
+	}()
+
+	_, err = tx.Conn().PgConn().CopyFrom(ctx, pr, copyImportStagingQuery)
+	pr.Close()
+	return err
+}
+
+// writeImportRows reads a gzipped data file and writes its rows as lines for the staging table.
+func writeImportRows(w io.Writer, r io.Reader, table string, file *ManifestFile) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	var transform rowTransform
+	if file.FingerprintEncoding == FingerprintEncodingCompressed {
+		transform = decompressFingerprintField
+	}
+
+	bw := bufio.NewWriter(w)
+	var buf []byte
+	writeRow := func(r row) error {
+		if transform != nil {
+			err := transform(r)
+			if err != nil {
+				return err
+			}
+		}
+		buf = append(buf[:0], table...)
+		buf = append(buf, '\x02')
+		buf = append(r.AppendJSON(buf), '\n')
+		_, err := bw.Write(buf)
+		return err
+	}
+
+	rows := NewRowReader(gzipReader, FormatFromFileName(file.Name), file.JSONColumns)
+	for {
+		r, err := rows.readRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		err = writeRow(r)
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+type tableColumn struct {
+	name         string
+	defaultValue string
+}
+
+func (im *importer) tableColumns(ctx context.Context, tx pgx.Tx, table string) ([]tableColumn, error) {
+	rows, err := tx.Query(ctx, tableColumnsQuery, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var columns []tableColumn
+	for rows.Next() {
+		var column tableColumn
+		err = rows.Scan(&column.name, &column.defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	return columns, rows.Err()
+}
+
+// renderApplyQueries returns queries that update existing rows and insert new rows from the staging table.
+// Rows of the same table from multiple files are merged by id. Existing rows only get the columns present in
+// the data files updated, while columns missing in new rows get their default values.
+func renderApplyQueries(table string, columns []tableColumn) (string, string) {
+	tableName := pgx.Identifier{table}.Sanitize()
+	staged := fmt.Sprintf(`WITH staged AS (
+    SELECT (s.data->>'id')::bigint AS id, jsonb_object_agg(e.key, e.value ORDER BY s.seq) AS data
+    FROM import_staging s, jsonb_each(s.data) e
+    WHERE s.table_name = '%s'
+    GROUP BY 1
+)
+`, table)
+
+	var updateColumns, insertColumns, insertValues []string
+	for _, column := range columns {
+		name := pgx.Identifier{column.name}.Sanitize()
+		insertColumns = append(insertColumns, name)
+		if column.defaultValue != "" {
+			insertValues = append(insertValues, fmt.Sprintf("coalesce(r.%s, %s)", name, column.defaultValue))
+		} else {
+			insertValues = append(insertValues, "r."+name)
+		}
+		if column.name != "id" {
+			updateColumns = append(updateColumns, fmt.Sprintf("%s = CASE WHEN s.data ? '%s' THEN r.%s ELSE t.%s END", name, column.name, name, name))
+		}
+	}
+
+	updateQuery := staged + fmt.Sprintf("UPDATE %s t SET %s\nFROM staged s, jsonb_populate_record(NULL::%s, s.data) r\nWHERE t.id = s.id",
+		tableName, strings.Join(updateColumns, ", "), tableName)
+	insertQuery := staged + fmt.Sprintf("INSERT INTO %s (%s)\nSELECT %s\nFROM staged s, jsonb_populate_record(NULL::%s, s.data) r\nWHERE NOT EXISTS (SELECT 1 FROM %s t WHERE t.id = s.id)",
+		tableName, strings.Join(insertColumns, ", "), strings.Join(insertValues, ", "), tableName, tableName)
+	return updateQuery, insertQuery
+}
+
+func (im *importer) applyTable(ctx context.Context, tx pgx.Tx, table string) error {
+	columns, err := im.tableColumns(ctx, tx, table)
+	if err != nil {
+		return err
+	}
+	updateQuery, insertQuery := renderApplyQueries(table, columns)
+	updated, err := tx.Exec(ctx, updateQuery)
+	if err != nil {
+		return err
+	}
+	inserted, err := tx.Exec(ctx, insertQuery)
+	if err != nil {
+		return err
+	}
+	im.logger.Info("Updated table", zap.String("table", table), zap.Int64("updated", updated.RowsAffected()), zap.Int64("inserted", inserted.RowsAffected()))
+	return nil
+}
+
+type changeEvent struct {
+	table     string
+	operation string
+	recordID  int64
+	newID     *int64
+}
+
+// applyChanges applies deletions and merges from the change log, in the order they happened.
+func (im *importer) applyChanges(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx, `
+SELECT data->>'table_name', data->>'operation', (data->>'record_id')::bigint, (data->>'new_id')::bigint
+FROM import_staging
+WHERE table_name = 'change_log'
+ORDER BY (data->>'id')::bigint
+`)
+	if err != nil {
+		return err
+	}
+	var changes []changeEvent
+	for rows.Next() {
+		var change changeEvent
+		err = rows.Scan(&change.table, &change.operation, &change.recordID, &change.newID)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		changes = append(changes, change)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	for _, change := range changes {
+		switch {
+		case change.operation == "delete" && importDeleteTables[change.table]:
+			_, err = tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE id = $1", pgx.Identifier{change.table}.Sanitize()), change.recordID)
+		case change.operation == "merge" && change.table == "track" && change.newID != nil:
+			_, err = tx.Exec(ctx, "UPDATE track SET new_id = $2 WHERE id = $1", change.recordID, *change.newID)
+		default:
+			im.logger.Warn("Skipping unknown change", zap.String("table", change.table), zap.String("operation", change.operation))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *importer) Run(ctx context.Context, config ImportConfig) error {
+	_, err := im.conn.Exec(ctx, createImportLogTableQuery)
+	if err != nil {
+		return err
+	}
+
+	from := config.From
+	if from.IsZero() {
+		lastDay, ok, err := im.lastImportedDay(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("no data was imported yet, the first day to import needs to be specified")
+		}
+		from = lastDay.AddDate(0, 0, 1)
+	}
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	to := config.To
+	if to.IsZero() {
+		to = time.Now().AddDate(0, 0, -1)
+	}
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		logger := im.logger.With(zap.String("day", day.Format("2006-01-02")))
+
+		imported, err := im.isDayImported(ctx, day)
+		if err != nil {
+			return err
+		}
+		if imported {
+			logger.Info("Day was already imported")
+			continue
+		}
+
+		manifest, err := im.readManifest(day)
+		if err != nil {
+			logger.Error("Failed to read manifest", zap.Error(err))
+			return err
+		}
+		if manifest == nil {
+			logger.Info("Manifest is not published yet, stopping")
+			return nil
+		}
+
+		logger.Info("Importing day")
+		err = im.ImportDay(ctx, day, manifest)
+		if err != nil {
+			logger.Error("Failed to import day", zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func ImportAll(logger *zap.Logger, source ImportSource, databaseConfig *pgx.ConnConfig, config ImportConfig) error {
+	ctx := context.Background()
+	conn, err := pgx.ConnectConfig(ctx, databaseConfig)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	im := &importer{logger: logger, source: source, conn: conn}
+	return im.Run(ctx, config)
+}
@@ -0,0 +1,117 @@
+package export
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportFileTable(t *testing.T) {
+	assert.Equal(t, "fingerprint-update", ExportFileTable("2020-03-01-fingerprint-update.jsonl.gz"))
+	assert.Equal(t, "fingerprint-update", ExportFileTable("2020-03-01-fingerprint-update.compressed.jsonl.gz"))
+	assert.Equal(t, "track_mbid-update", ExportFileTable("2020-03-01-track_mbid-update.csv.gz"))
+}
+
+func TestImportFileTables(t *testing.T) {
+	for _, table := range builtinTables {
+		dbTable, ok := importFileTables[table.name]
+		if assert.True(t, ok, table.name) && table.name != "changes-update" {
+			assert.Contains(t, importTableOrder, dbTable)
+		}
+	}
+	order := make(map[string]int)
+	for i, name := range importTableOrder {
+		order[name] = i
+	}
+	assert.Less(t, order["track"], order["track_foreignid"])
+	assert.Less(t, order["foreignid_vendor"], order["foreignid"])
+	assert.Less(t, order["foreignid"], order["track_foreignid"])
+}
+
+func TestWriteImportRows(t *testing.T) {
+	expected := make([]string, len(testFormatRows))
+	for i, line := range testFormatRows {
+		expected[i] = "test\x02" + line
+	}
+
+	for _, format := range []string{FormatJSONL, FormatCSV, FormatTSV} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			file := &ManifestFile{Name: "2020-03-01-test-update." + format + ".gz", JSONColumns: []string{"id", "fingerprint", "disabled"}}
+			err := writeImportRows(&buf, bytes.NewReader(gzipData(t, exportTestRows(t, format))), "test", file)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			if format != FormatJSONL {
+				// CSV files don't distinguish empty strings from NULL values
+				lines[3] = strings.Replace(lines[3], `"name":null`, `"name":""`, 1)
+			}
+			assert.Equal(t, expected, lines)
+		})
+	}
+}
+
+func TestWriteImportRows_StringColumns(t *testing.T) {
+	var buf bytes.Buffer
+	file := &ManifestFile{Name: "2020-03-01-test-update.csv.gz", JSONColumns: []string{"id"}}
+	err := writeImportRows(&buf, bytes.NewReader(gzipData(t, "id,name,length\n1,1999,120\n")), "test", file)
+	require.NoError(t, err)
+	assert.Equal(t, "test\x02"+`{"id":1,"name":"1999","length":"120"}`+"\n", buf.String())
+}
+
+func TestWriteImportRows_CompressedFingerprint(t *testing.T) {
+	line := `{"id":1,"fingerprint":[-587455133,-591649759,-574868448],"length":120}`
+	r, err := parseRow([]byte(line))
+	require.NoError(t, err)
+	require.NoError(t, compressFingerprintField(r))
+
+	var buf bytes.Buffer
+	file := &ManifestFile{Name: "2020-03-01-fingerprint-update.jsonl.gz", FingerprintEncoding: FingerprintEncodingCompressed}
+	err = writeImportRows(&buf, bytes.NewReader(gzipData(t, string(r.AppendJSON(nil))+"\n")), "fingerprint", file)
+	require.NoError(t, err)
+	assert.Equal(t, "fingerprint\x02"+line+"\n", buf.String())
+}
+
+func TestRenderApplyQueries(t *testing.T) {
+	columns := []tableColumn{{name: "id"}, {name: "mbid"}, {name: "disabled", defaultValue: "false"}}
+	updateQuery, insertQuery := renderApplyQueries("track_mbid", columns)
+	assert.Contains(t, updateQuery, `UPDATE "track_mbid" t SET "mbid" = CASE WHEN s.data ? 'mbid' THEN r."mbid" ELSE t."mbid" END, "disabled" = CASE`)
+	assert.Contains(t, insertQuery, `INSERT INTO "track_mbid" ("id", "mbid", "disabled")`)
+	assert.Contains(t, insertQuery, `SELECT r."id", r."mbid", coalesce(r."disabled", false)`)
+}
+
+func TestHTTPImportSource(t *testing.T) {
+	data := []byte("0123456789")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "401 Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(data))
+	}))
+	defer server.Close()
+
+	source := NewHTTPImportSource(server.URL, "secret")
+	reader, err := source.Open("/2020/2020-03/file")
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	assert.Equal(t, data, content)
+
+	reader, err = source.OpenRange("/2020/2020-03/file", 3, 4)
+	require.NoError(t, err)
+	content, err = ioutil.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "3456", string(content))
+
+	_, err = NewHTTPImportSource(server.URL, "").Open("/2020/2020-03/file")
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package export
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultRetryDelay  = 10 * time.Second
+)
+
+type exportJob struct {
+	name string
+	run  func() error
+}
+
+type ExportFailure struct {
+	Name string
+	Err  error
+}
+
+type ExportSummary struct {
+	mu        sync.Mutex
+	Succeeded []string
+	Failed    []ExportFailure
+}
+
+func (s *ExportSummary) Add(name string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.Failed = append(s.Failed, ExportFailure{Name: name, Err: err})
+	} else {
+		s.Succeeded = append(s.Succeeded, name)
+	}
+}
+
+func (s *ExportSummary) Log(logger *zap.Logger) {
+	for _, failure := range s.Failed {
+		logger.Error("Export failed", zap.String("name", failure.Name), zap.Error(failure.Err))
+	}
+	logger.Info("Export finished", zap.Int("succeeded", len(s.Succeeded)), zap.Int("failed", len(s.Failed)))
+}
+
+func (s *ExportSummary) Err() error {
+	if len(s.Failed) > 0 {
+		return fmt.Errorf("%d of %d exports failed", len(s.Failed), len(s.Failed)+len(s.Succeeded))
+	}
+	return nil
+}
+
+func (ex *exporter) retry(name string, fn func() error) error {
+	maxAttempts := ex.maxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = defaultMaxAttempts
+	}
+	delay := ex.retryDelay
+	if delay <= 0 {
+		delay = defaultRetryDelay
+	}
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= maxAttempts {
+			return err
+		}
+		ex.logger.Warn("Export failed, retrying", zap.String("name", name), zap.Int("attempt", attempt), zap.Duration("delay", delay), zap.Error(err))
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+// RunJobs runs the jobs on a bounded pool of workers, retrying failed jobs, and records the results in the summary.
+func (ex *exporter) RunJobs(jobs []exportJob, summary *ExportSummary) {
+	workers := ex.workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	queue := make(chan exportJob)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range queue {
+				summary.Add(job.name, ex.retry(job.name, job.run))
+			}
+		}()
+	}
+	for _, job := range jobs {
+		queue <- job
+	}
+	close(queue)
+	wg.Wait()
+}
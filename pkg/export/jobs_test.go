@@ -0,0 +1,42 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestRunJobs(t *testing.T) {
+	ex := &exporter{logger: zap.NewNop(), workers: 3, maxAttempts: 3, retryDelay: time.Millisecond}
+
+	var attempts int32
+	var jobs []exportJob
+	for i := 0; i < 10; i++ {
+		jobs = append(jobs, exportJob{name: fmt.Sprintf("job%d", i), run: func() error { return nil }})
+	}
+	jobs = append(jobs, exportJob{name: "flaky", run: func() error {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	}})
+	jobs = append(jobs, exportJob{name: "broken", run: func() error { return errors.New("permanent failure") }})
+
+	summary := &ExportSummary{}
+	ex.RunJobs(jobs, summary)
+
+	sort.Strings(summary.Succeeded)
+	assert.Len(t, summary.Succeeded, 11)
+	assert.Contains(t, summary.Succeeded, "flaky")
+	assert.Equal(t, int32(3), attempts)
+	if assert.Len(t, summary.Failed, 1) {
+		assert.Equal(t, "broken", summary.Failed[0].Name)
+	}
+	assert.EqualError(t, summary.Err(), "1 of 12 exports failed")
+}
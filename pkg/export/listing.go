@@ -0,0 +1,361 @@
+package export
+
+import (
+	"encoding/json"
+	"go.uber.org/zap"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListingEntry is a file or a directory in a JSON directory listing.
+type ListingEntry struct {
+	Name     string    `json:"name"`
+	IsDir    bool      `json:"is_dir"`
+	Size     int64     `json:"size"`
+	Modified time.Time `json:"modified"`
+	SHA256   string    `json:"sha256,omitempty"`
+}
+
+type Listing struct {
+	Path    string         `json:"path"`
+	Entries []ListingEntry `json:"entries"`
+}
+
+// LatestFile is the newest file of a table from a day with a published manifest.
+type LatestFile struct {
+	Table  string `json:"table"`
+	Date   string `json:"date"`
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+	Rows   int64  `json:"rows"`
+	// Set for files which were rolled up, the data is stored in the file at Path at this offset.
+	Offset int64 `json:"offset,omitempty"`
+}
+
+func isManifestFileName(name string) bool {
+	return strings.HasSuffix(name, "-manifest.json")
+}
+
+// ReadListing lists a directory. Checksums of files are taken from manifests in the same directory.
+// Hidden files, e.g. files that are still being written, are not listed.
+func ReadListing(storage Storage, path string) (*Listing, error) {
+	infos, err := storage.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	checksums := make(map[string]string)
+	for _, info := range infos {
+		if info.IsDir() || !isManifestFileName(info.Name()) {
+			continue
+		}
+		manifest, err := ReadManifest(storage, storage.Join(path, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if manifest == nil {
+			continue
+		}
+		for _, file := range manifest.Files {
+			checksums[file.Name] = file.SHA256
+		}
+	}
+
+	listing := &Listing{Path: path, Entries: []ListingEntry{}}
+	for _, info := range infos {
+		if strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+		entry := ListingEntry{
+			Name:     info.Name(),
+			IsDir:    info.IsDir(),
+			Modified: info.ModTime().UTC(),
+		}
+		if !entry.IsDir {
+			entry.Size = info.Size()
+			entry.SHA256 = checksums[entry.Name]
+		}
+		listing.Entries = append(listing.Entries, entry)
+	}
+	sort.Slice(listing.Entries, func(i, j int) bool { return listing.Entries[i].Name < listing.Entries[j].Name })
+	return listing, nil
+}
+
+// fileDate returns the date prefix of a daily or monthly file name, or an empty string.
+func fileDate(name string) string {
+	if isDailyFileName(name) {
+		return name[:10]
+	}
+	if len(name) > 8 && name[7] == '-' {
+		if _, err := time.Parse("2006-01", name[:7]); err == nil {
+			return name[:7]
+		}
+	}
+	return ""
+}
+
+// fileTable returns the name of the table of a daily or monthly file, e.g. "fingerprint-update" or "manifest".
+func fileTable(name string) string {
+	if date := fileDate(name); date != "" {
+		name = name[len(date)+1:]
+	}
+	if i := strings.IndexByte(name, '.'); i != -1 {
+		name = name[:i]
+	}
+	return name
+}
+
+func fileHref(name string, isDir bool) string {
+	if isDir {
+		name += "/"
+	}
+	u := url.URL{Path: name}
+	return u.String()
+}
+
+type indexEntry struct {
+	ListingEntry
+	Table         string
+	Href          string
+	SignatureHref string
+}
+
+type indexGroup struct {
+	Date    string
+	Entries []indexEntry
+}
+
+type indexPage struct {
+	Path   string
+	Parent bool
+	Other  []indexEntry
+	Groups []indexGroup
+}
+
+// newIndexPage groups files of a listing by date, newest first. Signatures are attached to the files they sign.
+func newIndexPage(listing *Listing) *indexPage {
+	page := &indexPage{Path: listing.Path, Parent: strings.Trim(listing.Path, "/") != ""}
+
+	names := make(map[string]bool)
+	for _, entry := range listing.Entries {
+		names[entry.Name] = true
+	}
+
+	groups := make(map[string]*indexGroup)
+	for _, entry := range listing.Entries {
+		if !entry.IsDir && strings.HasSuffix(entry.Name, SignatureFileSuffix) && names[strings.TrimSuffix(entry.Name, SignatureFileSuffix)] {
+			continue
+		}
+		item := indexEntry{ListingEntry: entry, Href: fileHref(entry.Name, entry.IsDir)}
+		if names[SignatureFileName(entry.Name)] {
+			item.SignatureHref = fileHref(SignatureFileName(entry.Name), false)
+		}
+		date := ""
+		if !entry.IsDir {
+			date = fileDate(entry.Name)
+		}
+		if date == "" {
+			page.Other = append(page.Other, item)
+			continue
+		}
+		item.Table = fileTable(entry.Name)
+		group, exists := groups[date]
+		if !exists {
+			group = &indexGroup{Date: date}
+			groups[date] = group
+		}
+		group.Entries = append(group.Entries, item)
+	}
+
+	for _, group := range groups {
+		sort.Slice(group.Entries, func(i, j int) bool { return group.Entries[i].Table < group.Entries[j].Table })
+		page.Groups = append(page.Groups, *group)
+	}
+	sort.Slice(page.Groups, func(i, j int) bool { return page.Groups[i].Date > page.Groups[j].Date })
+	return page
+}
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Index of {{.Path}}</title>
+</head>
+<body>
+<h1>Index of {{.Path}}</h1>
+{{- if .Parent}}
+<p><a href="../">../</a></p>
+{{- end}}
+{{- if .Other}}
+<ul>
+{{- range .Other}}
+<li><a href="{{.Href}}">{{.Name}}{{if .IsDir}}/{{end}}</a></li>
+{{- end}}
+</ul>
+{{- end}}
+{{- range .Groups}}
+<h2>{{.Date}}</h2>
+<table>
+<tr><th>Table</th><th>File</th><th>Size</th><th>Modified</th><th>SHA-256</th></tr>
+{{- range .Entries}}
+<tr><td>{{.Table}}</td><td><a href="{{.Href}}">{{.Name}}</a>{{if .SignatureHref}} (<a href="{{.SignatureHref}}">signature</a>){{end}}</td><td>{{.Size}}</td><td>{{.Modified.Format "2006-01-02 15:04:05"}}</td><td><code>{{.SHA256}}</code></td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// LatestFiles returns the newest file of each table from days with a published manifest.
+// Months are scanned from the newest one, until a month doesn't contain any other tables.
+func LatestFiles(storage Storage) ([]LatestFile, error) {
+	years, err := storage.ReadDir("")
+	if err != nil {
+		return nil, err
+	}
+	var yearNames []string
+	for _, year := range years {
+		if _, err := strconv.Atoi(year.Name()); err == nil && year.IsDir() && len(year.Name()) == 4 {
+			yearNames = append(yearNames, year.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(yearNames)))
+
+	latest := make(map[string]LatestFile)
+	for _, year := range yearNames {
+		months, err := storage.ReadDir(year)
+		if err != nil {
+			return nil, err
+		}
+		var monthNames []string
+		for _, month := range months {
+			if _, err := time.Parse("2006-01", month.Name()); err == nil && month.IsDir() {
+				monthNames = append(monthNames, month.Name())
+			}
+		}
+		sort.Sort(sort.Reverse(sort.StringSlice(monthNames)))
+
+		for _, month := range monthNames {
+			directory := storage.Join(year, month)
+			files, err := storage.ReadDir(directory)
+			if err != nil {
+				return nil, err
+			}
+			var manifestNames []string
+			for _, file := range files {
+				if isDailyFileName(file.Name()) && isManifestFileName(file.Name()) {
+					manifestNames = append(manifestNames, file.Name())
+				}
+			}
+			if len(manifestNames) == 0 {
+				continue
+			}
+			sort.Sort(sort.Reverse(sort.StringSlice(manifestNames)))
+
+			found := false
+			for _, name := range manifestNames {
+				manifest, err := ReadManifest(storage, storage.Join(directory, name))
+				if err != nil {
+					return nil, err
+				}
+				if manifest == nil {
+					continue
+				}
+				for _, file := range manifest.Files {
+					table := fileTable(file.Name)
+					if _, exists := latest[table]; exists {
+						continue
+					}
+					latest[table] = LatestFile{
+						Table:  table,
+						Date:   name[:10],
+						Path:   storage.Join(directory, file.DataFileName()),
+						Size:   file.Size,
+						SHA256: file.SHA256,
+						Rows:   file.Rows,
+						Offset: file.Offset,
+					}
+					found = true
+				}
+			}
+			if !found {
+				return sortLatestFiles(latest), nil
+			}
+		}
+	}
+	return sortLatestFiles(latest), nil
+}
+
+func sortLatestFiles(latest map[string]LatestFile) []LatestFile {
+	files := make([]LatestFile, 0, len(latest))
+	for _, file := range latest {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Table < files[j].Table })
+	return files
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// listingHandler serves directory listings, either as JSON with "?format=json", or as an HTML index page.
+// Everything else is passed to the file server.
+type listingHandler struct {
+	storage Storage
+	logger  *zap.Logger
+	files   http.Handler
+}
+
+func (h *listingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasSuffix(r.URL.Path, "/") {
+		h.files.ServeHTTP(w, r)
+		return
+	}
+	info, err := h.storage.Stat(r.URL.Path)
+	if err != nil || !info.IsDir() {
+		h.files.ServeHTTP(w, r)
+		return
+	}
+
+	listing, err := ReadListing(h.storage, r.URL.Path)
+	if err != nil {
+		h.logger.Error("Failed to list directory", zap.String("path", r.URL.Path), zap.Error(err))
+		http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	if r.URL.Query().Get("format") == "json" {
+		writeJSON(w, listing)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = indexTemplate.Execute(w, newIndexPage(listing))
+	if err != nil {
+		h.logger.Error("Failed to render index page", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+}
+
+// latestHandler serves the newest complete day of each table.
+type latestHandler struct {
+	storage Storage
+	logger  *zap.Logger
+}
+
+func (h *latestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	files, err := LatestFiles(h.storage)
+	if err != nil {
+		h.logger.Error("Failed to find latest files", zap.Error(err))
+		http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, files)
+}
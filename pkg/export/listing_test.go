@@ -0,0 +1,100 @@
+package export
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newListingTestStorage(t *testing.T) *memStorage {
+	storage := newMemStorage()
+	storage.WriteFile("2020/2020-02/2020-02-29-fingerprint-update.jsonl.gz", []byte("fp0229"))
+	storage.WriteFile("2020/2020-02/2020-02-29-meta-update.jsonl.gz", []byte("meta0229"))
+	storage.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", []byte("fp0301"))
+	storage.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz.sig", []byte("sig"))
+	storage.WriteFile("2020/2020-03/2020-03-02-fingerprint-update.jsonl.gz", []byte("fp0302"))
+	storage.WriteFile("2020/2020-03/.2020-03-02-meta-update.jsonl.gz.123.tmp", []byte("tmp"))
+
+	for _, manifest := range []*Manifest{
+		{Date: "2020-02-29", Files: []ManifestFile{
+			{Name: "2020-02-29-fingerprint-update.jsonl.gz", Size: 6, SHA256: "a", Rows: 1},
+			{Name: "2020-02-29-meta-update.jsonl.gz", Size: 8, SHA256: "b", Rows: 2},
+		}},
+		{Date: "2020-03-01", Files: []ManifestFile{
+			{Name: "2020-03-01-fingerprint-update.jsonl.gz", Size: 6, SHA256: "c", Rows: 3},
+		}},
+	} {
+		data, err := EncodeManifest(manifest)
+		require.NoError(t, err)
+		storage.WriteFile(manifest.Date[:4]+"/"+manifest.Date[:7]+"/"+manifest.Date+"-manifest.json", data)
+	}
+	return storage
+}
+
+func TestReadListing(t *testing.T) {
+	storage := newListingTestStorage(t)
+
+	listing, err := ReadListing(storage, "/2020/2020-03/")
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range listing.Entries {
+		names = append(names, entry.Name)
+	}
+	assert.Equal(t, []string{
+		"2020-03-01-fingerprint-update.jsonl.gz",
+		"2020-03-01-fingerprint-update.jsonl.gz.sig",
+		"2020-03-01-manifest.json",
+		"2020-03-02-fingerprint-update.jsonl.gz",
+	}, names)
+	assert.Equal(t, "c", listing.Entries[0].SHA256)
+	assert.Equal(t, int64(6), listing.Entries[0].Size)
+	assert.Equal(t, "", listing.Entries[3].SHA256)
+}
+
+func TestLatestFiles(t *testing.T) {
+	storage := newListingTestStorage(t)
+
+	files, err := LatestFiles(storage)
+	require.NoError(t, err)
+	assert.Equal(t, []LatestFile{
+		{Table: "fingerprint-update", Date: "2020-03-01", Path: "2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", Size: 6, SHA256: "c", Rows: 3},
+		{Table: "meta-update", Date: "2020-02-29", Path: "2020/2020-02/2020-02-29-meta-update.jsonl.gz", Size: 8, SHA256: "b", Rows: 2},
+	}, files)
+}
+
+func TestListingHandler(t *testing.T) {
+	storage := newListingTestStorage(t)
+	handler := &listingHandler{storage: storage, logger: zap.NewNop(), files: http.NotFoundHandler()}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/2020/2020-03/?format=json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var listing Listing
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listing))
+	assert.Equal(t, "/2020/2020-03/", listing.Path)
+	assert.Len(t, listing.Entries, 4)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/2020/2020-03/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "<h2>2020-03-01</h2>")
+	assert.Contains(t, body, `<td>fingerprint-update</td><td><a href="2020-03-01-fingerprint-update.jsonl.gz">2020-03-01-fingerprint-update.jsonl.gz</a> (<a href="2020-03-01-fingerprint-update.jsonl.gz.sig">signature</a>)</td>`)
+	assert.Less(t, strings.Index(body, "<h2>2020-03-02</h2>"), strings.Index(body, "<h2>2020-03-01</h2>"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/2020/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `<li><a href="2020-03/">2020-03/</a></li>`)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/2020/2020-03/2020-03-01-manifest.json", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,58 @@
+package export
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+)
+
+// exportLockID identifies the Postgres advisory lock held while files are being exported or pruned ("acoustid" in ASCII).
+const exportLockID int64 = 0x61636f7573746964
+
+// exportLock is a session-level advisory lock. It's held on a dedicated connection, so that it's
+// released automatically if the process dies.
+type exportLock struct {
+	conn *pgx.Conn
+}
+
+// tryLockExport returns nil if the lock is held by another process.
+func tryLockExport(ctx context.Context, config *pgx.ConnConfig) (*exportLock, error) {
+	conn, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	var locked bool
+	err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", exportLockID).Scan(&locked)
+	if err != nil || !locked {
+		conn.Close(ctx)
+		return nil, err
+	}
+	return &exportLock{conn: conn}, nil
+}
+
+// withExportLock calls fn while holding the export lock. If another process holds it, fn is not called
+// and nil is returned.
+func withExportLock(logger *zap.Logger, config *pgx.ConnConfig, fn func() error) error {
+	lock, err := tryLockExport(context.Background(), config)
+	if err != nil {
+		logger.Error("Failed to acquire export lock", zap.Error(err))
+		return err
+	}
+	if lock == nil {
+		logger.Warn("Another export or prune is already running, exiting")
+		return nil
+	}
+	defer func() {
+		err := lock.Unlock(context.Background())
+		if err != nil {
+			logger.Error("Failed to release export lock", zap.Error(err))
+		}
+	}()
+	return fn()
+}
+
+func (l *exportLock) Unlock(ctx context.Context) error {
+	defer l.conn.Close(ctx)
+	_, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", exportLockID)
+	return err
+}
@@ -0,0 +1,73 @@
+package export
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testDatabaseURL names the environment variable with the URL of a Postgres server for tests which need one.
+const testDatabaseURL = "ASERVER_TEST_DATABASE_URL"
+
+func testDatabaseConfig(t *testing.T) *pgx.ConnConfig {
+	url := os.Getenv(testDatabaseURL)
+	if url == "" {
+		t.Skipf("%s is not set", testDatabaseURL)
+	}
+	config, err := pgx.ParseConfig(url)
+	require.NoError(t, err)
+	config.PreferSimpleProtocol = true
+	return config
+}
+
+func TestTryLockExport(t *testing.T) {
+	config := testDatabaseConfig(t)
+	ctx := context.Background()
+
+	lock, err := tryLockExport(ctx, config)
+	require.NoError(t, err)
+	require.NotNil(t, lock)
+
+	other, err := tryLockExport(ctx, config)
+	require.NoError(t, err)
+	assert.Nil(t, other, "the lock is held by another connection")
+
+	require.NoError(t, lock.Unlock(ctx))
+
+	other, err = tryLockExport(ctx, config)
+	require.NoError(t, err)
+	require.NotNil(t, other)
+	require.NoError(t, other.Unlock(ctx))
+}
+
+func TestWithExportLock(t *testing.T) {
+	config := testDatabaseConfig(t)
+	ctx := context.Background()
+
+	called := false
+	err := withExportLock(zap.NewNop(), config, func() error {
+		called = true
+
+		// a prune started during an export doesn't run
+		nested := false
+		err := withExportLock(zap.NewNop(), config, func() error {
+			nested = true
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.False(t, nested)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, called)
+
+	lock, err := tryLockExport(ctx, config)
+	require.NoError(t, err)
+	require.NotNil(t, lock, "the lock is released after fn returns")
+	require.NoError(t, lock.Unlock(ctx))
+}
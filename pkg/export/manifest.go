@@ -0,0 +1,196 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+type ManifestFile struct {
+	Name                string `json:"name"`
+	Size                int64  `json:"size"`
+	SHA256              string `json:"sha256"`
+	Rows                int64  `json:"rows"`
+	FingerprintEncoding string `json:"fingerprint_encoding,omitempty"`
+	// Set for CSV and TSV files, the columns which contain numbers, booleans, arrays or JSON values.
+	// Values of the other columns are strings.
+	JSONColumns []string `json:"json_columns,omitempty"`
+	// Set in daily manifests for files which were rolled up into a monthly file, the data of the daily
+	// file is stored in the monthly file at the given offset.
+	Monthly string `json:"monthly,omitempty"`
+	Offset  int64  `json:"offset,omitempty"`
+	// Set in monthly manifests, the daily files which were concatenated into the monthly file.
+	Sources []ManifestFile `json:"sources,omitempty"`
+}
+
+// DataFileName returns the name of the file which contains the data of the entry.
+func (f *ManifestFile) DataFileName() string {
+	if f.Monthly != "" {
+		return f.Monthly
+	}
+	return f.Name
+}
+
+type Manifest struct {
+	Date  string         `json:"date"`
+	Files []ManifestFile `json:"files"`
+}
+
+func (m *Manifest) Find(name string) *ManifestFile {
+	if m == nil {
+		return nil
+	}
+	for i := range m.Files {
+		if m.Files[i].Name == name {
+			return &m.Files[i]
+		}
+	}
+	return nil
+}
+
+// OpenManifestFile opens the data of a manifest entry, which is either a whole file in the directory,
+// or a part of a monthly file.
+func OpenManifestFile(storage Storage, directory string, file *ManifestFile) (io.ReadCloser, error) {
+	path := storage.Join(directory, file.DataFileName())
+	if file.Monthly == "" {
+		return storage.Open(path)
+	}
+	return openFileRange(storage, path, file.Offset, file.Size)
+}
+
+type fileRange struct {
+	io.Reader
+	io.Closer
+}
+
+// openFileRange opens size bytes of a file, starting at offset.
+func openFileRange(storage Storage, path string, offset, size int64) (io.ReadCloser, error) {
+	file, err := storage.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &fileRange{Reader: io.LimitReader(file, size), Closer: file}, nil
+}
+
+func ManifestFileName(date time.Time) string {
+	return fmt.Sprintf("%s-manifest.json", date.Format("2006-01-02"))
+}
+
+func ReadManifest(storage Storage, path string) (*Manifest, error) {
+	fileExists, err := CheckFileExists(storage, path)
+	if err != nil {
+		return nil, err
+	}
+	if !fileExists {
+		return nil, nil
+	}
+
+	file, err := storage.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var manifest Manifest
+	err = json.NewDecoder(file).Decode(&manifest)
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func EncodeManifest(manifest *Manifest) ([]byte, error) {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type checksumWriter struct {
+	w    io.Writer
+	hash hash.Hash
+	size int64
+}
+
+func newChecksumWriter(w io.Writer) *checksumWriter {
+	return &checksumWriter{w: w, hash: sha256.New()}
+}
+
+func (cw *checksumWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.hash.Write(p[:n])
+	cw.size += int64(n)
+	return n, err
+}
+
+func (cw *checksumWriter) Size() int64 {
+	return cw.size
+}
+
+func (cw *checksumWriter) Sum() string {
+	return hex.EncodeToString(cw.hash.Sum(nil))
+}
+
+func countLines(r io.Reader) (int64, error) {
+	reader := bufio.NewReaderSize(r, bufferSize)
+	buf := make([]byte, bufferSize)
+	var lines int64
+	for {
+		n, err := reader.Read(buf)
+		lines += int64(bytes.Count(buf[:n], []byte{'\n'}))
+		if err == io.EOF {
+			return lines, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+func ComputeManifestFile(storage Storage, path string) (*ManifestFile, error) {
+	file, err := storage.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	checksum := newChecksumWriter(ioutil.Discard)
+	gzipFile, err := gzip.NewReader(io.TeeReader(file, checksum))
+	if err != nil {
+		return nil, err
+	}
+
+	_, fileName := storage.Split(path)
+
+	var rows int64
+	format := FormatFromFileName(fileName)
+	if format == FormatJSONL {
+		rows, err = countLines(gzipFile)
+	} else {
+		rows, err = countCSVRows(gzipFile, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManifestFile{
+		Name:   fileName,
+		Size:   checksum.Size(),
+		SHA256: checksum.Sum(),
+		Rows:   rows,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func gzipData(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestComputeManifestFile(t *testing.T) {
+	storage := newMemStorage()
+
+	jsonData := gzipData(t, "{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n")
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", jsonData)
+	file, err := ComputeManifestFile(storage, "2020/2020-03/2020-03-01-track-update.jsonl.gz")
+	require.NoError(t, err)
+	assert.Equal(t, &ManifestFile{
+		Name:   "2020-03-01-track-update.jsonl.gz",
+		Size:   int64(len(jsonData)),
+		SHA256: sha256Hex(jsonData),
+		Rows:   3,
+	}, file)
+
+	// the header is not a row and quoted values can contain newlines
+	csvData := gzipData(t, "id,track\n1,\"a\nb\"\n2,c\n")
+	storage.WriteFile("2020/2020-03/2020-03-01-meta-update.csv.gz", csvData)
+	file, err = ComputeManifestFile(storage, "2020/2020-03/2020-03-01-meta-update.csv.gz")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), file.Rows)
+	assert.Equal(t, sha256Hex(csvData), file.SHA256)
+
+	storage.WriteFile("2020/2020-03/2020-03-01-broken.jsonl.gz", []byte("not gzip"))
+	_, err = ComputeManifestFile(storage, "2020/2020-03/2020-03-01-broken.jsonl.gz")
+	assert.Error(t, err)
+}
+
+func TestUpdateManifest(t *testing.T) {
+	storage := newMemStorage()
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+	ex.tables = []exporterTableInfo{
+		{name: "track-update", delta: true, format: FormatJSONL},
+		{name: "fingerprint-update", delta: true, format: FormatJSONL, fingerprintEncoding: FingerprintEncodingCompressed},
+		{name: "meta", format: FormatCSV},
+	}
+
+	startTime := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	trackData := gzipData(t, "{\"id\":1}\n")
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", trackData)
+	fingerprintData := gzipData(t, "{\"id\":1}\n{\"id\":2}\n")
+	storage.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.compressed.jsonl.gz", fingerprintData)
+	storage.WriteFile("2020/2020-03/2020-03-01-manifest.json.123.tmp", []byte("{}"))
+
+	// the exported file is taken as it is, the other delta file is computed, the missing full file is skipped
+	exported := &ManifestFile{Name: "2020-03-01-track-update.jsonl.gz", Size: int64(len(trackData)), SHA256: sha256Hex(trackData), Rows: 1}
+	require.NoError(t, ex.UpdateManifest(startTime, []*ManifestFile{exported, nil, nil}))
+
+	manifest, err := ReadManifest(storage, "2020/2020-03/2020-03-01-manifest.json")
+	require.NoError(t, err)
+	require.NotNil(t, manifest)
+	assert.Equal(t, &Manifest{Date: "2020-03-01", Files: []ManifestFile{
+		*exported,
+		{Name: "2020-03-01-fingerprint-update.compressed.jsonl.gz", Size: int64(len(fingerprintData)), SHA256: sha256Hex(fingerprintData), Rows: 2, FingerprintEncoding: FingerprintEncodingCompressed},
+	}}, manifest)
+	_, exists := storage.ReadFile("2020/2020-03/2020-03-01-manifest.json.123.tmp")
+	assert.False(t, exists, "temporary files are deleted")
+
+	// entries of files which were not exported again are reused from the existing manifest, not recomputed
+	storage.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.compressed.jsonl.gz", gzipData(t, "{\"id\":3}\n"))
+	require.NoError(t, ex.UpdateManifest(startTime, []*ManifestFile{nil, nil, nil}))
+	reused, err := ReadManifest(storage, "2020/2020-03/2020-03-01-manifest.json")
+	require.NoError(t, err)
+	assert.Equal(t, manifest, reused)
+
+	// a full file which exists is added
+	metaData := gzipData(t, "id\n1\n")
+	storage.WriteFile("2020/2020-03/2020-03-01-meta.csv.gz", metaData)
+	require.NoError(t, ex.UpdateManifest(startTime, []*ManifestFile{nil, nil, nil}))
+	manifest, err = ReadManifest(storage, "2020/2020-03/2020-03-01-manifest.json")
+	require.NoError(t, err)
+	require.Len(t, manifest.Files, 3)
+	assert.Equal(t, ManifestFile{Name: "2020-03-01-meta.csv.gz", Size: int64(len(metaData)), SHA256: sha256Hex(metaData), Rows: 1}, manifest.Files[2])
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"github.com/acoustid/acoustid/pkg/metrics"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+var exportDurationBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600}
+
+var (
+	exportedFilesTotal   = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_export_files_total", "Number of exported files.", "table")
+	exportedRowsTotal    = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_export_rows_total", "Number of exported rows.", "table")
+	exportedBytesTotal   = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_export_bytes_total", "Number of exported bytes, after compression.", "table")
+	exportFailuresTotal  = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_export_failures_total", "Number of failed export attempts.", "table")
+	exportDuration       = metrics.NewHistogramVec(metrics.DefaultRegistry, "acoustid_export_duration_seconds", "Time spent exporting a file.", exportDurationBuckets, "table")
+	exportLastSuccessful = metrics.NewGaugeVec(metrics.DefaultRegistry, "acoustid_export_last_success_timestamp_seconds", "Time of the last successfully exported file, or of the last export run without failures.", "table")
+)
+
+var (
+	proxyRequestsTotal         = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_proxy_requests_total", "Number of HTTP requests.", "method", "code")
+	proxyResponseBytesTotal    = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_proxy_response_bytes_total", "Number of bytes served.")
+	proxyStorageDuration       = metrics.NewHistogramVec(metrics.DefaultRegistry, "acoustid_proxy_storage_duration_seconds", "Latency of storage operations.", metrics.DefBuckets, "operation")
+	proxyCacheRequestsTotal    = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_proxy_cache_requests_total", "Number of requests for cacheable files.", "result")
+	proxyCacheSize             = metrics.NewGaugeVec(metrics.DefaultRegistry, "acoustid_proxy_cache_size_bytes", "Total size of files in the disk cache.")
+	proxyRejectedRequestsTotal = metrics.NewCounterVec(metrics.DefaultRegistry, "acoustid_proxy_rejected_requests_total", "Number of requests rejected by authentication or rate limits.", "reason")
+)
+
+func recordExportedFile(table *exporterTableInfo, file *ManifestFile, startTime time.Time) {
+	exportedFilesTotal.Inc(table.name)
+	exportedRowsTotal.Add(float64(file.Rows), table.name)
+	exportedBytesTotal.Add(float64(file.Size), table.name)
+	exportDuration.ObserveDuration(startTime, table.name)
+	exportLastSuccessful.SetToCurrentTime(table.name)
+}
+
+// recordSuccessfulRun marks all tables as up to date, also those whose files already existed, so that
+// the last success time only gets old when exports are failing.
+func recordSuccessfulRun(tables []exporterTableInfo) {
+	for i := range tables {
+		exportLastSuccessful.SetToCurrentTime(tables[i].name)
+	}
+}
+
+// instrumentedStorage measures latency of storage operations.
+type instrumentedStorage struct {
+	Storage
+}
+
+func (s *instrumentedStorage) Stat(path string) (os.FileInfo, error) {
+	defer proxyStorageDuration.ObserveDuration(time.Now(), "stat")
+	return s.Storage.Stat(path)
+}
+
+func (s *instrumentedStorage) ReadDir(path string) ([]os.FileInfo, error) {
+	defer proxyStorageDuration.ObserveDuration(time.Now(), "readdir")
+	return s.Storage.ReadDir(path)
+}
+
+func (s *instrumentedStorage) Open(path string) (StorageFile, error) {
+	defer proxyStorageDuration.ObserveDuration(time.Now(), "open")
+	return s.Storage.Open(path)
+}
+
+type instrumentedResponseWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *instrumentedResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *instrumentedResponseWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func instrumentHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		iw := &instrumentedResponseWriter{ResponseWriter: w}
+		handler.ServeHTTP(iw, r)
+		if iw.status == 0 {
+			iw.status = http.StatusOK
+		}
+		proxyRequestsTotal.Inc(r.Method, strconv.Itoa(iw.status))
+		proxyResponseBytesTotal.Add(float64(iw.bytes))
+	})
+}
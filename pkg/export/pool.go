@@ -0,0 +1,66 @@
+package export
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"sync"
+)
+
+// connPool is a minimal pool of database connections. Connections are opened lazily, up to the given size.
+type connPool struct {
+	config *pgx.ConnConfig
+	sem    chan struct{}
+	mu     sync.Mutex
+	idle   []*pgx.Conn
+}
+
+func newConnPool(config *pgx.ConnConfig, size int) *connPool {
+	if size < 1 {
+		size = 1
+	}
+	return &connPool{config: config, sem: make(chan struct{}, size)}
+}
+
+func (p *connPool) Acquire(ctx context.Context) (*pgx.Conn, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mu.Lock()
+	for len(p.idle) > 0 {
+		conn := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if !conn.IsClosed() {
+			p.mu.Unlock()
+			return conn, nil
+		}
+	}
+	p.mu.Unlock()
+
+	conn, err := pgx.ConnectConfig(ctx, p.config)
+	if err != nil {
+		<-p.sem
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (p *connPool) Release(conn *pgx.Conn) {
+	if !conn.IsClosed() {
+		p.mu.Lock()
+		p.idle = append(p.idle, conn)
+		p.mu.Unlock()
+	}
+	<-p.sem
+}
+
+func (p *connPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, conn := range p.idle {
+		conn.Close(context.Background())
+	}
+	p.idle = nil
+}
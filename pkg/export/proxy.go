@@ -1,9 +1,23 @@
 package export
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/acoustid/acoustid/pkg/metrics"
+	"github.com/jackc/pgx/v4"
 	"go.uber.org/zap"
+	"io"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
 )
 
 type ProxyFile struct {
@@ -14,12 +28,12 @@ type ProxyFile struct {
 }
 
 func (f *ProxyFile) Readdir(count int) ([]os.FileInfo, error) {
-	f.logger.Info("readdir", zap.String("path", f.path))
+	f.logger.Debug("readdir", zap.String("path", f.path))
 	return f.storage.ReadDir(f.path)
 }
 
 func (f *ProxyFile) Stat() (os.FileInfo, error) {
-	f.logger.Info("stat", zap.String("path", f.path))
+	f.logger.Debug("stat", zap.String("path", f.path))
 	return f.storage.Stat(f.path)
 }
 
@@ -36,16 +50,16 @@ type ProxyFilesystem struct {
 }
 
 func (fs *ProxyFilesystem) Open(path string) (http.File, error) {
-	fs.logger.Info("open", zap.String("path", path))
+	fs.logger.Debug("open", zap.String("path", path))
 	file, err := fs.storage.Open(path)
 	if err != nil {
 		if err == os.ErrNotExist {
 			return nil, err
 		}
-		fs.logger.Info("Open failed", zap.Error(err))
+		fs.logger.Debug("Open failed", zap.Error(err))
 		info, err2 := fs.storage.Stat(path)
 		if err2 != nil {
-			fs.logger.Info("Stat failed", zap.Error(err2))
+			fs.logger.Debug("Stat failed", zap.Error(err2))
 			return nil, err
 		}
 		if err2 == nil || info.IsDir() {
@@ -56,14 +70,366 @@ func (fs *ProxyFilesystem) Open(path string) (http.File, error) {
 	return &ProxyFile{StorageFile: file, storage: fs.storage, logger: fs.logger, path: path}, nil
 }
 
-func RunProxy(logger *zap.Logger, sc *StorageConfig) error {
-	storage, err := NewStorageClient(logger, *sc)
+// isImmutableFile returns true for published daily and monthly data files and their signatures.
+// These are only replaced by backfills, which is detected by a change of the size or modification time.
+func isImmutableFile(p string) bool {
+	dir, name := path.Split(cleanStoragePath(p))
+	if strings.HasPrefix(dir, "hourly/") {
+		return false
+	}
+	name = strings.TrimSuffix(name, SignatureFileSuffix)
+	return fileDate(name) != "" && strings.HasSuffix(name, ".gz")
+}
+
+func fileETag(info os.FileInfo) string {
+	return fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size())
+}
+
+// fileHandler serves files with ETag, Last-Modified and Range support. Immutable files are served from
+// the disk cache, if it is enabled. Directories and missing files are passed to the fallback handler.
+type fileHandler struct {
+	storage  Storage
+	cache    *diskCache
+	stats    *downloadStats
+	logger   *zap.Logger
+	fallback http.Handler
+}
+
+// open returns the cached copy of an immutable file. On a cache miss, the file is served from the storage
+// and copied to the cache in the background, so that clients don't wait for the whole file to be downloaded.
+// If the file doesn't match its Stat result, io.ErrUnexpectedEOF is returned.
+func (h *fileHandler) open(path string, info os.FileInfo) (io.ReadSeekCloser, error) {
+	if h.cache == nil || !isImmutableFile(path) || info.Size() > h.cache.maxSize {
+		return h.storage.Open(path)
+	}
+
+	file := h.cache.Open(path, info)
+	if file != nil {
+		proxyCacheRequestsTotal.Inc("hit")
+		return file, nil
+	}
+	proxyCacheRequestsTotal.Inc("miss")
+
+	remoteFile, err := h.storage.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	size, err := remoteFile.Seek(0, io.SeekEnd)
+	if err == nil && size != info.Size() {
+		err = io.ErrUnexpectedEOF
+	}
+	if err == nil {
+		_, err = remoteFile.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		remoteFile.Close()
+		return nil, err
+	}
+
+	h.fillCache(path, info)
+	return remoteFile, nil
+}
+
+// fillCache copies a file to the cache in the background, unless it's already being copied.
+func (h *fileHandler) fillCache(path string, info os.FileInfo) {
+	if !h.cache.beginFill(path) {
+		return
+	}
+	go func() {
+		defer h.cache.endFill(path)
+		logger := h.logger.With(zap.String("path", path))
+
+		remoteFile, err := h.storage.Open(path)
+		if err != nil {
+			logger.Warn("Failed to open file for caching", zap.Error(err))
+			return
+		}
+		defer remoteFile.Close()
+
+		file, err := h.cache.Add(path, info, remoteFile)
+		if err != nil {
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				logger.Info("File changed since it was listed, not caching it")
+				forgetStat(h.storage, path)
+				return
+			}
+			logger.Warn("Failed to cache file", zap.Error(err))
+			return
+		}
+		file.Close()
+		proxyCacheSize.Set(float64(h.cache.Size()))
+	}()
+}
+
+// openStat opens a file like open. If the file doesn't match its cached Stat result, because it was replaced
+// in the meantime, the result is dropped from the listing cache and the file is opened with a fresh one.
+func (h *fileHandler) openStat(path string, info os.FileInfo) (io.ReadSeekCloser, os.FileInfo, error) {
+	file, err := h.open(path, info)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		return file, info, err
+	}
+	h.logger.Info("File changed since it was listed, retrying", zap.String("path", path))
+	forgetStat(h.storage, path)
+	info, err = h.storage.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err = h.open(path, info)
+	return file, info, err
+}
+
+func (h *fileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/") {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+	info, err := h.storage.Stat(r.URL.Path)
+	if err != nil || info.IsDir() {
+		h.fallback.ServeHTTP(w, r)
+		return
+	}
+
+	file, info, err := h.openStat(r.URL.Path, info)
+	if err != nil {
+		if os.IsNotExist(err) {
+			http.NotFound(w, r)
+			return
+		}
+		h.logger.Error("Failed to open file", zap.String("path", r.URL.Path), zap.Error(err))
+		http.Error(w, "500 Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("ETag", fileETag(info))
+	if h.stats == nil || r.Method != http.MethodGet || !isImmutableFile(r.URL.Path) {
+		http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+		return
+	}
+
+	rw := &instrumentedResponseWriter{ResponseWriter: w}
+	http.ServeContent(rw, r, info.Name(), info.ModTime(), file)
+	if rw.status == http.StatusOK || rw.status == http.StatusPartialContent {
+		h.stats.Record(r.URL.Path, rw.bytes, isCompleteDownload(rw.Header(), rw.status))
+	}
+}
+
+type ProxyConfig struct {
+	// Address to listen on, e.g. ":8080".
+	Addr string
+	// Path prefix of the data endpoints, e.g. "/data". Health checks and metrics are always served from the root.
+	PathPrefix        string
+	ReadHeaderTimeout time.Duration
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	// How long the server keeps accepting requests after a shutdown signal, while it is reported as not ready.
+	ShutdownDelay time.Duration
+	// How long the server waits for active requests to finish after a shutdown signal.
+	ShutdownTimeout time.Duration
+	// TLS certificate and key files, plain HTTP is served if they are empty.
+	TLSCertFile string
+	TLSKeyFile  string
+	// Number of SFTP sessions used for serving files in parallel.
+	StorageSessions int
+	Cache           ProxyCacheConfig
+	Auth            ProxyAuthConfig
+	Stats           ProxyStatsConfig
+}
+
+type healthStatus struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	Sessions  int    `json:"sessions"`
+	Connected int    `json:"connected"`
+}
+
+// healthHandler reports whether the storage can be reached.
+type healthHandler struct {
+	storage *StoragePool
+	// If set, the check fails once the server is shutting down.
+	shuttingDown *int32
+}
+
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var status healthStatus
+	var err error
+	if h.shuttingDown != nil && atomic.LoadInt32(h.shuttingDown) != 0 {
+		status = healthStatus{Status: "shutting_down", Sessions: len(h.storage.sessions)}
+	} else {
+		var connected int
+		connected, err = h.storage.Check()
+		status = healthStatus{Status: "ok", Sessions: len(h.storage.sessions), Connected: connected}
+		if err != nil {
+			status.Status = "unavailable"
+			status.Error = err.Error()
+		}
+	}
+	if status.Status != "ok" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(status)
+		return
+	}
+	writeJSON(w, status)
+}
+
+type proxyServer struct {
+	logger *zap.Logger
+	config ProxyConfig
+	// all connections to the storage, used for health checks
+	pool *StoragePool
+	// the storage used for serving files, with instrumentation and caching
+	storage Storage
+	cache   *diskCache
+	keys    APIKeyStore
+	// app database, only used if API keys are checked or download stats are stored
+	db           *connPool
+	stats        *downloadStats
+	shuttingDown int32
+}
+
+func (p *proxyServer) Handler() http.Handler {
+	fs := &ProxyFilesystem{storage: p.storage, logger: p.logger}
+	files := &fileHandler{storage: p.storage, cache: p.cache, stats: p.stats, logger: p.logger, fallback: http.FileServer(fs)}
+
+	prefix := strings.TrimSuffix(p.config.PathPrefix, "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+
+	routes := http.NewServeMux()
+	routes.Handle(prefix+"/", http.StripPrefix(prefix, &listingHandler{storage: p.storage, logger: p.logger, files: files}))
+	routes.Handle(prefix+"/latest", &latestHandler{storage: p.storage, logger: p.logger})
+	routes.Handle(prefix+"/export/", http.StripPrefix(prefix+"/export/", &rangeHandler{storage: p.storage, files: files, logger: p.logger}))
+	if p.stats != nil {
+		routes.Handle(prefix+"/stats", &statsHandler{db: p.db, logger: p.logger})
+	}
+
+	// all routes share one auth handler, so that the rate limits apply to requests of any kind together
+	var protected http.Handler = newAuthHandler(p.logger, p.config.Auth, p.keys, routes)
+	protected = &accessLogHandler{logger: p.logger, trustedProxies: p.config.Auth.TrustedProxies, next: protected}
+	protected = instrumentHandler(protected)
+
+	mux := http.NewServeMux()
+	mux.Handle("/", protected)
+	mux.Handle("/healthz", &healthHandler{storage: p.pool})
+	mux.Handle("/readyz", &healthHandler{storage: p.pool, shuttingDown: &p.shuttingDown})
+	mux.Handle("/metrics", metrics.DefaultRegistry)
+	return mux
+}
+
+// Serve handles requests until the context is cancelled. Then it waits for active requests to finish.
+func (p *proxyServer) Serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{
+		Handler:           p.Handler(),
+		ReadHeaderTimeout: p.config.ReadHeaderTimeout,
+		ReadTimeout:       p.config.ReadTimeout,
+		WriteTimeout:      p.config.WriteTimeout,
+		IdleTimeout:       p.config.IdleTimeout,
+		ErrorLog:          zap.NewStdLog(p.logger),
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		if p.config.TLSCertFile != "" {
+			errs <- server.ServeTLS(listener, p.config.TLSCertFile, p.config.TLSKeyFile)
+		} else {
+			errs <- server.Serve(listener)
+		}
+	}()
+
+	if p.stats != nil {
+		flushCtx, cancelFlush := context.WithCancel(context.Background())
+		defer func() {
+			cancelFlush()
+			err := p.stats.Flush(context.Background(), p.db)
+			if err != nil {
+				p.logger.Error("Failed to write download stats", zap.Error(err))
+			}
+		}()
+		go p.stats.RunFlush(flushCtx, p.logger, p.db, p.config.Stats.FlushInterval)
+	}
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	atomic.StoreInt32(&p.shuttingDown, 1)
+	if p.config.ShutdownDelay > 0 {
+		p.logger.Info("Shutting down", zap.Duration("delay", p.config.ShutdownDelay))
+		time.Sleep(p.config.ShutdownDelay)
+	}
+	p.logger.Info("Waiting for active requests to finish")
+
+	shutdownCtx := context.Background()
+	if p.config.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, p.config.ShutdownTimeout)
+		defer cancel()
+	}
+	err := server.Shutdown(shutdownCtx)
+	if err != nil {
+		p.logger.Error("Failed to finish active requests", zap.Error(err))
+		server.Close()
+		return err
+	}
+	return nil
+}
+
+// RunProxy serves the published files over HTTP, until it receives SIGTERM or SIGINT.
+// The app database is only used for checking API keys.
+func RunProxy(logger *zap.Logger, sc *StorageConfig, appDatabaseConfig *pgx.ConnConfig, config ProxyConfig) error {
+	pool := NewStoragePool(logger, *sc, config.StorageSessions)
+	defer pool.Close()
+
+	_, err := pool.Check()
+	if err != nil {
+		logger.Error("Storage is not available", zap.Error(err))
+	}
+
+	p := &proxyServer{logger: logger, config: config, pool: pool}
+
+	p.storage = &instrumentedStorage{pool}
+	if config.Cache.ListingTTL > 0 {
+		p.storage = newListingCache(p.storage, config.Cache.ListingTTL)
+	}
+
+	if config.Cache.Dir != "" {
+		p.cache, err = newDiskCache(logger, config.Cache.Dir, config.Cache.MaxSize)
+		if err != nil {
+			logger.Error("Failed to create cache directory", zap.String("path", config.Cache.Dir), zap.Error(err))
+			return err
+		}
+		logger.Info("Caching files on disk", zap.String("path", config.Cache.Dir), zap.Int64("max_size", config.Cache.MaxSize))
+	}
+
+	if config.Auth.Enabled || config.Stats.Enabled {
+		p.db = newConnPool(appDatabaseConfig, 4)
+		defer p.db.Close()
+	}
+	if config.Auth.Enabled {
+		p.keys = newAPIKeyStore(p.db, config.Auth.CacheTTL)
+		logger.Info("Checking API keys", zap.Bool("required", config.Auth.Required))
+	}
+	if config.Stats.Enabled {
+		if p.config.Stats.FlushInterval <= 0 {
+			p.config.Stats.FlushInterval = time.Minute
+		}
+		p.stats = newDownloadStats()
+		logger.Info("Storing download stats", zap.Duration("flush_interval", p.config.Stats.FlushInterval))
+	}
+
+	listener, err := net.Listen("tcp", config.Addr)
 	if err != nil {
+		logger.Error("Failed to listen", zap.String("addr", config.Addr), zap.Error(err))
 		return err
 	}
-	defer storage.Close()
+	logger.Info("Serving data files", zap.String("addr", listener.Addr().String()), zap.String("prefix", config.PathPrefix), zap.Bool("tls", config.TLSCertFile != ""))
 
-	fs := &ProxyFilesystem{storage: storage, logger: logger}
-	http.Handle("/", http.FileServer(fs))
-	return http.ListenAndServe(":8080", nil)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	return p.Serve(ctx, listener)
 }
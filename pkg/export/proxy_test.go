@@ -0,0 +1,139 @@
+package export
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// blockingStorage blocks opening files until it is released.
+type blockingStorage struct {
+	Storage
+	opened  chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingStorage) Open(path string) (StorageFile, error) {
+	s.opened <- struct{}{}
+	<-s.release
+	return s.Storage.Open(path)
+}
+
+func newTestProxyServer(config ProxyConfig) *proxyServer {
+	pool, connector := newTestStoragePool(1)
+	return &proxyServer{logger: zap.NewNop(), config: config, pool: pool, storage: connector.storage}
+}
+
+func TestProxyServer_Handler(t *testing.T) {
+	p := newTestProxyServer(ProxyConfig{PathPrefix: "/data/"})
+	defer p.pool.Close()
+	handler := p.Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/data/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "data", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/data/2020/?format=json", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"name":"2020-03"`)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/data/latest", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	for _, path := range []string{"/healthz", "/readyz"} {
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		assert.Equal(t, http.StatusOK, rec.Code, path)
+	}
+
+	p.shuttingDown = 1
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestProxyServer_HandlerRateLimit(t *testing.T) {
+	p := newTestProxyServer(ProxyConfig{Auth: ProxyAuthConfig{IPLimit: RateLimit{RequestsPerMinute: 1, Burst: 2}}})
+	defer p.pool.Close()
+	handler := p.Handler()
+
+	// the limit is shared by all routes, so alternating between them doesn't help
+	var codes []int
+	for _, target := range []string{"/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", "/latest", "/export/fingerprint-update?from=2020-03-01&to=2020-03-01"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", target, nil))
+		codes = append(codes, rec.Code)
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code, "health checks are not limited")
+}
+
+func TestProxyServer_GracefulShutdown(t *testing.T) {
+	p := newTestProxyServer(ProxyConfig{ShutdownTimeout: 10 * time.Second})
+	defer p.pool.Close()
+	storage := &blockingStorage{Storage: p.storage, opened: make(chan struct{}), release: make(chan struct{})}
+	p.storage = storage
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	url := "http://" + listener.Addr().String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- p.Serve(ctx, listener) }()
+
+	type result struct {
+		body string
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		resp, err := http.Get(url + "/2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz")
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		results <- result{body: string(body), err: err}
+	}()
+
+	<-storage.opened
+	cancel()
+
+	select {
+	case <-done:
+		t.Fatal("the server stopped before the active request finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(storage.release)
+	res := <-results
+	require.NoError(t, res.err)
+	assert.Equal(t, "data", res.body)
+	assert.NoError(t, <-done)
+
+	_, err = http.Get(url + "/healthz")
+	assert.Error(t, err, "the server doesn't accept new connections")
+}
@@ -0,0 +1,455 @@
+package export
+
+import (
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ed25519"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+type PruneConfig struct {
+	// Number of days for which daily files are kept, before they are rolled up into monthly files.
+	KeepDays int
+	// Number of days after which all files are deleted, zero means files are never deleted.
+	MaxDays int
+	// Number of days the exporter goes back to export missing days, daily files must be kept at least as long.
+	ExportMaxDays int
+	DryRun        bool
+	SigningKey    ed25519.PrivateKey
+}
+
+func MonthlyManifestFileName(month time.Time) string {
+	return fmt.Sprintf("%s-manifest.json", month.Format("2006-01"))
+}
+
+// monthDirectory contains the daily files of one month.
+type monthDirectory struct {
+	month time.Time
+	path  string
+	// daily data files grouped by their name without the date prefix, e.g. "fingerprint-update.jsonl.gz"
+	dailyFiles map[string][]string
+	// all other files, i.e. manifests, signatures and monthly files
+	otherFiles []string
+}
+
+func (d *monthDirectory) suffixes() []string {
+	suffixes := make([]string, 0, len(d.dailyFiles))
+	for suffix := range d.dailyFiles {
+		suffixes = append(suffixes, suffix)
+	}
+	sort.Strings(suffixes)
+	return suffixes
+}
+
+func isDailyFileName(name string) bool {
+	if len(name) < 11 || name[10] != '-' {
+		return false
+	}
+	_, err := time.Parse("2006-01-02", name[:10])
+	return err == nil
+}
+
+func (ex *exporter) listMonthDirectories(location *time.Location) ([]*monthDirectory, error) {
+	years, err := ex.storage.ReadDir("")
+	if err != nil {
+		return nil, err
+	}
+	var months []*monthDirectory
+	for _, year := range years {
+		if !year.IsDir() || len(year.Name()) != 4 {
+			continue
+		}
+		entries, err := ex.storage.ReadDir(year.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || !strings.HasPrefix(entry.Name(), year.Name()+"-") {
+				continue
+			}
+			month, err := time.ParseInLocation("2006-01", entry.Name(), location)
+			if err != nil {
+				continue
+			}
+			dir := &monthDirectory{month: month, path: ex.storage.Join(year.Name(), entry.Name()), dailyFiles: make(map[string][]string)}
+			files, err := ex.storage.ReadDir(dir.path)
+			if err != nil {
+				return nil, err
+			}
+			for _, file := range files {
+				name := file.Name()
+				if isDailyFileName(name) && strings.HasSuffix(name, ".gz") {
+					dir.dailyFiles[name[11:]] = append(dir.dailyFiles[name[11:]], name)
+				} else {
+					dir.otherFiles = append(dir.otherFiles, name)
+				}
+			}
+			for _, names := range dir.dailyFiles {
+				sort.Strings(names)
+			}
+			months = append(months, dir)
+		}
+	}
+	return months, nil
+}
+
+func (ex *exporter) removeFile(path string, dryRun bool) error {
+	if dryRun {
+		ex.logger.Info("Would delete file", zap.String("path", path))
+		return nil
+	}
+	ex.logger.Info("Deleting file", zap.String("path", path))
+	err := ex.storage.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		ex.logger.Error("Failed to delete file", zap.String("path", path), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// rollupSource is a daily file copied into a monthly file, either from the daily file itself,
+// or from the previous version of the monthly file.
+type rollupSource struct {
+	name   string
+	path   string
+	offset int64
+	// -1 if the whole file is copied
+	size int64
+}
+
+// isRolledUp checks if a daily file is identical to the copy in the monthly file, i.e. if it was rolled up
+// but not deleted. Daily files which were re-exported or backfilled after the rollup differ.
+func (ex *exporter) isRolledUp(path string, source *ManifestFile) (bool, error) {
+	if source == nil {
+		return false, nil
+	}
+	file, err := ComputeManifestFile(ex.storage, path)
+	if err != nil {
+		return false, err
+	}
+	return file.Size == source.Size && file.SHA256 == source.SHA256, nil
+}
+
+// RollupMonth concatenates the daily files of a month into monthly files, one per table, and deletes the daily files.
+// Only JSONL files are rolled up, since CSV and TSV files have headers and can't be simply concatenated.
+// The monthly manifest lists the daily files in each monthly file, and the daily manifests are kept, with their
+// entries pointing to the data in the monthly files. Daily files which appear again after the rollup, because
+// they were re-exported or backfilled, are rolled up into a new version of the monthly file.
+func (ex *exporter) RollupMonth(dir *monthDirectory, dryRun bool) error {
+	logger := ex.logger.With(zap.String("directory", dir.path))
+
+	var suffixes []string
+	for _, suffix := range dir.suffixes() {
+		if FormatFromFileName(suffix) == FormatJSONL {
+			suffixes = append(suffixes, suffix)
+		}
+	}
+	if len(suffixes) == 0 {
+		return nil
+	}
+
+	manifestPath := ex.storage.Join(dir.path, MonthlyManifestFileName(dir.month))
+	manifest, err := ReadManifest(ex.storage, manifestPath)
+	if err != nil {
+		logger.Error("Failed to read monthly manifest", zap.Error(err))
+		return err
+	}
+	if manifest == nil {
+		manifest = &Manifest{Date: dir.month.Format("2006-01")}
+	}
+
+	var rolledUp []string
+	for _, suffix := range suffixes {
+		names := dir.dailyFiles[suffix]
+		monthlyName := dir.month.Format("2006-01") + "-" + suffix
+		monthlyPath := ex.storage.Join(dir.path, monthlyName)
+
+		previous := make(map[string]*ManifestFile)
+		if monthly := manifest.Find(monthlyName); monthly != nil {
+			for i := range monthly.Sources {
+				previous[monthly.Sources[i].Name] = &monthly.Sources[i]
+			}
+		}
+
+		added := make(map[string]bool)
+		for _, name := range names {
+			path := ex.storage.Join(dir.path, name)
+			ok, err := ex.isRolledUp(path, previous[name])
+			if err != nil {
+				logger.Error("Failed to check daily file", zap.String("path", path), zap.Error(err))
+				return err
+			}
+			if !ok {
+				added[name] = true
+			}
+		}
+
+		if len(added) == 0 {
+			// the daily files were already rolled up, but not deleted
+			rolledUp = append(rolledUp, names...)
+			continue
+		}
+
+		if dryRun {
+			logger.Info("Would roll up daily files", zap.String("path", monthlyPath), zap.Int("files", len(added)))
+			rolledUp = append(rolledUp, names...)
+			continue
+		}
+
+		var sources []rollupSource
+		for name := range added {
+			sources = append(sources, rollupSource{name: name, path: ex.storage.Join(dir.path, name), size: -1})
+		}
+		for name, source := range previous {
+			if !added[name] {
+				sources = append(sources, rollupSource{name: name, path: monthlyPath, offset: source.Offset, size: source.Size})
+			}
+		}
+		sort.Slice(sources, func(i, j int) bool { return sources[i].name < sources[j].name })
+
+		logger.Info("Rolling up daily files", zap.String("path", monthlyPath), zap.Int("files", len(added)), zap.Int("previous_files", len(sources)-len(added)))
+		file, err := ex.concatenateFiles(sources, monthlyPath)
+		if err != nil {
+			logger.Error("Failed to roll up daily files", zap.String("path", monthlyPath), zap.Error(err))
+			return err
+		}
+		if strings.Contains(suffix, "."+FingerprintEncodingCompressed+".") {
+			file.FingerprintEncoding = FingerprintEncodingCompressed
+			for i := range file.Sources {
+				file.Sources[i].FingerprintEncoding = FingerprintEncodingCompressed
+			}
+		}
+		err = ex.SignFile(monthlyPath, file.SHA256)
+		if err != nil {
+			return err
+		}
+		if monthly := manifest.Find(monthlyName); monthly != nil {
+			*monthly = *file
+		} else {
+			manifest.Files = append(manifest.Files, *file)
+			sort.Slice(manifest.Files, func(i, j int) bool { return manifest.Files[i].Name < manifest.Files[j].Name })
+		}
+
+		// the manifest is updated after each file, so that daily files are never deleted before
+		// the monthly file is listed in the manifest
+		err = ex.WriteManifest(manifestPath, manifest)
+		if err != nil {
+			return err
+		}
+		rolledUp = append(rolledUp, names...)
+	}
+
+	if len(rolledUp) == 0 {
+		return nil
+	}
+
+	if !dryRun {
+		err = ex.updateDailyManifests(dir, manifest)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range rolledUp {
+		path := ex.storage.Join(dir.path, name)
+		for _, p := range []string{SignatureFileName(path), path} {
+			err := ex.removeFile(p, dryRun)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateDailyManifests points the entries of daily manifests to the data in the monthly files.
+func (ex *exporter) updateDailyManifests(dir *monthDirectory, monthlyManifest *Manifest) error {
+	days := make(map[string]map[string]ManifestFile)
+	for _, monthly := range monthlyManifest.Files {
+		for _, source := range monthly.Sources {
+			day := source.Name[:10]
+			if days[day] == nil {
+				days[day] = make(map[string]ManifestFile)
+			}
+			source.Monthly = monthly.Name
+			days[day][source.Name] = source
+		}
+	}
+
+	for day, sources := range days {
+		path := ex.storage.Join(dir.path, day+"-manifest.json")
+		manifest, err := ReadManifest(ex.storage, path)
+		if err != nil {
+			ex.logger.Error("Failed to read daily manifest", zap.String("path", path), zap.Error(err))
+			return err
+		}
+		if manifest == nil {
+			continue
+		}
+		changed := false
+		for i, file := range manifest.Files {
+			source, ok := sources[file.Name]
+			if ok && (file.Monthly != source.Monthly || file.Offset != source.Offset || file.SHA256 != source.SHA256) {
+				// column types are only known from the export of the daily file
+				source.JSONColumns = file.JSONColumns
+				manifest.Files[i] = source
+				changed = true
+			}
+		}
+		if changed {
+			err = ex.WriteManifest(path, manifest)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// concatenateFiles creates a file by concatenating raw gzip data of multiple files. The returned entry lists
+// the copied files with their offsets in the new file.
+func (ex *exporter) concatenateFiles(sources []rollupSource, path string) (*ManifestFile, error) {
+	tempPath := ex.makeTempPath(path)
+	file, err := ex.storage.Create(tempPath)
+	if err != nil {
+		return nil, err
+	}
+
+	checksumFile := newChecksumWriter(file)
+	var rows int64
+	var files []ManifestFile
+	for _, source := range sources {
+		offset := checksumFile.Size()
+		checksumSource := newChecksumWriter(checksumFile)
+		n, err := ex.appendGzipFileRange(checksumSource, source.path, source.offset, source.size)
+		if err == nil && source.size >= 0 && checksumSource.Size() != source.size {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			file.Close()
+			ex.storage.Remove(tempPath)
+			return nil, fmt.Errorf("failed to copy %s: %w", source.name, err)
+		}
+		rows += n
+		files = append(files, ManifestFile{
+			Name:   source.name,
+			Size:   checksumSource.Size(),
+			SHA256: checksumSource.Sum(),
+			Rows:   n,
+			Offset: offset,
+		})
+	}
+
+	err = file.Close()
+	if err != nil {
+		ex.storage.Remove(tempPath)
+		return nil, err
+	}
+
+	err = ex.storage.PosixRename(tempPath, path)
+	if err != nil {
+		ex.storage.Remove(tempPath)
+		return nil, err
+	}
+
+	_, fileName := ex.storage.Split(path)
+	return &ManifestFile{
+		Name:    fileName,
+		Size:    checksumFile.Size(),
+		SHA256:  checksumFile.Sum(),
+		Rows:    rows,
+		Sources: files,
+	}, nil
+}
+
+// DeleteMonth deletes all files of a month, and the parent directories if they become empty.
+func (ex *exporter) DeleteMonth(dir *monthDirectory, dryRun bool) error {
+	files, err := ex.storage.ReadDir(dir.path)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		err = ex.removeFile(ex.storage.Join(dir.path, file.Name()), dryRun)
+		if err != nil {
+			return err
+		}
+	}
+	if dryRun {
+		return nil
+	}
+	err = ex.storage.Remove(dir.path)
+	if err != nil {
+		ex.logger.Error("Failed to delete directory", zap.String("path", dir.path), zap.Error(err))
+		return err
+	}
+	yearPath, _ := ex.storage.Split(dir.path)
+	yearPath = strings.TrimSuffix(yearPath, "/")
+	entries, err := ex.storage.ReadDir(yearPath)
+	if err == nil && len(entries) == 0 {
+		ex.storage.Remove(yearPath)
+	}
+	return nil
+}
+
+// Prune applies the retention policy to all published files. Only months that are completely
+// older than the retention period are processed.
+func (ex *exporter) Prune(now time.Time, config PruneConfig) error {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	rollupCutoff := today.AddDate(0, 0, -config.KeepDays)
+	var deleteCutoff time.Time
+	if config.MaxDays > 0 {
+		deleteCutoff = today.AddDate(0, 0, -config.MaxDays)
+	}
+
+	months, err := ex.listMonthDirectories(now.Location())
+	if err != nil {
+		ex.logger.Error("Failed to list directories", zap.Error(err))
+		return err
+	}
+
+	for _, dir := range months {
+		monthEnd := dir.month.AddDate(0, 1, 0)
+		if !deleteCutoff.IsZero() && !monthEnd.After(deleteCutoff) {
+			err = ex.DeleteMonth(dir, config.DryRun)
+		} else if !monthEnd.After(rollupCutoff) {
+			err = ex.RollupMonth(dir, config.DryRun)
+		} else {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PruneAll rolls up and deletes old files, holding the export lock, so that files are not pruned
+// while they are being exported.
+func PruneAll(logger *zap.Logger, sc StorageConfig, databaseConfig *pgx.ConnConfig, config PruneConfig) error {
+	if config.MaxDays > 0 && config.MaxDays < config.KeepDays {
+		return fmt.Errorf("files can't be deleted after %d days, if daily files are kept for %d days", config.MaxDays, config.KeepDays)
+	}
+	if config.KeepDays < config.ExportMaxDays {
+		return fmt.Errorf("daily files must be kept for at least %d days, the number of days exported", config.ExportMaxDays)
+	}
+
+	storage, err := NewStorageClient(logger, sc)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+
+	ex := &exporter{
+		logger:     logger,
+		storage:    storage,
+		signingKey: config.SigningKey,
+	}
+	return withExportLock(logger, databaseConfig, func() error {
+		return ex.Prune(time.Now(), config)
+	})
+}
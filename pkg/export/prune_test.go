@@ -0,0 +1,174 @@
+package export
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// writePruneTestDay writes a daily file of the track-update table and a manifest listing it and the given other files.
+func writePruneTestDay(t *testing.T, storage *memStorage, day string, data string, otherFiles ...string) {
+	dir := "2020/" + day[:7] + "/"
+	storage.WriteFile(dir+day+"-track-update.jsonl.gz", gzipData(t, data))
+	storage.WriteFile(dir+day+"-track-update.jsonl.gz.sig", []byte("signature\n"))
+	manifest := &Manifest{Date: day}
+	for _, name := range append([]string{day + "-track-update.jsonl.gz"}, otherFiles...) {
+		file, err := ComputeManifestFile(storage, dir+name)
+		require.NoError(t, err)
+		manifest.Files = append(manifest.Files, *file)
+	}
+	data2, err := EncodeManifest(manifest)
+	require.NoError(t, err)
+	storage.WriteFile(dir+day+"-manifest.json", data2)
+}
+
+func newPruneTestStorage(t *testing.T) *memStorage {
+	storage := newMemStorage()
+	storage.WriteFile("2020/2020-01/2020-01-02-meta-update.csv.gz", gzipData(t, "id\n1\n"))
+	writePruneTestDay(t, storage, "2020-01-01", "{\"day\":\"2020-01-01\"}\n")
+	writePruneTestDay(t, storage, "2020-01-02", "{\"day\":\"2020-01-02\"}\n", "2020-01-02-meta-update.csv.gz")
+	writePruneTestDay(t, storage, "2020-01-31", "{\"day\":\"2020-01-31\"}\n")
+	writePruneTestDay(t, storage, "2020-02-20", "{\"day\":\"2020-02-20\"}\n")
+	return storage
+}
+
+// readPruneTestDay reads the track-update data of a day through its manifest.
+func readPruneTestDay(t *testing.T, storage *memStorage, day string) string {
+	dir := "2020/" + day[:7]
+	manifest, err := ReadManifest(storage, dir+"/"+day+"-manifest.json")
+	require.NoError(t, err)
+	file := manifest.Find(day + "-track-update.jsonl.gz")
+	require.NotNil(t, file)
+	reader, err := OpenManifestFile(storage, dir, file)
+	require.NoError(t, err)
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, file.Size, int64(len(data)))
+	return gunzipData(t, data)
+}
+
+func TestPrune_Rollup(t *testing.T) {
+	storage := newPruneTestStorage(t)
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+
+	now := time.Date(2020, 3, 20, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, ex.Prune(now, PruneConfig{KeepDays: 30}))
+
+	assert.Equal(t, []string{
+		"2020/2020-01/2020-01-01-manifest.json",
+		"2020/2020-01/2020-01-02-manifest.json",
+		"2020/2020-01/2020-01-02-meta-update.csv.gz",
+		"2020/2020-01/2020-01-31-manifest.json",
+		"2020/2020-01/2020-01-manifest.json",
+		"2020/2020-01/2020-01-track-update.jsonl.gz",
+		"2020/2020-02/2020-02-20-manifest.json",
+		"2020/2020-02/2020-02-20-track-update.jsonl.gz",
+		"2020/2020-02/2020-02-20-track-update.jsonl.gz.sig",
+	}, storage.Paths())
+
+	data, ok := storage.ReadFile("2020/2020-01/2020-01-track-update.jsonl.gz")
+	require.True(t, ok)
+	assert.Equal(t, "{\"day\":\"2020-01-01\"}\n{\"day\":\"2020-01-02\"}\n{\"day\":\"2020-01-31\"}\n", gunzipData(t, data))
+
+	manifest, err := ReadManifest(storage, "2020/2020-01/2020-01-manifest.json")
+	require.NoError(t, err)
+	require.Len(t, manifest.Files, 1)
+	assert.Equal(t, "2020-01", manifest.Date)
+	assert.Equal(t, int64(3), manifest.Files[0].Rows)
+	computed, err := ComputeManifestFile(storage, "2020/2020-01/2020-01-track-update.jsonl.gz")
+	require.NoError(t, err)
+	assert.Equal(t, computed.SHA256, manifest.Files[0].SHA256)
+	assert.Equal(t, computed.Size, manifest.Files[0].Size)
+	require.Len(t, manifest.Files[0].Sources, 3)
+	assert.Equal(t, "2020-01-31-track-update.jsonl.gz", manifest.Files[0].Sources[2].Name)
+
+	// daily manifests point to the data in the monthly file
+	assert.Equal(t, "{\"day\":\"2020-01-01\"}\n", readPruneTestDay(t, storage, "2020-01-01"))
+	assert.Equal(t, "{\"day\":\"2020-01-31\"}\n", readPruneTestDay(t, storage, "2020-01-31"))
+	dailyManifest, err := ReadManifest(storage, "2020/2020-01/2020-01-02-manifest.json")
+	require.NoError(t, err)
+	require.Len(t, dailyManifest.Files, 2)
+	assert.Equal(t, "2020-01-track-update.jsonl.gz", dailyManifest.Files[0].Monthly)
+	assert.Equal(t, "", dailyManifest.Files[1].Monthly)
+
+	// running again doesn't change anything
+	require.NoError(t, ex.Prune(now, PruneConfig{KeepDays: 30}))
+	data2, _ := storage.ReadFile("2020/2020-01/2020-01-track-update.jsonl.gz")
+	assert.Equal(t, data, data2)
+}
+
+func TestPrune_RollupAgain(t *testing.T) {
+	storage := newPruneTestStorage(t)
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+
+	now := time.Date(2020, 3, 20, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, ex.Prune(now, PruneConfig{KeepDays: 30}))
+	data, _ := storage.ReadFile("2020/2020-01/2020-01-track-update.jsonl.gz")
+
+	// a daily file which was rolled up, but not deleted, is only deleted
+	storage.WriteFile("2020/2020-01/2020-01-31-track-update.jsonl.gz", gzipData(t, "{\"day\":\"2020-01-31\"}\n"))
+	require.NoError(t, ex.Prune(now, PruneConfig{KeepDays: 30}))
+	data2, _ := storage.ReadFile("2020/2020-01/2020-01-track-update.jsonl.gz")
+	assert.Equal(t, data, data2)
+	_, ok := storage.ReadFile("2020/2020-01/2020-01-31-track-update.jsonl.gz")
+	assert.False(t, ok)
+
+	// backfilled and newly exported daily files are rolled up into a new monthly file
+	writePruneTestDay(t, storage, "2020-01-02", "{\"day\":\"2020-01-02\",\"backfill\":true}\n", "2020-01-02-meta-update.csv.gz")
+	writePruneTestDay(t, storage, "2020-01-15", "{\"day\":\"2020-01-15\"}\n")
+	require.NoError(t, ex.Prune(now, PruneConfig{KeepDays: 30}))
+
+	data, _ = storage.ReadFile("2020/2020-01/2020-01-track-update.jsonl.gz")
+	assert.Equal(t, "{\"day\":\"2020-01-01\"}\n{\"day\":\"2020-01-02\",\"backfill\":true}\n{\"day\":\"2020-01-15\"}\n{\"day\":\"2020-01-31\"}\n", gunzipData(t, data))
+	for _, day := range []string{"2020-01-01", "2020-01-15", "2020-01-31"} {
+		assert.Equal(t, "{\"day\":\""+day+"\"}\n", readPruneTestDay(t, storage, day))
+	}
+	assert.Equal(t, "{\"day\":\"2020-01-02\",\"backfill\":true}\n", readPruneTestDay(t, storage, "2020-01-02"))
+	for _, path := range storage.Paths() {
+		if strings.HasPrefix(path, "2020/2020-01/") && isDailyFileName(strings.TrimPrefix(path, "2020/2020-01/")) {
+			assert.NotContains(t, path, "-track-update.jsonl.gz", "daily files were deleted")
+		}
+	}
+
+	manifest, err := ReadManifest(storage, "2020/2020-01/2020-01-manifest.json")
+	require.NoError(t, err)
+	require.Len(t, manifest.Files, 1)
+	assert.Equal(t, int64(4), manifest.Files[0].Rows)
+	assert.Len(t, manifest.Files[0].Sources, 4)
+}
+
+func TestPrune_DryRun(t *testing.T) {
+	storage := newPruneTestStorage(t)
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+	paths := storage.Paths()
+
+	now := time.Date(2020, 3, 20, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, ex.Prune(now, PruneConfig{KeepDays: 1, MaxDays: 45, DryRun: true}))
+	assert.Equal(t, paths, storage.Paths())
+}
+
+func TestPrune_Delete(t *testing.T) {
+	storage := newPruneTestStorage(t)
+	ex := &exporter{storage: storage, logger: zap.NewNop()}
+
+	now := time.Date(2020, 3, 20, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, ex.Prune(now, PruneConfig{KeepDays: 40, MaxDays: 45}))
+	assert.Equal(t, []string{
+		"2020/2020-02/2020-02-20-manifest.json",
+		"2020/2020-02/2020-02-20-track-update.jsonl.gz",
+		"2020/2020-02/2020-02-20-track-update.jsonl.gz.sig",
+	}, storage.Paths())
+	_, err := storage.Stat("2020/2020-01")
+	assert.Error(t, err)
+}
+
+func TestPruneAll_KeepDays(t *testing.T) {
+	err := PruneAll(zap.NewNop(), StorageConfig{}, nil, PruneConfig{KeepDays: 7, ExportMaxDays: 30})
+	assert.Error(t, err)
+}
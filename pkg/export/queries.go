@@ -61,3 +61,60 @@ WHERE
   OR
   (updated >= '{{.StartTime}}' AND updated < '{{.EndTime}}')
 `
+
+const ExportForeignIDVendorUpdateQuery = `
+SELECT id, name, created
+FROM foreignid_vendor
+WHERE created >= '{{.StartTime}}' AND created < '{{.EndTime}}'
+`
+
+const ExportForeignIDUpdateQuery = `
+SELECT id, vendor_id, name, created
+FROM foreignid
+WHERE created >= '{{.StartTime}}' AND created < '{{.EndTime}}'
+`
+
+const ExportTrackForeignIDUpdateQuery = `
+SELECT id, track_id, foreignid_id, submission_count, created, updated
+FROM track_foreignid
+WHERE
+  (created >= '{{.StartTime}}' AND created < '{{.EndTime}}')
+  OR
+  (updated >= '{{.StartTime}}' AND updated < '{{.EndTime}}')
+`
+
+const ExportChangesUpdateQuery = `
+SELECT id, table_name, operation, record_id, new_id, created
+FROM change_log
+WHERE created >= '{{.StartTime}}' AND created < '{{.EndTime}}'
+`
+
+type builtinTable struct {
+	name  string
+	query string
+	delta bool
+}
+
+// builtinTables are exported by default, their settings can be overridden in the configuration.
+var builtinTables = []builtinTable{
+	{"fingerprint-update", ExportFingerprintUpdateQuery, true},
+	{"meta-update", ExportMetaUpdateQuery, true},
+	{"track-update", ExportTrackUpdateQuery, true},
+	{"track_fingerprint-update", ExportTrackFingerprintUpdateQuery, true},
+	{"track_mbid-update", ExportTrackMbidUpdateQuery, true},
+	{"track_puid-update", ExportTrackPuidUpdateQuery, true},
+	{"track_meta-update", ExportTrackMetaUpdateQuery, true},
+	{"foreignid_vendor-update", ExportForeignIDVendorUpdateQuery, true},
+	{"foreignid-update", ExportForeignIDUpdateQuery, true},
+	{"track_foreignid-update", ExportTrackForeignIDUpdateQuery, true},
+	{"changes-update", ExportChangesUpdateQuery, true},
+}
+
+func isBuiltinTable(name string) bool {
+	for _, table := range builtinTables {
+		if table.name == name {
+			return true
+		}
+	}
+	return false
+}
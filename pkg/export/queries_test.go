@@ -0,0 +1,143 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/acoustid/acoustid/database"
+	"github.com/acoustid/acoustid/pkg/migrate"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTestFingerprintDatabase creates a scratch database with the fingerprint schema, which is dropped after the test.
+func newTestFingerprintDatabase(t *testing.T) *pgx.Conn {
+	config := testDatabaseConfig(t)
+	ctx := context.Background()
+
+	admin, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Close(ctx) })
+
+	name := fmt.Sprintf("acoustid_test_%d", time.Now().UnixNano())
+	_, err = admin.Exec(ctx, "CREATE DATABASE "+pgx.Identifier{name}.Sanitize())
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Exec(ctx, "DROP DATABASE IF EXISTS "+pgx.Identifier{name}.Sanitize()) })
+
+	dbConfig := *config
+	dbConfig.Database = name
+	conn, err := pgx.ConnectConfig(ctx, &dbConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close(ctx) })
+
+	_, err = migrate.Init(ctx, zap.NewNop(), conn, database.SQL, "fingerprint", migrate.InitConfig{})
+	require.NoError(t, err)
+	return conn
+}
+
+func TestExportChangesUpdateQuery(t *testing.T) {
+	conn := newTestFingerprintDatabase(t)
+	ctx := context.Background()
+
+	statements := []string{
+		"INSERT INTO track (id, gid) VALUES (1, '9f4a3a4b-6d7e-4c1a-8b1e-1a2b3c4d5e01'), (2, '9f4a3a4b-6d7e-4c1a-8b1e-1a2b3c4d5e02'), (3, '9f4a3a4b-6d7e-4c1a-8b1e-1a2b3c4d5e03')",
+		"INSERT INTO track_mbid (id, track_id, mbid, submission_count) VALUES (10, 1, 'b81f83ee-4da4-11e0-9ed8-0025225356f3', 1)",
+		"UPDATE track SET new_id = 2 WHERE id = 1",
+		// updates of other columns and of new_id to the same value are not merges
+		"UPDATE track SET updated = now() WHERE id = 1",
+		"UPDATE track SET new_id = 2 WHERE id = 1",
+		"DELETE FROM track_mbid WHERE id = 10",
+		"DELETE FROM track WHERE id = 3",
+	}
+	for _, statement := range statements {
+		_, err := conn.Exec(ctx, statement)
+		require.NoError(t, err, statement)
+	}
+
+	ex := &exporter{}
+	query, err := ex.RenderQueryTemplate(ExportChangesUpdateQuery, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	rows, err := conn.Query(ctx, query+" ORDER BY id")
+	require.NoError(t, err)
+	defer rows.Close()
+	var changes []string
+	for rows.Next() {
+		var (
+			id                   int64
+			tableName, operation string
+			recordID             int
+			newID                *int
+			created              time.Time
+		)
+		require.NoError(t, rows.Scan(&id, &tableName, &operation, &recordID, &newID, &created))
+		change := fmt.Sprintf("%s %s %d", tableName, operation, recordID)
+		if newID != nil {
+			change += fmt.Sprintf(" -> %d", *newID)
+		}
+		changes = append(changes, change)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"track merge 1 -> 2", "track_mbid delete 10", "track delete 3"}, changes)
+
+	query, err = ex.RenderQueryTemplate(ExportChangesUpdateQuery, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, conn.QueryRow(ctx, "SELECT count(*) FROM ("+query+") q").Scan(&count))
+	assert.Equal(t, 0, count, "changes outside of the time range are not exported")
+}
+
+func TestExportTrackForeignIDUpdateQuery(t *testing.T) {
+	conn := newTestFingerprintDatabase(t)
+	ctx := context.Background()
+
+	statements := []string{
+		"INSERT INTO track (id, gid) VALUES (1, '9f4a3a4b-6d7e-4c1a-8b1e-1a2b3c4d5e01')",
+		"INSERT INTO foreignid_vendor (id, name) VALUES (1, 'mbid')",
+		"INSERT INTO foreignid (id, vendor_id, name) VALUES (1, 1, 'a'), (2, 1, 'b')",
+		"INSERT INTO track_foreignid (id, track_id, foreignid_id, submission_count, created) VALUES (1, 1, 1, 1, now() - interval '2 days'), (2, 1, 2, 1, now() - interval '2 days')",
+	}
+	for _, statement := range statements {
+		_, err := conn.Exec(ctx, statement)
+		require.NoError(t, err, statement)
+	}
+
+	ex := &exporter{}
+	query, err := ex.RenderQueryTemplate(ExportTrackForeignIDUpdateQuery, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	countRows := func() int {
+		var count int
+		require.NoError(t, conn.QueryRow(ctx, "SELECT count(*) FROM ("+query+") q").Scan(&count))
+		return count
+	}
+	assert.Equal(t, 0, countRows())
+
+	// the trigger sets updated, so changed rows are exported again
+	_, err = conn.Exec(ctx, "UPDATE track_foreignid SET submission_count = submission_count + 1 WHERE id = 1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, countRows())
+
+	// updates which don't change the row don't set it
+	_, err = conn.Exec(ctx, "UPDATE track_foreignid SET submission_count = submission_count WHERE id = 2")
+	require.NoError(t, err)
+	assert.Equal(t, 1, countRows())
+}
+
+func TestQueryColumns(t *testing.T) {
+	conn := newTestFingerprintDatabase(t)
+	ctx := context.Background()
+
+	ex := &exporter{}
+	query, err := ex.RenderQueryTemplate(ExportFingerprintUpdateQuery, time.Now().Add(-time.Hour), time.Now())
+	require.NoError(t, err)
+	columns, jsonColumns, err := ex.QueryColumns(ctx, conn, query)
+	require.NoError(t, err)
+	assert.Contains(t, columns, "created")
+	assert.Contains(t, jsonColumns, "id")
+	assert.Contains(t, jsonColumns, "fingerprint")
+	assert.NotContains(t, jsonColumns, "created")
+}
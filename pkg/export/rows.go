@@ -0,0 +1,187 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/acoustid/acoustid/pkg/chromaprint"
+)
+
+const (
+	FingerprintEncodingArray      = "array"
+	FingerprintEncodingCompressed = "compressed"
+)
+
+// All fingerprints in the database were generated by the default Chromaprint algorithm.
+const exportFingerprintVersion = 1
+
+type rowField struct {
+	Name  string
+	Value json.RawMessage
+}
+
+type row []rowField
+
+func parseRow(data []byte) (row, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, errors.New("row is not a JSON object")
+	}
+	var r row
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := token.(string)
+		if !ok {
+			return nil, errors.New("invalid field name")
+		}
+		var value json.RawMessage
+		err = decoder.Decode(&value)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, rowField{Name: name, Value: value})
+	}
+	return r, nil
+}
+
+func (r row) Get(name string) (json.RawMessage, bool) {
+	for _, field := range r {
+		if field.Name == name {
+			return field.Value, true
+		}
+	}
+	return nil, false
+}
+
+func (r row) Set(name string, value json.RawMessage) {
+	for i := range r {
+		if r[i].Name == name {
+			r[i].Value = value
+			return
+		}
+	}
+}
+
+func (r row) AppendJSON(buf []byte) []byte {
+	buf = append(buf, '{')
+	for i, field := range r {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		name, _ := json.Marshal(field.Name)
+		buf = append(buf, name...)
+		buf = append(buf, ':')
+		buf = append(buf, field.Value...)
+	}
+	return append(buf, '}')
+}
+
+type rowTransform func(r row) error
+
+func compressFingerprintField(r row) error {
+	value, ok := r.Get("fingerprint")
+	if !ok {
+		return nil
+	}
+	var hashes []int32
+	err := json.Unmarshal(value, &hashes)
+	if err != nil {
+		return fmt.Errorf("invalid fingerprint: %v", err)
+	}
+	fp := chromaprint.Fingerprint{Version: exportFingerprintVersion, Hashes: make([]uint32, len(hashes))}
+	for i, hash := range hashes {
+		fp.Hashes[i] = uint32(hash)
+	}
+	encoded, err := json.Marshal(chromaprint.EncodeFingerprintToString(chromaprint.CompressFingerprint(fp)))
+	if err != nil {
+		return err
+	}
+	r.Set("fingerprint", encoded)
+	return nil
+}
+
+func decompressFingerprintField(r row) error {
+	value, ok := r.Get("fingerprint")
+	if !ok {
+		return nil
+	}
+	var str string
+	err := json.Unmarshal(value, &str)
+	if err != nil {
+		return fmt.Errorf("invalid fingerprint: %v", err)
+	}
+	fp, err := chromaprint.ParseFingerprintString(str)
+	if err != nil {
+		return err
+	}
+	hashes := make([]int32, len(fp.Hashes))
+	for i, hash := range fp.Hashes {
+		hashes[i] = int32(hash)
+	}
+	decoded, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+	r.Set("fingerprint", decoded)
+	return nil
+}
+
+// rowTransformWriter receives JSON rows, one per line, applies an optional transformation to each
+// of them and passes the resulting rows to the underlying row writer.
+type rowTransformWriter struct {
+	w         rowWriter
+	transform rowTransform
+	line      []byte
+}
+
+func newRowTransformWriter(w rowWriter, transform rowTransform) *rowTransformWriter {
+	return &rowTransformWriter{w: w, transform: transform}
+}
+
+func (tw *rowTransformWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i == -1 {
+			tw.line = append(tw.line, p...)
+			break
+		}
+		tw.line = append(tw.line, p[:i]...)
+		err := tw.writeLine()
+		if err != nil {
+			return 0, err
+		}
+		p = p[i+1:]
+	}
+	return n, nil
+}
+
+func (tw *rowTransformWriter) writeLine() error {
+	r, err := parseRow(tw.line)
+	if err != nil {
+		return err
+	}
+	if tw.transform != nil {
+		err = tw.transform(r)
+		if err != nil {
+			return err
+		}
+	}
+	tw.line = tw.line[:0]
+	return tw.w.WriteRow(r)
+}
+
+func (tw *rowTransformWriter) Close() error {
+	if len(tw.line) > 0 {
+		return tw.writeLine()
+	}
+	return nil
+}
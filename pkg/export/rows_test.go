@@ -0,0 +1,69 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/acoustid/acoustid/pkg/chromaprint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRow(t *testing.T) {
+	r, err := parseRow([]byte(`{"id":1,"name":"a\"b","tags":[1,2],"x":null}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "name", "tags", "x"}, []string{r[0].Name, r[1].Name, r[2].Name, r[3].Name})
+	assert.Equal(t, `{"id":1,"name":"a\"b","tags":[1,2],"x":null}`, string(r.AppendJSON(nil)))
+
+	_, err = parseRow([]byte(`[1,2]`))
+	assert.Error(t, err)
+}
+
+func TestCompressFingerprintField(t *testing.T) {
+	hashes := []uint32{0xdcfc2563, 0xdcbc2421, 0xddbc3420, 0x4f4ce540, 0x45bdff71}
+	signed := make([]int32, len(hashes))
+	for i, hash := range hashes {
+		signed[i] = int32(hash)
+	}
+	array, err := json.Marshal(signed)
+	require.NoError(t, err)
+
+	r := row{{Name: "id", Value: json.RawMessage("1")}, {Name: "fingerprint", Value: array}, {Name: "length", Value: json.RawMessage("120")}}
+	require.NoError(t, compressFingerprintField(r))
+
+	assert.Equal(t, "fingerprint", r[1].Name)
+	var encoded string
+	require.NoError(t, json.Unmarshal(r[1].Value, &encoded))
+	fp, err := chromaprint.ParseFingerprintString(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, exportFingerprintVersion, fp.Version)
+	assert.Equal(t, hashes, fp.Hashes)
+}
+
+func TestRowTransformWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := newRowTransformWriter(newJSONRowWriter(&buf), func(r row) error {
+		r.Set("id", json.RawMessage("0"))
+		return nil
+	})
+	input := "{\"id\":1,\"a\":\"x\"}\n{\"id\":2,\"a\":\"y\"}\n"
+	for i := 0; i < len(input); i += 5 {
+		end := i + 5
+		if end > len(input) {
+			end = len(input)
+		}
+		_, err := w.Write([]byte(input[i:end]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	assert.Equal(t, "{\"id\":0,\"a\":\"x\"}\n{\"id\":0,\"a\":\"y\"}\n", buf.String())
+}
+
+func TestDecompressFingerprintField(t *testing.T) {
+	array := json.RawMessage("[-587455133,-591649759,-574868448,1330439488,1169030001]")
+	r := row{{Name: "id", Value: json.RawMessage("1")}, {Name: "fingerprint", Value: array}}
+	require.NoError(t, compressFingerprintField(r))
+	require.NoError(t, decompressFingerprintField(r))
+	assert.Equal(t, string(array), string(r[1].Value))
+}
@@ -0,0 +1,79 @@
+package export
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"golang.org/x/crypto/ed25519"
+	"io"
+)
+
+// Exported files are signed by computing an ed25519 signature of the SHA-256 digest of the file
+// content, so that large files can be signed and verified without holding them in memory.
+// The signature is stored base64-encoded in a file with the ".sig" suffix next to the signed file.
+
+const SignatureFileSuffix = ".sig"
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+func SignatureFileName(path string) string {
+	return path + SignatureFileSuffix
+}
+
+func ParsePrivateKey(str string) (ed25519.PrivateKey, error) {
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, err
+	}
+	switch len(data) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(data), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(data), nil
+	}
+	return nil, errors.New("invalid private key size")
+}
+
+func ParsePublicKey(str string) (ed25519.PublicKey, error) {
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid public key size")
+	}
+	return ed25519.PublicKey(data), nil
+}
+
+func SignChecksum(privateKey ed25519.PrivateKey, checksum string) ([]byte, error) {
+	digest, err := hex.DecodeString(checksum)
+	if err != nil {
+		return nil, err
+	}
+	signature := ed25519.Sign(privateKey, digest)
+	encoded := base64.StdEncoding.EncodeToString(signature)
+	return []byte(encoded + "\n"), nil
+}
+
+func SignData(privateKey ed25519.PrivateKey, data []byte) ([]byte, error) {
+	digest := sha256.Sum256(data)
+	return SignChecksum(privateKey, hex.EncodeToString(digest[:]))
+}
+
+func VerifySignature(publicKey ed25519.PublicKey, r io.Reader, signature []byte) error {
+	decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	hash := sha256.New()
+	_, err = io.Copy(hash, r)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(publicKey, hash.Sum(nil), decoded) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package export
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestSignData(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x42}, ed25519.SeedSize)
+	privateKey, err := ParsePrivateKey(base64.StdEncoding.EncodeToString(seed))
+	require.NoError(t, err)
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+
+	data := []byte("{\"id\":1}\n")
+	signature, err := SignData(privateKey, data)
+	require.NoError(t, err)
+
+	t.Run("Valid", func(t *testing.T) {
+		err := VerifySignature(publicKey, bytes.NewReader(data), signature)
+		assert.NoError(t, err)
+	})
+	t.Run("ModifiedData", func(t *testing.T) {
+		err := VerifySignature(publicKey, bytes.NewReader([]byte("{\"id\":2}\n")), signature)
+		assert.Equal(t, ErrInvalidSignature, err)
+	})
+	t.Run("WrongKey", func(t *testing.T) {
+		otherPublicKey, _, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		err = VerifySignature(otherPublicKey, bytes.NewReader(data), signature)
+		assert.Equal(t, ErrInvalidSignature, err)
+	})
+	t.Run("Garbage", func(t *testing.T) {
+		err := VerifySignature(publicKey, bytes.NewReader(data), []byte("not a signature"))
+		assert.Equal(t, ErrInvalidSignature, err)
+	})
+}
+
+func TestParsePublicKey(t *testing.T) {
+	_, err := ParsePublicKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(t, err)
+
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	parsed, err := ParsePublicKey(base64.StdEncoding.EncodeToString(publicKey))
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, parsed)
+}
+
+func TestExportFile_SignsExistingFile(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	storage := newMemStorage()
+	data := gzipData(t, "{\"id\":1}\n")
+	storage.WriteFile("2020/2020-03/2020-03-01-track-update.jsonl.gz", data)
+	ex := &exporter{storage: storage, logger: zap.NewNop(), signingKey: privateKey}
+	table := &exporterTableInfo{name: "track-update", delta: true, format: FormatJSONL}
+
+	startTime := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	_, err = ex.exportFile(table, "2020/2020-03", "2020-03-01-track-update.jsonl.gz", startTime, startTime.AddDate(0, 0, 1), false, false)
+	require.NoError(t, err)
+
+	signature, ok := storage.ReadFile("2020/2020-03/2020-03-01-track-update.jsonl.gz.sig")
+	require.True(t, ok, "missing signature is written")
+	assert.NoError(t, VerifySignature(publicKey, bytes.NewReader(data), signature))
+}
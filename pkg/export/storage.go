@@ -29,6 +29,8 @@ type Storage interface {
 	MkdirAll(path string) error
 	Remove(path string) error
 	Rename(oldPath, newPath string) error
+	PosixRename(oldPath, newPath string) error
+	Link(oldPath, newPath string) error
 	Join(elem ...string) string
 	Split(path string) (string, string)
 }
@@ -43,8 +45,11 @@ type StorageConfig struct {
 
 type StorageClient struct {
 	config *StorageConfig
+	conn   *ssh.Client
 	client *sftp.Client
-	logger *zap.Logger
+	// session is the same as client, but it's not cleared by Close, so that Wait can use it concurrently
+	session *sftp.Client
+	logger  *zap.Logger
 }
 
 func (c *StorageClient) Close() error {
@@ -55,9 +60,18 @@ func (c *StorageClient) Close() error {
 		}
 		c.client = nil
 	}
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
 	return nil
 }
 
+// Wait blocks until the SFTP session is closed, either by Close or by a connection failure.
+func (c *StorageClient) Wait() error {
+	return c.session.Wait()
+}
+
 func (c *StorageClient) Stat(path string) (os.FileInfo, error) {
 	return c.client.Stat(sftp.Join(c.config.Path, path))
 }
@@ -90,6 +104,14 @@ func (c *StorageClient) Rename(oldPath, newPath string) error {
 	return c.client.Rename(sftp.Join(c.config.Path, oldPath), sftp.Join(c.config.Path, newPath))
 }
 
+func (c *StorageClient) PosixRename(oldPath, newPath string) error {
+	return c.client.PosixRename(sftp.Join(c.config.Path, oldPath), sftp.Join(c.config.Path, newPath))
+}
+
+func (c *StorageClient) Link(oldPath, newPath string) error {
+	return c.client.Link(sftp.Join(c.config.Path, oldPath), sftp.Join(c.config.Path, newPath))
+}
+
 func (c *StorageClient) Join(elem ...string) string {
 	return sftp.Join(elem...)
 }
@@ -119,10 +141,11 @@ func NewStorageClient(logger *zap.Logger, sc StorageConfig) (*StorageClient, err
 	client, err := sftp.NewClient(conn)
 	if err != nil {
 		logger.Error("Failed to open SFTP session", zap.Error(err))
+		conn.Close()
 		return nil, err
 	}
 
-	return &StorageClient{config: &sc, client: client, logger: logger}, nil
+	return &StorageClient{config: &sc, conn: conn, client: client, session: client, logger: logger}, nil
 }
 
 func CheckFileExists(storage Storage, path string) (bool, error) {
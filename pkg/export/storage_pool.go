@@ -0,0 +1,252 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"github.com/pkg/sftp"
+	"go.uber.org/zap"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	storageMinBackoff = time.Second
+	storageMaxBackoff = time.Minute
+)
+
+// storageSession is a connection to the storage, which can fail at any time.
+type storageSession interface {
+	Storage
+	Close() error
+	// Wait blocks until the session is closed.
+	Wait() error
+}
+
+// pooledSession reconnects a session after it failed, waiting longer after each failed attempt.
+type pooledSession struct {
+	mu       sync.Mutex
+	session  storageSession
+	failures int
+	retryAt  time.Time
+	err      error
+}
+
+// StoragePool is a storage, which spreads operations over multiple SFTP sessions, so that downloads can run
+// in parallel on separate connections. Dead sessions are detected and reconnected when they are needed again.
+type StoragePool struct {
+	logger     *zap.Logger
+	connect    func() (storageSession, error)
+	sessions   []*pooledSession
+	next       uint32
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	now        func() time.Time
+}
+
+func NewStoragePool(logger *zap.Logger, sc StorageConfig, size int) *StoragePool {
+	return newStoragePool(logger, size, func() (storageSession, error) {
+		return NewStorageClient(logger, sc)
+	})
+}
+
+func newStoragePool(logger *zap.Logger, size int, connect func() (storageSession, error)) *StoragePool {
+	if size < 1 {
+		size = 1
+	}
+	p := &StoragePool{
+		logger:     logger,
+		connect:    connect,
+		sessions:   make([]*pooledSession, size),
+		minBackoff: storageMinBackoff,
+		maxBackoff: storageMaxBackoff,
+		now:        time.Now,
+	}
+	for i := range p.sessions {
+		p.sessions[i] = &pooledSession{}
+	}
+	return p
+}
+
+func (p *StoragePool) backoff(failures int) time.Duration {
+	backoff := p.minBackoff
+	for i := 1; i < failures && backoff < p.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.maxBackoff {
+		backoff = p.maxBackoff
+	}
+	return backoff
+}
+
+// get returns the connected session, connecting it if needed. While waiting for the next reconnect attempt,
+// the last connection error is returned.
+func (p *StoragePool) get(ps *pooledSession) (storageSession, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.session != nil {
+		return ps.session, nil
+	}
+	now := p.now()
+	if now.Before(ps.retryAt) {
+		return nil, ps.err
+	}
+	session, err := p.connect()
+	if err != nil {
+		ps.failures++
+		ps.retryAt = now.Add(p.backoff(ps.failures))
+		ps.err = fmt.Errorf("storage is not available: %w", err)
+		p.logger.Warn("Failed to connect to storage", zap.Int("failures", ps.failures), zap.Time("retry_at", ps.retryAt), zap.Error(err))
+		return nil, ps.err
+	}
+	if ps.failures > 0 {
+		p.logger.Info("Reconnected to storage", zap.Int("failures", ps.failures))
+	}
+	ps.session = session
+	ps.failures = 0
+	ps.err = nil
+	go func() {
+		err := session.Wait()
+		p.logger.Debug("Storage session closed", zap.Error(err))
+		p.drop(ps, session)
+	}()
+	return session, nil
+}
+
+// drop closes a dead session, so that it is reconnected the next time it is needed.
+func (p *StoragePool) drop(ps *pooledSession, session storageSession) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.session != session {
+		return
+	}
+	ps.session = nil
+	session.Close()
+}
+
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, sftp.ErrSSHFxConnectionLost) ||
+		errors.Is(err, sftp.ErrSSHFxNoConnection) || errors.As(err, &netErr)
+}
+
+// do runs an operation on the next session. If the session turns out to be dead, it is dropped.
+// Read-only operations are then retried on another session.
+func (p *StoragePool) do(retry bool, fn func(s Storage) error) error {
+	attempts := 1
+	if retry {
+		attempts = len(p.sessions) + 1
+	}
+	var err error
+	for i := 0; i < attempts; i++ {
+		ps := p.sessions[int(atomic.AddUint32(&p.next, 1))%len(p.sessions)]
+		var session storageSession
+		session, err = p.get(ps)
+		if err != nil {
+			continue
+		}
+		err = fn(session)
+		if err == nil || !isConnectionError(err) {
+			return err
+		}
+		p.logger.Warn("Storage connection failed", zap.Error(err))
+		p.drop(ps, session)
+	}
+	return err
+}
+
+// Check verifies that at least one session can reach the storage, and returns the number of connected sessions.
+func (p *StoragePool) Check() (int, error) {
+	err := p.do(true, func(s Storage) error {
+		_, err := s.Stat("")
+		return err
+	})
+	connected := 0
+	for _, ps := range p.sessions {
+		ps.mu.Lock()
+		if ps.session != nil {
+			connected++
+		}
+		ps.mu.Unlock()
+	}
+	return connected, err
+}
+
+func (p *StoragePool) Close() error {
+	for _, ps := range p.sessions {
+		ps.mu.Lock()
+		if ps.session != nil {
+			ps.session.Close()
+			ps.session = nil
+		}
+		ps.mu.Unlock()
+	}
+	return nil
+}
+
+func (p *StoragePool) Stat(path string) (info os.FileInfo, err error) {
+	err = p.do(true, func(s Storage) error {
+		info, err = s.Stat(path)
+		return err
+	})
+	return info, err
+}
+
+func (p *StoragePool) ReadDir(path string) (infos []os.FileInfo, err error) {
+	err = p.do(true, func(s Storage) error {
+		infos, err = s.ReadDir(path)
+		return err
+	})
+	return infos, err
+}
+
+func (p *StoragePool) Open(path string) (file StorageFile, err error) {
+	err = p.do(true, func(s Storage) error {
+		file, err = s.Open(path)
+		return err
+	})
+	return file, err
+}
+
+func (p *StoragePool) Create(path string) (file StorageFile, err error) {
+	err = p.do(false, func(s Storage) error {
+		file, err = s.Create(path)
+		return err
+	})
+	return file, err
+}
+
+func (p *StoragePool) Mkdir(path string) error {
+	return p.do(false, func(s Storage) error { return s.Mkdir(path) })
+}
+
+func (p *StoragePool) MkdirAll(path string) error {
+	return p.do(false, func(s Storage) error { return s.MkdirAll(path) })
+}
+
+func (p *StoragePool) Remove(path string) error {
+	return p.do(false, func(s Storage) error { return s.Remove(path) })
+}
+
+func (p *StoragePool) Rename(oldPath, newPath string) error {
+	return p.do(false, func(s Storage) error { return s.Rename(oldPath, newPath) })
+}
+
+func (p *StoragePool) PosixRename(oldPath, newPath string) error {
+	return p.do(false, func(s Storage) error { return s.PosixRename(oldPath, newPath) })
+}
+
+func (p *StoragePool) Link(oldPath, newPath string) error {
+	return p.do(false, func(s Storage) error { return s.Link(oldPath, newPath) })
+}
+
+func (p *StoragePool) Join(elem ...string) string {
+	return sftp.Join(elem...)
+}
+
+func (p *StoragePool) Split(path string) (string, string) {
+	return sftp.Split(path)
+}
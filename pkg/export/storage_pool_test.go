@@ -0,0 +1,175 @@
+package export
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeSession is a storage session, which fails all operations after it was broken.
+type fakeSession struct {
+	*memStorage
+	mu     sync.Mutex
+	broken bool
+	closed chan struct{}
+	once   sync.Once
+}
+
+func (s *fakeSession) Break() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.broken = true
+}
+
+func (s *fakeSession) Stat(path string) (os.FileInfo, error) {
+	s.mu.Lock()
+	broken := s.broken
+	s.mu.Unlock()
+	if broken {
+		return nil, io.EOF
+	}
+	return s.memStorage.Stat(path)
+}
+
+func (s *fakeSession) Close() error {
+	s.once.Do(func() { close(s.closed) })
+	return nil
+}
+
+func (s *fakeSession) Wait() error {
+	<-s.closed
+	return nil
+}
+
+type fakeConnector struct {
+	storage  *memStorage
+	mu       sync.Mutex
+	fail     bool
+	sessions []*fakeSession
+}
+
+func (c *fakeConnector) Connect() (storageSession, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fail {
+		return nil, errors.New("connection refused")
+	}
+	session := &fakeSession{memStorage: c.storage, closed: make(chan struct{})}
+	c.sessions = append(c.sessions, session)
+	return session, nil
+}
+
+func (c *fakeConnector) SetFail(fail bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fail = fail
+}
+
+func (c *fakeConnector) Sessions() []*fakeSession {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*fakeSession(nil), c.sessions...)
+}
+
+func newTestStoragePool(size int) (*StoragePool, *fakeConnector) {
+	storage := newMemStorage()
+	storage.WriteFile("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz", []byte("data"))
+	connector := &fakeConnector{storage: storage}
+	return newStoragePool(zap.NewNop(), size, connector.Connect), connector
+}
+
+func TestStoragePool_Backoff(t *testing.T) {
+	pool, connector := newTestStoragePool(1)
+	defer pool.Close()
+	now := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	pool.now = func() time.Time { return now }
+
+	connector.SetFail(true)
+	_, err := pool.Stat("2020")
+	assert.Error(t, err)
+	assert.Equal(t, now.Add(time.Second), pool.sessions[0].retryAt)
+
+	now = now.Add(time.Second)
+	_, err = pool.Stat("2020")
+	assert.Error(t, err)
+	assert.Equal(t, now.Add(2*time.Second), pool.sessions[0].retryAt)
+
+	connector.SetFail(false)
+	_, err = pool.Stat("2020")
+	assert.Error(t, err, "no reconnect attempt before the backoff expires")
+	assert.Len(t, connector.Sessions(), 0)
+
+	now = now.Add(2 * time.Second)
+	info, err := pool.Stat("2020")
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+	assert.Equal(t, 0, pool.sessions[0].failures)
+}
+
+func TestStoragePool_Reconnect(t *testing.T) {
+	pool, connector := newTestStoragePool(2)
+	defer pool.Close()
+
+	for i := 0; i < 4; i++ {
+		_, err := pool.Stat("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz")
+		require.NoError(t, err)
+	}
+	require.Len(t, connector.Sessions(), 2, "operations are spread over all sessions")
+
+	for _, session := range connector.Sessions() {
+		session.Break()
+	}
+	_, err := pool.Stat("2020/2020-03/2020-03-01-fingerprint-update.jsonl.gz")
+	require.NoError(t, err, "the operation is retried on a new session")
+	assert.Len(t, connector.Sessions(), 3)
+
+	connected, err := pool.Check()
+	require.NoError(t, err)
+	assert.Equal(t, 2, connected)
+}
+
+func TestStoragePool_ClosedSession(t *testing.T) {
+	pool, connector := newTestStoragePool(1)
+	defer pool.Close()
+
+	_, err := pool.Stat("2020")
+	require.NoError(t, err)
+	connector.Sessions()[0].Close()
+
+	require.Eventually(t, func() bool {
+		pool.sessions[0].mu.Lock()
+		defer pool.sessions[0].mu.Unlock()
+		return pool.sessions[0].session == nil
+	}, time.Second, time.Millisecond)
+
+	_, err = pool.Stat("2020")
+	require.NoError(t, err)
+	assert.Len(t, connector.Sessions(), 2)
+}
+
+func TestHealthHandler(t *testing.T) {
+	pool, connector := newTestStoragePool(2)
+	defer pool.Close()
+	handler := &healthHandler{storage: pool}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok","sessions":2,"connected":1}`, rec.Body.String())
+
+	pool.Close()
+	connector.SetFail(true)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"unavailable"`)
+}
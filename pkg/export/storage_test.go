@@ -0,0 +1,248 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type memFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	isDir   bool
+}
+
+func (fi *memFileInfo) Name() string       { return fi.name }
+func (fi *memFileInfo) Size() int64        { return fi.size }
+func (fi *memFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *memFileInfo) IsDir() bool        { return fi.isDir }
+func (fi *memFileInfo) Sys() interface{}   { return nil }
+
+func (fi *memFileInfo) Mode() os.FileMode {
+	if fi.isDir {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
+
+type memFile struct {
+	*bytes.Reader
+	storage *memStorage
+	path    string
+	buf     *bytes.Buffer
+}
+
+func (f *memFile) Write(p []byte) (int, error) {
+	if f.buf == nil {
+		return 0, os.ErrPermission
+	}
+	return f.buf.Write(p)
+}
+
+func (f *memFile) ReadFrom(r io.Reader) (int64, error) {
+	if f.buf == nil {
+		return 0, os.ErrPermission
+	}
+	return f.buf.ReadFrom(r)
+}
+
+func (f *memFile) Close() error {
+	if f.buf != nil {
+		f.storage.mu.Lock()
+		f.storage.files[f.path] = append([]byte(nil), f.buf.Bytes()...)
+		f.storage.mu.Unlock()
+	}
+	return nil
+}
+
+// memStorage is an in-memory implementation of the Storage interface for tests.
+type memStorage struct {
+	mu      sync.Mutex
+	files   map[string][]byte
+	dirs    map[string]bool
+	modTime time.Time
+}
+
+func newMemStorage() *memStorage {
+	return &memStorage{
+		files:   make(map[string][]byte),
+		dirs:    map[string]bool{"": true},
+		modTime: time.Date(2020, 3, 2, 0, 25, 0, 0, time.UTC),
+	}
+}
+
+func (s *memStorage) clean(p string) string {
+	return strings.Trim(path.Clean("/"+p), "/")
+}
+
+func (s *memStorage) WriteFile(p string, data []byte) {
+	s.MkdirAll(path.Dir(s.clean(p)))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[s.clean(p)] = data
+}
+
+func (s *memStorage) ReadFile(p string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[s.clean(p)]
+	return data, ok
+}
+
+func (s *memStorage) Paths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var paths []string
+	for p := range s.files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (s *memStorage) Stat(p string) (os.FileInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p = s.clean(p)
+	if data, ok := s.files[p]; ok {
+		return &memFileInfo{name: path.Base(p), size: int64(len(data)), modTime: s.modTime}, nil
+	}
+	if s.dirs[p] {
+		return &memFileInfo{name: path.Base(p), modTime: s.modTime, isDir: true}, nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (s *memStorage) ReadDir(p string) ([]os.FileInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p = s.clean(p)
+	if !s.dirs[p] {
+		return nil, os.ErrNotExist
+	}
+	var infos []os.FileInfo
+	for name, data := range s.files {
+		if path.Dir("/"+name) == path.Clean("/"+p) {
+			infos = append(infos, &memFileInfo{name: path.Base(name), size: int64(len(data)), modTime: s.modTime})
+		}
+	}
+	for name := range s.dirs {
+		if name != "" && path.Dir("/"+name) == path.Clean("/"+p) {
+			infos = append(infos, &memFileInfo{name: path.Base(name), modTime: s.modTime, isDir: true})
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+	return infos, nil
+}
+
+func (s *memStorage) Open(p string) (StorageFile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[s.clean(p)]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return &memFile{Reader: bytes.NewReader(data), storage: s, path: s.clean(p)}, nil
+}
+
+func (s *memStorage) Create(p string) (StorageFile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p = s.clean(p)
+	if !s.dirs[path.Dir("/" + p)[1:]] {
+		return nil, os.ErrNotExist
+	}
+	s.files[p] = nil
+	return &memFile{Reader: bytes.NewReader(nil), storage: s, path: p, buf: &bytes.Buffer{}}, nil
+}
+
+func (s *memStorage) Mkdir(p string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dirs[s.clean(p)] = true
+	return nil
+}
+
+func (s *memStorage) MkdirAll(p string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p = s.clean(p)
+	for p != "" && p != "." {
+		s.dirs[p] = true
+		p = s.clean(path.Dir(p))
+	}
+	return nil
+}
+
+func (s *memStorage) Remove(p string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p = s.clean(p)
+	if _, ok := s.files[p]; ok {
+		delete(s.files, p)
+		return nil
+	}
+	if s.dirs[p] {
+		delete(s.dirs, p)
+		return nil
+	}
+	return os.ErrNotExist
+}
+
+func (s *memStorage) Rename(oldPath, newPath string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	oldPath, newPath = s.clean(oldPath), s.clean(newPath)
+	data, ok := s.files[oldPath]
+	if !ok {
+		return os.ErrNotExist
+	}
+	if _, exists := s.files[newPath]; exists {
+		return os.ErrExist
+	}
+	delete(s.files, oldPath)
+	s.files[newPath] = data
+	return nil
+}
+
+func (s *memStorage) PosixRename(oldPath, newPath string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	oldPath, newPath = s.clean(oldPath), s.clean(newPath)
+	data, ok := s.files[oldPath]
+	if !ok {
+		return os.ErrNotExist
+	}
+	delete(s.files, oldPath)
+	s.files[newPath] = data
+	return nil
+}
+
+func (s *memStorage) Link(oldPath, newPath string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	oldPath, newPath = s.clean(oldPath), s.clean(newPath)
+	data, ok := s.files[oldPath]
+	if !ok {
+		return os.ErrNotExist
+	}
+	if _, exists := s.files[newPath]; exists {
+		return os.ErrExist
+	}
+	s.files[newPath] = data
+	return nil
+}
+
+func (s *memStorage) Join(elem ...string) string {
+	return path.Join(elem...)
+}
+
+func (s *memStorage) Split(p string) (string, string) {
+	return path.Split(p)
+}
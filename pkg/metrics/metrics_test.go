@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_WriteTo(t *testing.T) {
+	registry := NewRegistry()
+	counter := NewCounterVec(registry, "test_requests_total", "Number of requests.", "method", "code")
+	gauge := NewGaugeVec(registry, "test_last_success_timestamp_seconds", "Time of the last success.")
+	histogram := NewHistogramVec(registry, "test_duration_seconds", "Duration.", []float64{1, 0.1}, "table")
+
+	counter.Inc("GET", "200")
+	counter.Add(2, "GET", "200")
+	counter.Inc("GET", "404")
+	gauge.Set(1583020800)
+	histogram.Observe(0.05, "a\"b")
+	histogram.Observe(0.5, "a\"b")
+	histogram.Observe(5, "a\"b")
+
+	var buf bytes.Buffer
+	_, err := registry.WriteTo(&buf)
+	require.NoError(t, err)
+
+	expected := `# HELP test_duration_seconds Duration.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{table="a\"b",le="0.1"} 1
+test_duration_seconds_bucket{table="a\"b",le="1"} 2
+test_duration_seconds_bucket{table="a\"b",le="+Inf"} 3
+test_duration_seconds_sum{table="a\"b"} 5.55
+test_duration_seconds_count{table="a\"b"} 3
+# HELP test_last_success_timestamp_seconds Time of the last success.
+# TYPE test_last_success_timestamp_seconds gauge
+test_last_success_timestamp_seconds 1.5830208e+09
+# HELP test_requests_total Number of requests.
+# TYPE test_requests_total counter
+test_requests_total{method="GET",code="200"} 3
+test_requests_total{method="GET",code="404"} 1
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestRegistry_DuplicateName(t *testing.T) {
+	registry := NewRegistry()
+	NewCounterVec(registry, "test_total", "Test.")
+	assert.Panics(t, func() { NewGaugeVec(registry, "test_total", "Test.") })
+}
+
+func TestRegistry_Push(t *testing.T) {
+	registry := NewRegistry()
+	NewCounterVec(registry, "test_total", "Test.").Inc()
+
+	var method, path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	require.NoError(t, registry.Push(server.URL+"/", "acoustid-data-export"))
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/metrics/job/acoustid-data-export", path)
+	assert.Contains(t, body, "test_total 1\n")
+}
@@ -0,0 +1,236 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// InitDatabases lists the databases created by Init, in the order they should be initialized.
+var InitDatabases = []string{"fingerprint", "musicbrainz", "ingest", "app"}
+
+// initFiles are the scripts which create the schema of a database, in the order they are executed.
+var initFiles = map[string][]string{
+	"fingerprint": {"extensions.sql", "schema.sql"},
+	"musicbrainz": {"schema.sql"},
+	"ingest":      {"schema.sql"},
+	"app":         {"schema.sql"},
+}
+
+// initSchemas are the schemas whose tables mark a database as already initialized.
+var initSchemas = map[string]string{
+	"fingerprint": "public",
+	"musicbrainz": "musicbrainz",
+	"ingest":      "public",
+	"app":         "public",
+}
+
+const seedFile = "seed.sql"
+
+var createExtensionRegexp = regexp.MustCompile(`(?i)^CREATE\s+EXTENSION\s+(?:IF\s+NOT\s+EXISTS\s+)?"?([a-z0-9_]+)"?`)
+
+var createTableRegexp = regexp.MustCompile(`(?i)^CREATE\s+(?:UNLOGGED\s+)?TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([a-z0-9_.]+)`)
+
+var identifierRegexp = regexp.MustCompile(`(?i)[a-z0-9_.]+`)
+
+// SQLSTATE codes of errors caused by missing objects.
+const (
+	undefinedObjectCode   = "42704"
+	undefinedFunctionCode = "42883"
+	undefinedTableCode    = "42P01"
+)
+
+// isMissingObjectError checks if a statement failed because it uses a type, operator class or function
+// from a missing extension, or a table which was not created because of one.
+func isMissingObjectError(err error, statement string, skippedTables []string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	switch pgErr.Code {
+	case undefinedObjectCode, undefinedFunctionCode:
+		return true
+	case undefinedTableCode:
+		for _, name := range identifierRegexp.FindAllString(statement, -1) {
+			name = strings.ToLower(name)
+			for _, table := range skippedTables {
+				table = strings.ToLower(table)
+				if name == table || strings.HasPrefix(name, table+".") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+type InitConfig struct {
+	// Load seed data for development, if the database has any.
+	Seed bool
+}
+
+func readFile(fs http.FileSystem, name string) (string, error) {
+	file, err := fs.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func isInitialized(ctx context.Context, conn *pgx.Conn, schema string) (bool, error) {
+	var exists bool
+	err := conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_tables WHERE schemaname = $1)", schema).Scan(&exists)
+	return exists, err
+}
+
+func availableExtensions(ctx context.Context, conn *pgx.Conn) (map[string]bool, error) {
+	rows, err := conn.Query(ctx, "SELECT name FROM pg_available_extensions")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	extensions := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+		extensions[name] = true
+	}
+	return extensions, rows.Err()
+}
+
+// Init creates the schema of an empty database from the embedded SQL files and marks all its migrations
+// as applied. Databases which already have tables are left alone, Init returns false for them. Extensions
+// which are not installed on the server are skipped, and so are the statements which fail because they use
+// objects from them, any other error stops the initialization.
+func Init(ctx context.Context, logger *zap.Logger, conn *pgx.Conn, fs http.FileSystem, database string, config InitConfig) (bool, error) {
+	files, ok := initFiles[database]
+	if !ok {
+		return false, fmt.Errorf("unknown database %q", database)
+	}
+
+	initialized, err := isInitialized(ctx, conn, initSchemas[database])
+	if err != nil {
+		return false, err
+	}
+	if initialized {
+		logger.Info("Database is already initialized, skipping")
+		return false, nil
+	}
+
+	extensions, err := availableExtensions(ctx, conn)
+	if err != nil {
+		return false, err
+	}
+
+	var statements []string
+	for _, name := range files {
+		script, err := readFile(fs, path.Join("/", database, name))
+		if err != nil {
+			return false, err
+		}
+		statements = append(statements, SplitStatements(script)...)
+	}
+	if config.Seed {
+		script, err := readFile(fs, path.Join("/", database, seedFile))
+		if err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+		statements = append(statements, SplitStatements(script)...)
+	}
+
+	startTime := time.Now()
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var missingExtensions, skippedTables []string
+	skipped := 0
+	for _, statement := range statements {
+		if transactionControlRegexp.MatchString(statement) {
+			continue
+		}
+		if match := createExtensionRegexp.FindStringSubmatch(statement); match != nil && !extensions[match[1]] {
+			logger.Warn("Extension is not installed on the database server, skipping it and everything that depends on it", zap.String("extension", match[1]))
+			missingExtensions = append(missingExtensions, match[1])
+			continue
+		}
+		if len(missingExtensions) == 0 {
+			_, err = tx.Exec(ctx, statement)
+			if err != nil {
+				return false, err
+			}
+			continue
+		}
+		// with missing extensions, any statement can fail, so each one runs in its own savepoint
+		_, err = tx.Exec(ctx, "SAVEPOINT init_statement")
+		if err != nil {
+			return false, err
+		}
+		_, err = tx.Exec(ctx, statement)
+		if err != nil {
+			if !isMissingObjectError(err, statement, skippedTables) {
+				logger.Error("Statement failed", zap.String("sql", statement), zap.Error(err))
+				return false, err
+			}
+			logger.Warn("Skipping statement which uses objects from a missing extension", zap.Strings("missing_extensions", missingExtensions), zap.Error(err))
+			logger.Debug("Skipped statement", zap.String("sql", statement))
+			if match := createTableRegexp.FindStringSubmatch(statement); match != nil {
+				skippedTables = append(skippedTables, match[1])
+			}
+			skipped++
+			_, err = tx.Exec(ctx, "ROLLBACK TO SAVEPOINT init_statement")
+		} else {
+			_, err = tx.Exec(ctx, "RELEASE SAVEPOINT init_statement")
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// schema.sql already contains all changes from the migrations
+	migrations, err := Load(fs, database)
+	if err != nil {
+		return true, err
+	}
+	if len(migrations) > 0 {
+		_, err = NewMigrator(logger, conn, migrations).Baseline(ctx, "")
+		if err != nil {
+			return true, err
+		}
+	}
+
+	logger.Info("Initialized database",
+		zap.Duration("duration", time.Since(startTime)),
+		zap.Int("skipped_statements", skipped),
+		zap.Bool("seed", config.Seed))
+	if skipped > 0 {
+		logger.Warn("Database was initialized without some objects, install the missing extensions and recreate the database to get them",
+			zap.Strings("missing_extensions", missingExtensions))
+	}
+	return true, nil
+}
@@ -0,0 +1,139 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/acoustid/acoustid/database"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestInitFiles(t *testing.T) {
+	for _, db := range InitDatabases {
+		require.Contains(t, initFiles, db)
+		require.Contains(t, initSchemas, db)
+		for _, name := range append(initFiles[db], seedFile) {
+			script, err := readFile(database.SQL, path.Join("/", db, name))
+			if name == seedFile && err != nil {
+				continue
+			}
+			require.NoError(t, err, db+"/"+name)
+			assert.NotEmpty(t, SplitStatements(script), db+"/"+name)
+		}
+	}
+}
+
+func TestCreateExtensionRegexp(t *testing.T) {
+	tests := []struct {
+		statement string
+		extension string
+	}{
+		{"CREATE EXTENSION IF NOT EXISTS acoustid WITH SCHEMA public", "acoustid"},
+		{`CREATE EXTENSION "intarray"`, "intarray"},
+		{"create extension cube", "cube"},
+		{"CREATE TABLE extension (id int)", ""},
+	}
+	for _, test := range tests {
+		match := createExtensionRegexp.FindStringSubmatch(test.statement)
+		if test.extension == "" {
+			assert.Nil(t, match, test.statement)
+		} else if assert.NotNil(t, match, test.statement) {
+			assert.Equal(t, test.extension, match[1])
+		}
+	}
+}
+
+func TestIsMissingObjectError(t *testing.T) {
+	skippedTables := []string{"musicbrainz.medium_index"}
+	assert.True(t, isMissingObjectError(&pgconn.PgError{Code: undefinedObjectCode}, "CREATE TABLE t (toc cube)", nil))
+	assert.True(t, isMissingObjectError(fmt.Errorf("wrapped: %w", &pgconn.PgError{Code: undefinedFunctionCode}), "SELECT f()", nil))
+	assert.True(t, isMissingObjectError(&pgconn.PgError{Code: undefinedTableCode}, "CREATE INDEX i ON musicbrainz.medium_index USING gist (toc)", skippedTables))
+	assert.False(t, isMissingObjectError(&pgconn.PgError{Code: undefinedTableCode}, "CREATE INDEX i ON musicbrainz.medium_index2 (id)", skippedTables))
+	assert.False(t, isMissingObjectError(&pgconn.PgError{Code: "42601"}, "CREATE TABEL t (id int)", nil))
+	assert.False(t, isMissingObjectError(errors.New("connection reset"), "SELECT 1", nil))
+}
+
+// newTestDatabase creates an empty database on the test server, which is dropped at the end of the test.
+// It returns a connection to the database and its configuration.
+func newTestDatabase(t *testing.T) (*pgx.Conn, *pgx.ConnConfig) {
+	config := testDatabaseConfig(t)
+	ctx := context.Background()
+	admin, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Close(ctx) })
+	db, err := createScratchDatabase(ctx, admin, config, fmt.Sprintf("acoustid_test_%d", time.Now().UnixNano()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Drop(ctx) })
+	dbConfig := *config
+	dbConfig.Database = db.name
+	return db.conn, &dbConfig
+}
+
+// testSQLFileSystem returns a file system with the given schema.sql and migrations of the app database.
+func testSQLFileSystem(t *testing.T, schema string, migrations map[string]string) http.FileSystem {
+	dir, err := ioutil.TempDir("", "sql")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "app", "migrations"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app", "schema.sql"), []byte(schema), 0644))
+	for name, migration := range migrations {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app", "migrations", name+".sql"), []byte(migration), 0644))
+	}
+	return http.Dir(dir)
+}
+
+func TestInit_MissingExtension(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	fs := testSQLFileSystem(t, `
+CREATE EXTENSION IF NOT EXISTS acoustid_missing_extension;
+CREATE TABLE a (id int);
+CREATE TABLE b (id int, x acoustid_missing_type);
+CREATE INDEX b_idx ON b (id);
+CREATE TABLE c (id int);
+`, nil)
+	initialized, err := Init(ctx, zap.NewNop(), conn, fs, "app", InitConfig{})
+	require.NoError(t, err)
+	assert.True(t, initialized)
+
+	var tables []string
+	rows, err := conn.Query(ctx, "SELECT tablename FROM pg_tables WHERE schemaname = 'public' ORDER BY tablename")
+	require.NoError(t, err)
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		tables = append(tables, name)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"a", "c", "schema_migration"}, tables)
+}
+
+func TestInit_OtherErrors(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	fs := testSQLFileSystem(t, `
+CREATE EXTENSION IF NOT EXISTS acoustid_missing_extension;
+CREATE TABLE a (id int);
+CREATE TABLE a (id int);
+`, nil)
+	_, err := Init(ctx, zap.NewNop(), conn, fs, "app", InitConfig{})
+	require.Error(t, err, "errors not caused by the missing extension are not skipped")
+
+	initialized, err := isInitialized(ctx, conn, "public")
+	require.NoError(t, err)
+	assert.False(t, initialized, "the schema is created in one transaction")
+}
@@ -0,0 +1,477 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Databases lists the databases which have migrations in the embedded SQL filesystem.
+var Databases = []string{"fingerprint", "ingest", "app"}
+
+// migrateLockID identifies the Postgres advisory lock held while migrations are applied ("migrate" in ASCII).
+const migrateLockID int64 = 0x6d696772617465
+
+const createMigrationTableQuery = `
+CREATE TABLE IF NOT EXISTS schema_migration (
+    name text NOT NULL,
+    applied timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT schema_migration_pkey PRIMARY KEY (name)
+)
+`
+
+// schema_migration_progress records the executed statements of a migration which runs outside of a transaction,
+// until the whole migration is applied.
+const createMigrationProgressTableQuery = `
+CREATE TABLE IF NOT EXISTS schema_migration_progress (
+    name text NOT NULL,
+    statement integer NOT NULL,
+    CONSTRAINT schema_migration_progress_pkey PRIMARY KEY (name, statement)
+)
+`
+
+var concurrentlyRegexp = regexp.MustCompile(`(?i)\bCONCURRENTLY\b`)
+
+var createIndexConcurrentlyRegexp = regexp.MustCompile(`(?i)^CREATE\s+(?:UNIQUE\s+)?INDEX\s+CONCURRENTLY\s+(?:IF\s+NOT\s+EXISTS\s+)?([a-z0-9_]+)\s+ON\b`)
+
+var dropIndexConcurrentlyRegexp = regexp.MustCompile(`(?i)^DROP\s+INDEX\s+CONCURRENTLY\s+(?:IF\s+EXISTS\s+)?([a-z0-9_]+)\s*(?:CASCADE|RESTRICT)?$`)
+
+var transactionControlRegexp = regexp.MustCompile(`(?i)^(BEGIN|COMMIT|START\s+TRANSACTION|END)(\s+(WORK|TRANSACTION))?$`)
+
+// Migration is one SQL file from the migrations directory of a database.
+type Migration struct {
+	// File name without the .sql extension, e.g. "2020_03_03_meta_gid".
+	Name string
+	// Statements of the file, without transaction control statements.
+	Statements []string
+	// Whether the statements are executed one by one outside of a transaction, because the file has
+	// statements with CONCURRENTLY, which can't run inside one. The executed statements are recorded,
+	// so that a failed migration can be retried from the statement which failed.
+	NoTransaction bool
+}
+
+// Load reads all migrations of a database from fs, sorted by name. The migrations are stored
+// in /{database}/migrations/*.sql. A database without the migrations directory has no migrations.
+func Load(fs http.FileSystem, database string) ([]Migration, error) {
+	dir := path.Join("/", database, "migrations")
+	f, err := fs.Open(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	infos, err := f.Readdir(-1)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".sql") {
+			continue
+		}
+		file, err := fs.Open(path.Join(dir, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, NewMigration(strings.TrimSuffix(info.Name(), ".sql"), string(data)))
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Name < migrations[j].Name })
+	return migrations, nil
+}
+
+// NewMigration parses a migration script. Explicit BEGIN and COMMIT statements are dropped, the runner
+// decides how the statements are executed.
+func NewMigration(name string, script string) Migration {
+	migration := Migration{Name: name}
+	for _, statement := range SplitStatements(script) {
+		if transactionControlRegexp.MatchString(statement) {
+			continue
+		}
+		if concurrentlyRegexp.MatchString(statement) {
+			migration.NoTransaction = true
+		}
+		migration.Statements = append(migration.Statements, statement)
+	}
+	return migration
+}
+
+// MigrationStatus is a migration with the time it was applied, zero if it's pending.
+type MigrationStatus struct {
+	Migration
+	Applied time.Time
+}
+
+func (s MigrationStatus) Pending() bool {
+	return s.Applied.IsZero()
+}
+
+// Migrator applies migrations to a database and records them in the schema_migration table.
+type Migrator struct {
+	logger     *zap.Logger
+	conn       *pgx.Conn
+	migrations []Migration
+	DryRun     bool
+}
+
+func NewMigrator(logger *zap.Logger, conn *pgx.Conn, migrations []Migration) *Migrator {
+	return &Migrator{logger: logger, conn: conn, migrations: migrations}
+}
+
+func (m *Migrator) appliedMigrations(ctx context.Context) (map[string]time.Time, error) {
+	var exists bool
+	err := m.conn.QueryRow(ctx, "SELECT to_regclass('schema_migration') IS NOT NULL").Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[string]time.Time)
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := m.conn.Query(ctx, "SELECT name, applied FROM schema_migration")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		var t time.Time
+		err = rows.Scan(&name, &t)
+		if err != nil {
+			return nil, err
+		}
+		applied[name] = t
+	}
+	return applied, rows.Err()
+}
+
+// Status returns all migrations with the time they were applied.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]MigrationStatus, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i] = MigrationStatus{Migration: migration, Applied: applied[migration.Name]}
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) lock(ctx context.Context) error {
+	var locked bool
+	err := m.conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", migrateLockID).Scan(&locked)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return errors.New("migrations are being applied by another process")
+	}
+	return nil
+}
+
+func (m *Migrator) unlock(ctx context.Context) {
+	_, err := m.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", migrateLockID)
+	if err != nil {
+		m.logger.Warn("Failed to release migration lock", zap.Error(err))
+	}
+}
+
+// pending returns migrations which were not applied yet, up to and including the named one, or all if it's empty.
+func (m *Migrator) pending(ctx context.Context, until string) ([]Migration, error) {
+	if until != "" {
+		found := false
+		for _, migration := range m.migrations {
+			if migration.Name == until {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown migration %q", until)
+		}
+	}
+
+	applied, err := m.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Name]; !ok {
+			pending = append(pending, migration)
+		}
+		if migration.Name == until {
+			break
+		}
+	}
+	return pending, nil
+}
+
+// ErrUntrackedSchema is returned by Migrate for databases which have tables, but no record of applied migrations.
+var ErrUntrackedSchema = errors.New(`database has tables, but no schema_migration table, use "db migrate baseline" to record the migrations which were already applied`)
+
+// Migrate applies all pending migrations in order and returns the number of applied migrations.
+// Databases which were created or migrated without the migrator must be baselined first.
+func (m *Migrator) Migrate(ctx context.Context) (int, error) {
+	return m.run(ctx, "", true, m.apply)
+}
+
+// checkTracked returns ErrUntrackedSchema if the database has tables, but not the schema_migration table.
+func (m *Migrator) checkTracked(ctx context.Context) error {
+	var untracked bool
+	err := m.conn.QueryRow(ctx, `
+SELECT to_regclass('schema_migration') IS NULL AND EXISTS (
+  SELECT 1 FROM pg_tables WHERE schemaname NOT IN ('pg_catalog', 'information_schema')
+)`).Scan(&untracked)
+	if err != nil {
+		return err
+	}
+	if untracked {
+		return ErrUntrackedSchema
+	}
+	return nil
+}
+
+// Baseline records pending migrations up to and including the named one (or all) as applied,
+// without running them. It's used for databases which were migrated by hand.
+func (m *Migrator) Baseline(ctx context.Context, until string) (int, error) {
+	return m.run(ctx, until, false, func(ctx context.Context, migration Migration) error {
+		m.logger.Info("Marking migration as applied", zap.String("migration", migration.Name))
+		if m.DryRun {
+			return nil
+		}
+		_, err := m.conn.Exec(ctx, "INSERT INTO schema_migration (name) VALUES ($1)", migration.Name)
+		return err
+	})
+}
+
+func (m *Migrator) run(ctx context.Context, until string, checkTracked bool, fn func(context.Context, Migration) error) (int, error) {
+	err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer m.unlock(ctx)
+
+	if checkTracked {
+		err = m.checkTracked(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if !m.DryRun {
+		_, err = m.conn.Exec(ctx, createMigrationTableQuery)
+		if err != nil {
+			return 0, err
+		}
+		_, err = m.conn.Exec(ctx, createMigrationProgressTableQuery)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	pending, err := m.pending(ctx, until)
+	if err != nil {
+		return 0, err
+	}
+	if len(pending) == 0 {
+		m.logger.Info("No pending migrations")
+		return 0, nil
+	}
+	for i, migration := range pending {
+		err = fn(ctx, migration)
+		if err != nil {
+			return i, fmt.Errorf("migration %s failed: %w", migration.Name, err)
+		}
+	}
+	return len(pending), nil
+}
+
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	logger := m.logger.With(zap.String("migration", migration.Name))
+	logger.Info("Applying migration", zap.Bool("transaction", !migration.NoTransaction), zap.Bool("dry_run", m.DryRun))
+	for _, statement := range migration.Statements {
+		logger.Debug("Statement", zap.String("sql", statement))
+	}
+	if m.DryRun {
+		return nil
+	}
+
+	startTime := time.Now()
+	if migration.NoTransaction {
+		err := m.applyConcurrently(ctx, logger, migration)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := m.applyInTransaction(ctx, migration)
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("Applied migration", zap.Duration("duration", time.Since(startTime)))
+	return nil
+}
+
+func (m *Migrator) applyInTransaction(ctx context.Context, migration Migration) error {
+	tx, err := m.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, statement := range migration.Statements {
+		_, err = tx.Exec(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(ctx, "INSERT INTO schema_migration (name) VALUES ($1)", migration.Name)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// applyConcurrently applies a migration with CONCURRENTLY statements. The statements are executed one by one
+// in the order of the file, and each executed statement is recorded in schema_migration_progress, so that
+// a retry of a failed migration continues with the statement which failed. Other statements are executed
+// in a transaction together with their record. CONCURRENTLY statements can't be, so if the process stops
+// after one of them, but before its record, the retry checks the catalog: indexes which were already built
+// or dropped are skipped, and invalid indexes left by a failed build are dropped and built again.
+func (m *Migrator) applyConcurrently(ctx context.Context, logger *zap.Logger, migration Migration) error {
+	executed := make(map[int]bool)
+	rows, err := m.conn.Query(ctx, "SELECT statement FROM schema_migration_progress WHERE name = $1", migration.Name)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var i int
+		err = rows.Scan(&i)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		executed[i] = true
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	for i, statement := range migration.Statements {
+		if executed[i] {
+			logger.Info("Statement was executed by a previous attempt, skipping", zap.Int("statement", i))
+			continue
+		}
+		if !concurrentlyRegexp.MatchString(statement) {
+			err = m.executeStatement(ctx, migration.Name, i, statement)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		skip, err := m.prepareConcurrentStatement(ctx, logger, statement)
+		if err != nil {
+			return err
+		}
+		if !skip {
+			_, err = m.conn.Exec(ctx, statement)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = m.conn.Exec(ctx, "INSERT INTO schema_migration_progress (name, statement) VALUES ($1, $2)", migration.Name, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := m.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx, "DELETE FROM schema_migration_progress WHERE name = $1", migration.Name)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, "INSERT INTO schema_migration (name) VALUES ($1)", migration.Name)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// executeStatement executes a statement and records it in schema_migration_progress in one transaction.
+func (m *Migrator) executeStatement(ctx context.Context, name string, i int, statement string) error {
+	tx, err := m.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	_, err = tx.Exec(ctx, statement)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, "INSERT INTO schema_migration_progress (name, statement) VALUES ($1, $2)", name, i)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// prepareConcurrentStatement checks if a CONCURRENTLY statement was already executed by a previous attempt
+// which stopped before recording it, and drops an invalid index left by a failed CREATE INDEX CONCURRENTLY.
+func (m *Migrator) prepareConcurrentStatement(ctx context.Context, logger *zap.Logger, statement string) (bool, error) {
+	if match := createIndexConcurrentlyRegexp.FindStringSubmatch(statement); match != nil {
+		var valid *bool
+		err := m.conn.QueryRow(ctx, "SELECT (SELECT indisvalid FROM pg_index WHERE indexrelid = to_regclass($1))", match[1]).Scan(&valid)
+		if err != nil {
+			return false, err
+		}
+		if valid != nil && *valid {
+			logger.Info("Index already exists, skipping", zap.String("index", match[1]))
+			return true, nil
+		}
+		if valid != nil {
+			logger.Warn("Dropping invalid index left by a failed migration", zap.String("index", match[1]))
+			_, err = m.conn.Exec(ctx, "DROP INDEX CONCURRENTLY "+pgx.Identifier{match[1]}.Sanitize())
+			if err != nil {
+				return false, err
+			}
+		}
+		return false, nil
+	}
+	if match := dropIndexConcurrentlyRegexp.FindStringSubmatch(statement); match != nil {
+		var exists bool
+		err := m.conn.QueryRow(ctx, "SELECT to_regclass($1) IS NOT NULL", match[1]).Scan(&exists)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			logger.Info("Index was already dropped, skipping", zap.String("index", match[1]))
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,252 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/acoustid/acoustid/database"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewMigration(t *testing.T) {
+	migration := NewMigration("2020_03_01_fingerprint_created_index", "BEGIN;\n\nCREATE INDEX fingerprint_idx_created ON fingerprint (created);\n\nCOMMIT;\n")
+	assert.Equal(t, []string{"CREATE INDEX fingerprint_idx_created ON fingerprint (created)"}, migration.Statements)
+	assert.False(t, migration.NoTransaction)
+
+	migration = NewMigration("2020_03_03_meta_gid", "ALTER TABLE meta ADD COLUMN IF NOT EXISTS gid uuid;\nCREATE UNIQUE INDEX CONCURRENTLY meta_idx_gid ON meta (gid) WHERE gid IS NOT NULL;\n")
+	assert.Len(t, migration.Statements, 2)
+	assert.True(t, migration.NoTransaction)
+
+	migration = NewMigration("comment", "-- not created concurrently\nCREATE INDEX a ON b (c);\n")
+	assert.False(t, migration.NoTransaction)
+}
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load(database.SQL, "fingerprint")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	assert.Equal(t, "2010_01_01_initial", migrations[0].Name)
+
+	names := make(map[string]Migration)
+	for i, migration := range migrations {
+		if i > 0 {
+			assert.Less(t, migrations[i-1].Name, migration.Name)
+		}
+		assert.NotEmpty(t, migration.Statements, migration.Name)
+		for _, statement := range migration.Statements {
+			assert.NotEqual(t, "BEGIN", strings.ToUpper(statement), migration.Name)
+		}
+		names[migration.Name] = migration
+	}
+	assert.True(t, names["2020_03_03_meta_gid"].NoTransaction)
+	assert.False(t, names["2020_03_03_drop_duplicate_indexes"].NoTransaction)
+	assert.Len(t, names["2020_03_03_drop_duplicate_indexes"].Statements, 4)
+
+	migrations, err = Load(database.SQL, "app")
+	require.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+
+	migrations, err = Load(database.SQL, "ingest")
+	require.NoError(t, err)
+	assert.Empty(t, migrations, "databases without a migrations directory have no migrations")
+
+	_, err = Load(http.Dir("/nonexistent"), "app")
+	assert.NoError(t, err)
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		NewMigration("2020_01_01_initial", "BEGIN; CREATE TABLE track (id serial NOT NULL); COMMIT;"),
+		NewMigration("2020_01_02_track_gid", "ALTER TABLE track ADD gid uuid;"),
+		NewMigration("2020_01_03_meta", "CREATE TABLE meta (id serial NOT NULL);"),
+	}
+}
+
+func tableExists(t *testing.T, conn *pgx.Conn, name string) bool {
+	var exists bool
+	err := conn.QueryRow(context.Background(), "SELECT to_regclass($1) IS NOT NULL", name).Scan(&exists)
+	require.NoError(t, err)
+	return exists
+}
+
+func TestMigrator_Migrate(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	migrator := NewMigrator(zap.NewNop(), conn, testMigrations())
+	migrator.DryRun = true
+	count, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.False(t, tableExists(t, conn, "schema_migration"), "dry run doesn't change the database")
+
+	migrator.DryRun = false
+	count, err = migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.True(t, tableExists(t, conn, "meta"))
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	for _, status := range statuses {
+		assert.False(t, status.Pending(), status.Name)
+	}
+
+	count, err = migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestMigrator_MigrateFailure(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	migrations := append(testMigrations()[:1], NewMigration("2020_01_02_broken", "CREATE TABLE meta (id int); ALTER TABLE missing ADD gid uuid;"))
+	migrator := NewMigrator(zap.NewNop(), conn, migrations)
+	count, err := migrator.Migrate(ctx)
+	require.Error(t, err)
+	assert.Equal(t, 1, count)
+	assert.Contains(t, err.Error(), "2020_01_02_broken")
+	assert.False(t, tableExists(t, conn, "meta"), "failed migration is rolled back")
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.False(t, statuses[0].Pending())
+	assert.True(t, statuses[1].Pending())
+}
+
+func TestMigrator_Baseline(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE track (id serial NOT NULL, gid uuid)")
+	require.NoError(t, err)
+
+	migrator := NewMigrator(zap.NewNop(), conn, testMigrations())
+	_, err = migrator.Baseline(ctx, "unknown")
+	assert.Error(t, err)
+
+	count, err := migrator.Baseline(ctx, "2020_01_02_track_gid")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.False(t, tableExists(t, conn, "meta"), "baseline doesn't run migrations")
+
+	count, err = migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.True(t, tableExists(t, conn, "meta"))
+}
+
+func TestMigrator_Lock(t *testing.T) {
+	conn, config := newTestDatabase(t)
+	ctx := context.Background()
+
+	other, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(t, err)
+	defer other.Close(ctx)
+	_, err = other.Exec(ctx, "SELECT pg_advisory_lock($1)", migrateLockID)
+	require.NoError(t, err)
+
+	migrator := NewMigrator(zap.NewNop(), conn, testMigrations())
+	_, err = migrator.Migrate(ctx)
+	assert.Error(t, err, "migrations are not applied while another process holds the lock")
+	assert.False(t, tableExists(t, conn, "track"))
+
+	_, err = other.Exec(ctx, "SELECT pg_advisory_unlock($1)", migrateLockID)
+	require.NoError(t, err)
+	count, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestMigrator_RetryConcurrently(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE meta (id int NOT NULL, name text)")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO meta (id) VALUES (1), (1)")
+	require.NoError(t, err)
+
+	// the statements depend on each other, so they must run in the order of the file
+	migrations := []Migration{NewMigration("2020_03_03_meta_pkey", `
+ALTER TABLE meta ADD gid uuid;
+CREATE INDEX CONCURRENTLY meta_idx_name ON meta (name);
+CREATE UNIQUE INDEX CONCURRENTLY meta_idx_id ON meta (id);
+ALTER TABLE meta ADD CONSTRAINT meta_pkey PRIMARY KEY USING INDEX meta_idx_id;
+DROP INDEX CONCURRENTLY meta_idx_name;
+`)}
+	migrator := NewMigrator(zap.NewNop(), conn, migrations)
+
+	// the unique index fails because of the duplicate and is left invalid
+	_, err = migrator.Migrate(ctx)
+	require.Error(t, err)
+	assert.True(t, tableExists(t, conn, "meta_idx_name"), "statements before the failed one are applied")
+
+	_, err = conn.Exec(ctx, "DELETE FROM meta WHERE ctid <> (SELECT min(ctid) FROM meta)")
+	require.NoError(t, err)
+
+	// the retry continues with the failed statement, the ADD without IF NOT EXISTS is not executed again
+	count, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	assert.True(t, tableExists(t, conn, "meta_pkey"))
+	assert.False(t, tableExists(t, conn, "meta_idx_name"))
+	var progress int
+	err = conn.QueryRow(ctx, "SELECT count(*) FROM schema_migration_progress").Scan(&progress)
+	require.NoError(t, err)
+	assert.Equal(t, 0, progress, "the progress is deleted once the migration is applied")
+}
+
+func TestMigrator_RetryConcurrentlyUnrecorded(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	_, err := NewMigrator(zap.NewNop(), conn, nil).Migrate(ctx)
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "CREATE TABLE meta (id int NOT NULL, name text)")
+	require.NoError(t, err)
+
+	// a previous attempt built the first index and stopped before recording it
+	_, err = conn.Exec(ctx, "CREATE INDEX meta_idx_id ON meta (id)")
+	require.NoError(t, err)
+
+	migrator := NewMigrator(zap.NewNop(), conn, []Migration{NewMigration("2020_03_03_meta_indexes", `
+CREATE INDEX CONCURRENTLY meta_idx_id ON meta (id);
+CREATE INDEX CONCURRENTLY meta_idx_name ON meta (name);
+DROP INDEX CONCURRENTLY meta_idx_name;
+`)})
+	count, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.True(t, tableExists(t, conn, "meta_idx_id"))
+	assert.False(t, tableExists(t, conn, "meta_idx_name"))
+}
+
+func TestMigrator_UntrackedSchema(t *testing.T) {
+	conn, _ := newTestDatabase(t)
+	ctx := context.Background()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE meta (id int NOT NULL)")
+	require.NoError(t, err)
+
+	migrator := NewMigrator(zap.NewNop(), conn, []Migration{NewMigration("2020_03_03_meta_gid", "ALTER TABLE meta ADD gid uuid")})
+	_, err = migrator.Migrate(ctx)
+	assert.True(t, errors.Is(err, ErrUntrackedSchema), "%v", err)
+
+	count, err := migrator.Baseline(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = migrator.Migrate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,211 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// catalog maps objects of a database (e.g. "column public.track.gid") to their definitions.
+type catalog map[string]string
+
+const catalogQuery = `
+SELECT 'table ' || n.nspname || '.' || c.relname, ''
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p') AND n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_toast%'
+UNION ALL
+SELECT 'column ' || n.nspname || '.' || c.relname || '.' || a.attname,
+  format_type(a.atttypid, a.atttypmod) ||
+  CASE WHEN a.attnotnull THEN ' NOT NULL' ELSE '' END ||
+  coalesce(' DEFAULT ' || pg_get_expr(d.adbin, d.adrelid), '')
+FROM pg_attribute a
+JOIN pg_class c ON c.oid = a.attrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE c.relkind IN ('r', 'p') AND a.attnum > 0 AND NOT a.attisdropped
+  AND n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_toast%'
+UNION ALL
+SELECT 'index ' || n.nspname || '.' || c.relname, pg_get_indexdef(i.indexrelid)
+FROM pg_index i
+JOIN pg_class c ON c.oid = i.indexrelid
+JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_toast%'
+UNION ALL
+SELECT 'constraint ' || n.nspname || '.' || t.relname || '.' || co.conname, pg_get_constraintdef(co.oid)
+FROM pg_constraint co
+JOIN pg_class t ON t.oid = co.conrelid
+JOIN pg_namespace n ON n.oid = t.relnamespace
+WHERE n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_toast%'
+`
+
+func readCatalog(ctx context.Context, conn *pgx.Conn) (catalog, error) {
+	rows, err := conn.Query(ctx, catalogQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	objects := make(catalog)
+	for rows.Next() {
+		var name, definition string
+		err = rows.Scan(&name, &definition)
+		if err != nil {
+			return nil, err
+		}
+		objects[name] = definition
+	}
+	return objects, rows.Err()
+}
+
+// SchemaDifference is an object which is defined differently by the migrations and by schema.sql,
+// or which exists only in one of them.
+type SchemaDifference struct {
+	Object       string
+	InMigrations bool
+	InSchema     bool
+	Migrations   string
+	Schema       string
+}
+
+func (d SchemaDifference) String() string {
+	if !d.InMigrations || !d.InSchema {
+		where, definition := "schema.sql", d.Schema
+		if !d.InSchema {
+			where, definition = "migrations", d.Migrations
+		}
+		if definition == "" {
+			return fmt.Sprintf("%s: only in %s", d.Object, where)
+		}
+		return fmt.Sprintf("%s: only in %s: %s", d.Object, where, definition)
+	}
+	return fmt.Sprintf("%s: migrations have %q, schema.sql has %q", d.Object, d.Migrations, d.Schema)
+}
+
+// compareCatalogs returns the differences between two catalogs, sorted by object.
+func compareCatalogs(migrations, schema catalog) []SchemaDifference {
+	var differences []SchemaDifference
+	for name, definition := range migrations {
+		other, ok := schema[name]
+		if !ok || other != definition {
+			differences = append(differences, SchemaDifference{Object: name, InMigrations: true, InSchema: ok, Migrations: definition, Schema: other})
+		}
+	}
+	for name, definition := range schema {
+		if _, ok := migrations[name]; !ok {
+			differences = append(differences, SchemaDifference{Object: name, InSchema: true, Schema: definition})
+		}
+	}
+	sort.Slice(differences, func(i, j int) bool { return differences[i].Object < differences[j].Object })
+	return differences
+}
+
+// withoutMissingExtensions removes CREATE EXTENSION statements for extensions which are not installed
+// on the server, so that the migrations can run on any Postgres server.
+func withoutMissingExtensions(logger *zap.Logger, migrations []Migration, extensions map[string]bool) []Migration {
+	result := make([]Migration, len(migrations))
+	for i, migration := range migrations {
+		result[i] = migration
+		result[i].Statements = nil
+		for _, statement := range migration.Statements {
+			if match := createExtensionRegexp.FindStringSubmatch(statement); match != nil && !extensions[match[1]] {
+				logger.Warn("Extension is not installed on the database server, skipping it", zap.String("migration", migration.Name), zap.String("extension", match[1]))
+				continue
+			}
+			result[i].Statements = append(result[i].Statements, statement)
+		}
+	}
+	return result
+}
+
+type scratchDatabase struct {
+	admin *pgx.Conn
+	name  string
+	conn  *pgx.Conn
+}
+
+func createScratchDatabase(ctx context.Context, admin *pgx.Conn, config *pgx.ConnConfig, name string) (*scratchDatabase, error) {
+	_, err := admin.Exec(ctx, "CREATE DATABASE "+pgx.Identifier{name}.Sanitize())
+	if err != nil {
+		return nil, err
+	}
+	db := &scratchDatabase{admin: admin, name: name}
+	scratchConfig := *config
+	scratchConfig.Database = name
+	db.conn, err = pgx.ConnectConfig(ctx, &scratchConfig)
+	if err != nil {
+		db.Drop(ctx)
+		return nil, err
+	}
+	return db, nil
+}
+
+func (db *scratchDatabase) Drop(ctx context.Context) error {
+	if db.conn != nil {
+		db.conn.Close(ctx)
+	}
+	_, err := db.admin.Exec(ctx, "DROP DATABASE IF EXISTS "+pgx.Identifier{db.name}.Sanitize())
+	return err
+}
+
+// CheckSchema applies the migrations of a database to one scratch database and schema.sql to another,
+// and returns the differences between their catalogs (tables, columns, indexes and constraints).
+// The scratch databases are created on the server from config, which needs the CREATEDB privilege,
+// and they are dropped afterwards.
+func CheckSchema(ctx context.Context, logger *zap.Logger, config *pgx.ConnConfig, fs http.FileSystem, database string) ([]SchemaDifference, error) {
+	migrations, err := Load(fs, database)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) == 0 {
+		return nil, fmt.Errorf("database %s has no migrations", database)
+	}
+
+	admin, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close(ctx)
+
+	extensions, err := availableExtensions(ctx, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("acoustid_check_%s_%d", database, time.Now().Unix())
+
+	migrationsDB, err := createScratchDatabase(ctx, admin, config, prefix+"_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer migrationsDB.Drop(ctx)
+
+	schemaDB, err := createScratchDatabase(ctx, admin, config, prefix+"_schema")
+	if err != nil {
+		return nil, err
+	}
+	defer schemaDB.Drop(ctx)
+
+	migrator := NewMigrator(logger.With(zap.String("scratch_database", migrationsDB.name)), migrationsDB.conn, withoutMissingExtensions(logger, migrations, extensions))
+	_, err = migrator.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	_, err = Init(ctx, logger.With(zap.String("scratch_database", schemaDB.name)), schemaDB.conn, fs, database, InitConfig{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply schema.sql: %w", err)
+	}
+
+	migrationsCatalog, err := readCatalog(ctx, migrationsDB.conn)
+	if err != nil {
+		return nil, err
+	}
+	schemaCatalog, err := readCatalog(ctx, schemaDB.conn)
+	if err != nil {
+		return nil, err
+	}
+	return compareCatalogs(migrationsCatalog, schemaCatalog), nil
+}
@@ -0,0 +1,158 @@
+package migrate
+
+import (
+	"strings"
+)
+
+// SplitStatements splits a SQL script into individual statements. Comments are removed, semicolons
+// inside string literals, quoted identifiers and dollar-quoted function bodies are not treated as
+// statement separators.
+func SplitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+
+	flush := func() {
+		statement := strings.TrimSpace(current.String())
+		if statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(script); {
+		c := script[i]
+		switch {
+		case c == ';':
+			flush()
+			i++
+		case c == '-' && strings.HasPrefix(script[i:], "--"):
+			end := strings.IndexByte(script[i:], '\n')
+			if end == -1 {
+				i = len(script)
+			} else {
+				i += end
+			}
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			i = skipBlockComment(script, i)
+			current.WriteByte(' ')
+		case c == '\'':
+			end := skipString(script, i, isEscapeString(script, i))
+			current.WriteString(script[i:end])
+			i = end
+		case c == '"':
+			end := skipQuoted(script, i, '"')
+			current.WriteString(script[i:end])
+			i = end
+		case c == '$':
+			tag, ok := dollarQuoteTag(script, i)
+			if !ok {
+				current.WriteByte(c)
+				i++
+				break
+			}
+			end := strings.Index(script[i+len(tag):], tag)
+			if end == -1 {
+				end = len(script)
+			} else {
+				end = i + len(tag) + end + len(tag)
+			}
+			current.WriteString(script[i:end])
+			i = end
+		default:
+			current.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return statements
+}
+
+// skipBlockComment returns the position after a block comment starting at i. Block comments can be nested.
+func skipBlockComment(script string, i int) int {
+	depth := 0
+	for i < len(script) {
+		if strings.HasPrefix(script[i:], "/*") {
+			depth++
+			i += 2
+		} else if strings.HasPrefix(script[i:], "*/") {
+			depth--
+			i += 2
+			if depth == 0 {
+				return i
+			}
+		} else {
+			i++
+		}
+	}
+	return i
+}
+
+// skipQuoted returns the position after a quoted string starting at i. The quote character is escaped by doubling it.
+func skipQuoted(script string, i int, quote byte) int {
+	i++
+	for i < len(script) {
+		if script[i] == quote {
+			if i+1 < len(script) && script[i+1] == quote {
+				i += 2
+				continue
+			}
+			return i + 1
+		}
+		i++
+	}
+	return i
+}
+
+// skipString is like skipQuoted, but it also handles backslash escapes in E'...' strings.
+func skipString(script string, i int, backslashEscapes bool) int {
+	if !backslashEscapes {
+		return skipQuoted(script, i, '\'')
+	}
+	i++
+	for i < len(script) {
+		switch script[i] {
+		case '\\':
+			i += 2
+			continue
+		case '\'':
+			if i+1 < len(script) && script[i+1] == '\'' {
+				i += 2
+				continue
+			}
+			return i + 1
+		}
+		i++
+	}
+	return len(script)
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func isEscapeString(script string, i int) bool {
+	if i == 0 || (script[i-1] != 'E' && script[i-1] != 'e') {
+		return false
+	}
+	return i == 1 || !isIdentifierChar(script[i-2])
+}
+
+// dollarQuoteTag returns the opening tag of a dollar-quoted string ($$ or $tag$) starting at i.
+func dollarQuoteTag(script string, i int) (string, bool) {
+	if i > 0 && isIdentifierChar(script[i-1]) {
+		return "", false
+	}
+	for j := i + 1; j < len(script); j++ {
+		c := script[j]
+		if c == '$' {
+			return script[i : j+1], true
+		}
+		if c >= '0' && c <= '9' && j == i+1 {
+			return "", false
+		}
+		if !isIdentifierChar(c) {
+			return "", false
+		}
+	}
+	return "", false
+}
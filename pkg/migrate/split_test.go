@@ -0,0 +1,33 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name       string
+		script     string
+		statements []string
+	}{
+		{"empty", " \n-- comment\n", nil},
+		{"simple", "SELECT 1;\nSELECT 2", []string{"SELECT 1", "SELECT 2"}},
+		{"comments", "-- a; b\nSELECT 1; /* c; /* nested; */ d; */ SELECT 2;", []string{"SELECT 1", "SELECT 2"}},
+		{"string", "SELECT 'a;''b'; SELECT 2;", []string{"SELECT 'a;''b'", "SELECT 2"}},
+		{"escape string", `SELECT E'a\';b'; SELECT 2;`, []string{`SELECT E'a\';b'`, "SELECT 2"}},
+		{"identifier", `SELECT 1 AS "a;""b"; SELECT 2;`, []string{`SELECT 1 AS "a;""b"`, "SELECT 2"}},
+		{"comment in string", "SELECT '-- a'; SELECT 2;", []string{"SELECT '-- a'", "SELECT 2"}},
+		{
+			"dollar quotes",
+			"CREATE FUNCTION f() RETURNS trigger AS $$\nBEGIN\n    RETURN NEW;\nEND;\n$$ LANGUAGE plpgsql;\nSELECT 2;",
+			[]string{"CREATE FUNCTION f() RETURNS trigger AS $$\nBEGIN\n    RETURN NEW;\nEND;\n$$ LANGUAGE plpgsql", "SELECT 2"},
+		},
+		{"tagged dollar quotes", "SELECT $a$ $$; $a$; SELECT 2;", []string{"SELECT $a$ $$; $a$", "SELECT 2"}},
+		{"parameters", "PREPARE p AS SELECT $1; SELECT 2;", []string{"PREPARE p AS SELECT $1", "SELECT 2"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.statements, SplitStatements(test.script), test.name)
+	}
+}